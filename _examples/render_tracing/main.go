@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// spanKey is the context key of the name of the active span.
+type spanKey struct{}
+
+// spanTracer is a `chart.ContextTracer` that prints a span for the render and each of its stages.
+//
+// An OpenTelemetry adapter has the same shape, starting a span with the stage name and ending it
+// at the end of the stage:
+//
+//	func (ot otelTracer) StartStage(ctx context.Context, stage chart.RenderStage) (context.Context, func(chart.TraceInfo)) {
+//		ctx, span := ot.tracer.Start(ctx, "chart."+stage.String())
+//		return ctx, func(ti chart.TraceInfo) {
+//			if ti.Err != nil {
+//				span.RecordError(ti.Err)
+//			}
+//			span.End()
+//		}
+//	}
+type spanTracer struct{}
+
+// Trace implements chart.Tracer, for renders without a context.
+func (spanTracer) Trace(ti chart.TraceInfo) {
+	fmt.Printf("%s: %v\n", ti.Stage, ti.Elapsed)
+}
+
+// StartStage implements chart.ContextTracer.
+func (spanTracer) StartStage(ctx context.Context, stage chart.RenderStage) (context.Context, func(chart.TraceInfo)) {
+	parent, _ := ctx.Value(spanKey{}).(string)
+	name := strings.TrimPrefix(parent+"/"+stage.String(), "/")
+	fmt.Printf("start %s\n", name)
+	return context.WithValue(ctx, spanKey{}, name), func(ti chart.TraceInfo) {
+		if ti.Stage == chart.RenderStageSeries {
+			fmt.Printf("end   %s %q (%d values): %v\n", name, ti.SeriesName, ti.Values, ti.Elapsed)
+			return
+		}
+		fmt.Printf("end   %s: %v\n", name, ti.Elapsed)
+	}
+}
+
+func main() {
+	graph := chart.Chart{
+		Tracer: spanTracer{},
+		Series: []chart.Series{
+			chart.ContinuousSeries{
+				Name:    "requests",
+				XValues: chart.LinearRange(1, 100),
+				YValues: chart.Seq{Sequence: chart.NewRandomSequence().WithLen(100).WithMin(0).WithMax(100)}.Values(),
+			},
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), spanKey{}, "request")
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	if err := graph.RenderContext(ctx, chart.PNG, f); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
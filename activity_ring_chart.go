@@ -0,0 +1,179 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// ActivityRingChart is a chart that draws concentric progress rings, one for each value, as a compact
+// view of several percentage KPIs; each ring is drawn clockwise from the top over a faint track, with
+// rounded ends, and its label beside its start.
+//
+// Values are fractions of a goal on the interval [0, 1], where 1 is a complete ring.
+type ActivityRingChart struct {
+	ChartFrame
+
+	RingStyle  Style
+	TrackStyle Style
+	LabelStyle Style
+
+	// RingWidth is the pixel thickness of the rings, it defaults to filling the outer three quarters of the circle.
+	RingWidth int
+	// RingSpacing is the pixel spacing between rings.
+	RingSpacing int
+
+	Values   []Value
+	Elements []Renderable
+}
+
+// GetHeight returns the chart height or the default value.
+func (arc ActivityRingChart) GetHeight() int {
+	if arc.Height == 0 {
+		return DefaultChartWidth
+	}
+	return arc.Height
+}
+
+// Box returns the chart bounds as a box, at the chart's own default size.
+func (arc ActivityRingChart) Box() Box {
+	return arc.box(arc.GetWidth(), arc.GetHeight())
+}
+
+// GetRingSpacing returns the spacing between rings or the default value.
+func (arc ActivityRingChart) GetRingSpacing() int {
+	if arc.RingSpacing == 0 {
+		return DefaultActivityRingSpacing
+	}
+	return arc.RingSpacing
+}
+
+// GetRingWidth returns the thickness of the rings, or the thickness at which the rings fill the outer
+// three quarters of a circle of a given radius.
+func (arc ActivityRingChart) GetRingWidth(radius int) int {
+	if arc.RingWidth > 0 {
+		return arc.RingWidth
+	}
+	count := len(arc.Values)
+	if count == 0 {
+		return 0
+	}
+	return MaxInt(1, ((radius*3)/4-(count-1)*arc.GetRingSpacing())/count)
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (arc ActivityRingChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(arc.Values) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one value"))
+	}
+	for _, v := range arc.Values {
+		if v.Value < 0 {
+			return newRenderError(RenderStageValidate, fmt.Errorf("activity ring chart values cannot be negative"))
+		}
+	}
+
+	width, height := arc.GetWidth(), arc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if arc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		arc.defaultFont = defaultFont
+	}
+	r.SetDPI(arc.GetDPI(DefaultDPI))
+
+	canvasBox := arc.getDefaultCanvasBox(r, width, height)
+
+	arc.drawBackground(r, width, height)
+	arc.drawCanvas(r, canvasBox)
+	arc.drawRings(r, canvasBox)
+	arc.drawTitle(r, width, height)
+	for _, a := range arc.Elements {
+		a(r, canvasBox, arc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+// drawRings draws the rings from the outside in, each starting at the top of the circle.
+func (arc ActivityRingChart) drawRings(r Renderer, canvasBox Box) {
+	cx, cy := canvasBox.Center()
+	outer := MinInt(canvasBox.Width(), canvasBox.Height()) >> 1
+	ringWidth := arc.GetRingWidth(outer)
+	labelStyle := arc.styleDefaultsLabels()
+
+	for index, v := range arc.Values {
+		radius := float64(outer - ringWidth>>1 - index*(ringWidth+arc.GetRingSpacing()))
+		if radius <= 0 {
+			return
+		}
+
+		ringStyle := v.Style.InheritFrom(arc.styleActivityRingValue(index, ringWidth))
+		trackStyle := arc.TrackStyle.InheritFrom(Style{
+			StrokeColor: ringStyle.GetStrokeColor().WithAlpha(48),
+			StrokeWidth: float64(ringWidth),
+		})
+
+		arc.drawArc(r, cx, cy, radius, _2pi, trackStyle)
+
+		delta := _2pi * math.Min(v.Value, 1)
+		if delta > 0 {
+			arc.drawArc(r, cx, cy, radius, delta, ringStyle)
+
+			// round off the ends of the ring.
+			capStyle := Style{
+				FillColor:   ringStyle.GetStrokeColor(),
+				StrokeColor: ringStyle.GetStrokeColor(),
+				StrokeWidth: 1,
+			}
+			for _, theta := range []float64{DefaultActivityRingStartAngle, DefaultActivityRingStartAngle + delta} {
+				x := cx + int(math.Round(radius*math.Cos(theta)))
+				y := cy + int(math.Round(radius*math.Sin(theta)))
+				capStyle.WriteToRenderer(r)
+				r.Circle(float64(ringWidth)/2, x, y)
+				r.FillStroke()
+			}
+		}
+
+		if len(v.Label) > 0 && !arc.LabelStyle.Hidden {
+			tb := Draw.MeasureText(r, v.Label, labelStyle)
+			Draw.Text(r, v.Label, cx-ringWidth-tb.Width(), cy-int(radius)+tb.Height()>>1, labelStyle)
+		}
+	}
+}
+
+// drawArc strokes an arc clockwise from the top of a circle, in segments of at most half a circle so
+// that renderers can draw complete rings.
+func (arc ActivityRingChart) drawArc(r Renderer, cx, cy int, radius, delta float64, style Style) {
+	style.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(cx, cy-int(radius))
+	start := DefaultActivityRingStartAngle
+	for delta > 0 {
+		segment := math.Min(delta, _pi)
+		r.ArcTo(cx, cy, radius, radius, start, segment)
+		start += segment
+		delta -= segment
+	}
+	r.Stroke()
+}
+
+func (arc ActivityRingChart) styleActivityRingValue(index, ringWidth int) Style {
+	return arc.RingStyle.InheritFrom(Style{
+		StrokeColor: arc.GetColorPalette().GetSeriesColor(index),
+		StrokeWidth: float64(ringWidth),
+	})
+}
+
+func (arc ActivityRingChart) styleDefaultsLabels() Style {
+	return arc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: arc.GetColorPalette().TextColor(),
+		Font:      arc.GetFont(),
+	})
+}
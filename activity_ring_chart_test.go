@@ -0,0 +1,68 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestActivityRingChart(t *testing.T) {
+	// replaced new assertions helper
+
+	arc := ActivityRingChart{
+		ChartFrame: ChartFrame{
+			Title: "test",
+		},
+		Values: []Value{
+			{Value: 0.8, Label: "Move"},
+			{Value: 1.2, Label: "Exercise"},
+			{Value: 0, Label: "Stand"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, arc.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestActivityRingChartRenderSVG(t *testing.T) {
+	// replaced new assertions helper
+
+	arc := ActivityRingChart{
+		ChartFrame: ChartFrame{
+			Width:  200,
+			Height: 200,
+		},
+		Values: []Value{{Value: 1}},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, arc.Render(SVG, b))
+
+	// the track and the complete ring are each drawn as two half circles.
+	testutil.AssertEqual(t, 4, strings.Count(b.String(), "A "))
+	// with a rounded cap at either end of the ring.
+	testutil.AssertEqual(t, 2, strings.Count(b.String(), "<circle"))
+}
+
+func TestActivityRingChartGetRingWidth(t *testing.T) {
+	// replaced new assertions helper
+
+	arc := ActivityRingChart{}
+	testutil.AssertZero(t, arc.GetRingWidth(100))
+
+	arc.Values = []Value{{Value: 0.5}, {Value: 0.5}}
+	testutil.AssertEqual(t, (75-DefaultActivityRingSpacing)/2, arc.GetRingWidth(100))
+
+	arc.RingWidth = 10
+	testutil.AssertEqual(t, 10, arc.GetRingWidth(100))
+}
+
+func TestActivityRingChartRenderInvalid(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, ActivityRingChart{}.Render(PNG, bytes.NewBuffer(nil)))
+	testutil.AssertNotNil(t, ActivityRingChart{Values: []Value{{Value: -1}}}.Render(PNG, bytes.NewBuffer(nil)))
+}
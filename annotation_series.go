@@ -7,7 +7,8 @@ import (
 
 // Interface Assertions.
 var (
-	_ Series = (*AnnotationSeries)(nil)
+	_ Series         = (*AnnotationSeries)(nil)
+	_ MeasuredSeries = (*AnnotationSeries)(nil)
 )
 
 // AnnotationSeries is a series of labels on the chart.
@@ -89,3 +90,9 @@ func (as AnnotationSeries) Validate() error {
 	}
 	return nil
 }
+
+// CopySeries returns a copy of the series that does not share its annotations with the original.
+func (as AnnotationSeries) CopySeries() Series {
+	as.Annotations = append([]Value2(nil), as.Annotations...)
+	return as
+}
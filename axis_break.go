@@ -0,0 +1,129 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Range         = (*BrokenRange)(nil)
+	_ TicksProvider = (*BrokenRange)(nil)
+)
+
+// AxisBreak is an interval of values cut out of a y-axis, e.g. to keep a single outlier
+// from flattening the rest of a chart.
+// The axis is drawn with a zig-zag marker on either side of the break.
+type AxisBreak struct {
+	Min   float64
+	Max   float64
+	Style Style
+}
+
+// IsZero returns if the break has been set or not.
+func (ab AxisBreak) IsZero() bool {
+	return ab.Min == 0 && ab.Max == 0
+}
+
+// Render draws the break marker across an axis line at x, at each edge of the break.
+func (ab AxisBreak) Render(r Renderer, x, y1, y2 int, defaults Style) {
+	style := ab.Style.InheritFrom(defaults)
+	style.GetStrokeOptions().WriteToRenderer(r)
+
+	half := DefaultAxisBreakWidth >> 1
+	for _, y := range []int{y1, y2} {
+		r.MoveTo(x-half, y)
+		for step := 1; step <= 4; step++ {
+			offset := DefaultAxisBreakAmplitude
+			if step%2 == 0 {
+				offset = -offset
+			}
+			if step == 4 {
+				offset = 0
+			}
+			r.LineTo(x-half+(step*DefaultAxisBreakWidth)/4, y+offset)
+		}
+		r.Stroke()
+	}
+}
+
+// BrokenRange is a continuous range with an interval of values cut out of it,
+// which is replaced with a small gap in the domain.
+type BrokenRange struct {
+	ContinuousRange
+	Break AxisBreak
+}
+
+// HasBreak returns if the break falls within the range.
+func (br BrokenRange) HasBreak() bool {
+	return br.Break.Min < br.Break.Max && br.Break.Min > br.Min && br.Break.Max < br.Max
+}
+
+// Clone returns a copy of the range.
+func (br BrokenRange) Clone() Range {
+	br.Break.Style = br.Break.Style.Clone()
+	return &br
+}
+
+// String returns a simple string for the BrokenRange.
+func (br BrokenRange) String() string {
+	return fmt.Sprintf("BrokenRange [%.2f,%.2f] => %d, break [%.2f,%.2f]", br.Min, br.Max, br.Domain, br.Break.Min, br.Break.Max)
+}
+
+// Translate maps a given value into the BrokenRange space, values within the break are
+// spread across the gap.
+func (br BrokenRange) Translate(value float64) int {
+	if !br.HasBreak() {
+		return br.ContinuousRange.Translate(value)
+	}
+
+	lower, gap, scale := br.getSegments()
+	var translated float64
+	if value <= br.Break.Min {
+		translated = (value - br.Min) * scale
+	} else if value >= br.Break.Max {
+		translated = float64(lower+gap) + (value-br.Break.Max)*scale
+	} else {
+		translated = float64(lower) + float64(gap)*(value-br.Break.Min)/(br.Break.Max-br.Break.Min)
+	}
+
+	if br.IsDescending() {
+		return br.Domain - int(math.Ceil(translated))
+	}
+	return int(math.Ceil(translated))
+}
+
+// GetTicks generates vertical ticks for each side of the break.
+func (br BrokenRange) GetTicks(r Renderer, defaults Style, vf ValueFormatter) []Tick {
+	if !br.HasBreak() {
+		return GenerateContinuousTicks(r, &br.ContinuousRange, true, defaults, vf)
+	}
+
+	lower, gap, _ := br.getSegments()
+	below := &ContinuousRange{Min: br.Min, Max: br.Break.Min, Domain: lower, Descending: br.Descending}
+	above := &ContinuousRange{Min: br.Break.Max, Max: br.Max, Domain: br.Domain - lower - gap, Descending: br.Descending}
+
+	// the labels at the edges of the break would overlap, so leave them out.
+	var ticks []Tick
+	for _, t := range append(GenerateContinuousTicks(r, below, true, defaults, vf), GenerateContinuousTicks(r, above, true, defaults, vf)...) {
+		if t.Value != br.Break.Min && t.Value != br.Break.Max {
+			ticks = append(ticks, t)
+		}
+	}
+	return ticks
+}
+
+// GetBreakEdges returns the domain positions of either edge of the break.
+func (br BrokenRange) GetBreakEdges() (lower, upper int) {
+	return br.Translate(br.Break.Min), br.Translate(br.Break.Max)
+}
+
+// getSegments returns the domain below the break, the gap in the domain for the break,
+// and the scale from values to domain either side of it.
+func (br BrokenRange) getSegments() (lower, gap int, scale float64) {
+	gap = MinInt(DefaultAxisBreakGap, br.Domain>>2)
+	delta := br.GetDelta() - (br.Break.Max - br.Break.Min)
+	scale = float64(br.Domain-gap) / delta
+	lower = int(math.Ceil((br.Break.Min - br.Min) * scale))
+	return
+}
@@ -0,0 +1,85 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestBrokenRangeTranslate(t *testing.T) {
+	// replaced new assertions helper
+
+	br := &BrokenRange{
+		ContinuousRange: ContinuousRange{Min: 0, Max: 100, Domain: 110},
+		Break:           AxisBreak{Min: 40, Max: 90},
+	}
+	testutil.AssertTrue(t, br.HasBreak())
+
+	// 50 values share 100 pixels once the 10 pixel gap is taken out.
+	testutil.AssertEqual(t, 0, br.Translate(0))
+	testutil.AssertEqual(t, 80, br.Translate(40))
+	testutil.AssertEqual(t, 85, br.Translate(65))
+	testutil.AssertEqual(t, 90, br.Translate(90))
+	testutil.AssertEqual(t, 110, br.Translate(100))
+
+	lower, upper := br.GetBreakEdges()
+	testutil.AssertEqual(t, 80, lower)
+	testutil.AssertEqual(t, 90, upper)
+
+	br.Descending = true
+	testutil.AssertEqual(t, 110, br.Translate(0))
+	testutil.AssertEqual(t, 0, br.Translate(100))
+
+	// a break outside of the range is ignored.
+	outside := &BrokenRange{
+		ContinuousRange: ContinuousRange{Min: 0, Max: 100, Domain: 100},
+		Break:           AxisBreak{Min: 140, Max: 190},
+	}
+	testutil.AssertFalse(t, outside.HasBreak())
+	testutil.AssertEqual(t, 50, outside.Translate(50))
+
+	clone := CloneRange(br).(*BrokenRange)
+	clone.Break.Min = 10
+	testutil.AssertEqual(t, 40.0, br.Break.Min)
+}
+
+func TestBrokenRangeTicks(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(100, 400)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	br := &BrokenRange{
+		ContinuousRange: ContinuousRange{Min: 0, Max: 1000, Domain: 400},
+		Break:           AxisBreak{Min: 100, Max: 900},
+	}
+	ticks := br.GetTicks(r, Style{Font: f, FontSize: 10}, nil)
+	testutil.AssertNotEmpty(t, ticks)
+	for _, tick := range ticks {
+		testutil.AssertTrue(t, tick.Value < 100 || tick.Value > 900)
+	}
+}
+
+func TestChartRenderAxisBreak(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		YAxis: YAxis{
+			Break: AxisBreak{Min: 30, Max: 950},
+		},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3, 4}, YValues: []float64{3, 1000, 12, 5}},
+		},
+	}
+	_, yr, _ := c.getRanges()
+	br, isBroken := yr.(*BrokenRange)
+	testutil.AssertTrue(t, isBroken)
+	testutil.AssertTrue(t, br.HasBreak())
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), "1000.00")
+}
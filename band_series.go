@@ -0,0 +1,91 @@
+package chart
+
+import "fmt"
+
+const (
+	// DefaultBandFillAlpha is the default alpha of a band's fill, which takes the color of its stroke.
+	DefaultBandFillAlpha = 64
+)
+
+// Interface Assertions.
+var (
+	_ Series                    = (*BandSeries)(nil)
+	_ FullBoundedValuesProvider = (*BandSeries)(nil)
+)
+
+// BandSeries fills the band between an upper and a lower value at each x-value,
+// e.g. for confidence intervals, min/max envelopes or forecast cones.
+type BandSeries struct {
+	Name  string
+	Style Style
+
+	YAxis YAxisType
+
+	XValues     []float64
+	UpperValues []float64
+	LowerValues []float64
+}
+
+// GetName returns the name of the time series.
+func (bs BandSeries) GetName() string {
+	return bs.Name
+}
+
+// GetStyle returns the line style.
+func (bs BandSeries) GetStyle() Style {
+	return bs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bs BandSeries) GetYAxis() YAxisType {
+	return bs.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (bs BandSeries) Len() int {
+	return len(bs.XValues)
+}
+
+// GetBoundedValues gets the x-value and the upper and lower values at a given index.
+func (bs BandSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	return bs.XValues[index], bs.UpperValues[index], bs.LowerValues[index]
+}
+
+// GetBoundedLastValues gets the last x-value and upper and lower values.
+func (bs BandSeries) GetBoundedLastValues() (x, y1, y2 float64) {
+	if len(bs.XValues) == 0 {
+		return
+	}
+	return bs.GetBoundedValues(len(bs.XValues) - 1)
+}
+
+func (bs BandSeries) styleDefaults(defaults Style) Style {
+	return defaults.InheritFrom(Style{
+		FillColor: defaults.StrokeColor.WithAlpha(DefaultBandFillAlpha),
+	})
+}
+
+// Render renders the series.
+func (bs BandSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := bs.Style.InheritFrom(bs.styleDefaults(defaults))
+	Draw.BoundedSeries(r, canvasBox, xrange, yrange, style, bs)
+}
+
+// Validate validates the series.
+func (bs BandSeries) Validate() error {
+	if len(bs.XValues) == 0 {
+		return fmt.Errorf("band series; must have xvalues set")
+	}
+	if len(bs.UpperValues) != len(bs.XValues) || len(bs.LowerValues) != len(bs.XValues) {
+		return fmt.Errorf("band series; must have same length upper and lower values as xvalues")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its values with the original.
+func (bs BandSeries) CopySeries() Series {
+	bs.XValues = append([]float64(nil), bs.XValues...)
+	bs.UpperValues = append([]float64(nil), bs.UpperValues...)
+	bs.LowerValues = append([]float64(nil), bs.LowerValues...)
+	return bs
+}
@@ -0,0 +1,44 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestBandSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, BandSeries{}.Validate())
+	testutil.AssertNotNil(t, BandSeries{XValues: []float64{1, 2}, UpperValues: []float64{1, 2}, LowerValues: []float64{1}}.Validate())
+
+	bs := BandSeries{
+		XValues:     []float64{1, 2, 3},
+		UpperValues: []float64{4, 5, 6},
+		LowerValues: []float64{1, 2, 1},
+	}
+	testutil.AssertNil(t, bs.Validate())
+	testutil.AssertEqual(t, 3, bs.Len())
+
+	x, y1, y2 := bs.GetBoundedLastValues()
+	testutil.AssertEqual(t, 3.0, x)
+	testutil.AssertEqual(t, 6.0, y1)
+	testutil.AssertEqual(t, 1.0, y2)
+
+	style := bs.styleDefaults(Style{StrokeColor: drawing.ColorRed})
+	testutil.AssertEqual(t, drawing.ColorRed.WithAlpha(DefaultBandFillAlpha), style.FillColor)
+
+	copied := bs.CopySeries().(BandSeries)
+	copied.UpperValues[0] = 10
+	testutil.AssertEqual(t, 4.0, bs.UpperValues[0])
+
+	c := Chart{Series: []Series{bs}}
+	_, yrange, _ := c.getRanges()
+	testutil.AssertEqual(t, 1.0, yrange.GetMin())
+	testutil.AssertEqual(t, 6.0, yrange.GetMax())
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+}
@@ -9,6 +9,12 @@ import (
 	"github.com/golang/freetype/truetype"
 )
 
+// BarGroup is a category of a grouped bar chart, with a value for each series drawn side by side.
+type BarGroup struct {
+	Label  string
+	Values []Value
+}
+
 // BarChart is a chart that draws bars on a range.
 type BarChart struct {
 	Title      string
@@ -29,6 +35,8 @@ type BarChart struct {
 	YAxis YAxis
 
 	BarSpacing int
+	// GroupBarSpacing is the spacing between the bars within each of the `Groups`.
+	GroupBarSpacing int
 
 	UseBaseValue bool
 	BaseValue    float64
@@ -36,7 +44,10 @@ type BarChart struct {
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
-	Bars     []Value
+	Bars []Value
+	// Groups draws a group of bars for each category in place of `Bars`, where the bars
+	// at the same position in each group share a color, e.g. a bar per year for each month.
+	Groups   []BarGroup
 	Elements []Renderable
 }
 
@@ -88,21 +99,29 @@ func (bc BarChart) GetBarWidth() int {
 	return bc.BarWidth
 }
 
+// GetGroupBarSpacing returns the spacing between bars within a group.
+func (bc BarChart) GetGroupBarSpacing() int {
+	if bc.GroupBarSpacing == 0 {
+		return DefaultGroupBarSpacing
+	}
+	return bc.GroupBarSpacing
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
 func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
-	if len(bc.Bars) == 0 {
-		return errors.New("please provide at least one bar")
+	if len(bc.Bars) == 0 && len(bc.Groups) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one bar"))
 	}
 
 	r, err := rp(bc.GetWidth(), bc.GetHeight())
 	if err != nil {
-		return err
+		return newRenderError(RenderStageRenderer, err)
 	}
 
 	if bc.Font == nil {
 		defaultFont, err := GetDefaultFont()
 		if err != nil {
-			return err
+			return newRenderError(RenderStageFonts, err)
 		}
 		bc.defaultFont = defaultFont
 	}
@@ -118,7 +137,7 @@ func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 	canvasBox = bc.getDefaultCanvasBox()
 	yr = bc.getRanges()
 	if yr.GetMax()-yr.GetMin() == 0 {
-		return fmt.Errorf("invalid data range; cannot be zero")
+		return newRenderError(RenderStageRanges, fmt.Errorf("invalid data range; cannot be zero"))
 	}
 	yr = bc.setRangeDomains(canvasBox, yr)
 	yf = bc.getValueFormatters()
@@ -138,17 +157,20 @@ func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 		a(r, canvasBox, bc.styleDefaultsElements())
 	}
 
-	return r.Save(w)
+	return newRenderError(RenderStageEncode, r.Save(w))
 }
 
 func (bc BarChart) drawCanvas(r Renderer, canvasBox Box) {
+	if bc.Canvas.Hidden {
+		return
+	}
 	Draw.Box(r, canvasBox, bc.getCanvasStyle())
 }
 
 func (bc BarChart) getRanges() Range {
 	var yrange Range
 	if bc.YAxis.Range != nil && !bc.YAxis.Range.IsZero() {
-		yrange = bc.YAxis.Range
+		yrange = CloneRange(bc.YAxis.Range)
 	} else {
 		yrange = &ContinuousRange{}
 	}
@@ -169,9 +191,11 @@ func (bc BarChart) getRanges() Range {
 	}
 
 	min, max := math.MaxFloat64, -math.MaxFloat64
-	for _, b := range bc.Bars {
-		min = math.Min(b.Value, min)
-		max = math.Max(b.Value, max)
+	for _, g := range bc.getGroups() {
+		for _, b := range g.Values {
+			min = math.Min(b.Value, min)
+			max = math.Max(b.Value, max)
+		}
 	}
 
 	yrange.SetMin(min)
@@ -181,45 +205,54 @@ func (bc BarChart) getRanges() Range {
 }
 
 func (bc BarChart) drawBackground(r Renderer) {
-	Draw.Box(r, Box{
-		Right:  bc.GetWidth(),
-		Bottom: bc.GetHeight(),
-	}, bc.getBackgroundStyle())
+	if bc.Background.Hidden {
+		return
+	}
+	drawBackground(r, bc.GetWidth(), bc.GetHeight(), bc.getBackgroundStyle())
 }
 
 func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
 	xoffset := canvasBox.Left
 
 	width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
+	groupWidth := bc.calculateGroupWidth(width)
+	groupSpacing := bc.GetGroupBarSpacing()
 	bs2 := spacing >> 1
 
 	var barBox Box
 	var bxl, bxr, by int
-	for index, bar := range bc.Bars {
-		bxl = xoffset + bs2
-		bxr = bxl + width
-
-		by = canvasBox.Bottom - yr.Translate(bar.Value)
-
-		if bc.UseBaseValue {
-			barBox = Box{
-				Top:    by,
-				Left:   bxl,
-				Right:  bxr,
-				Bottom: canvasBox.Bottom - yr.Translate(bc.BaseValue),
+	for groupIndex, group := range bc.getGroups() {
+		for index, bar := range group.Values {
+			bxl = xoffset + bs2 + index*(width+groupSpacing)
+			bxr = bxl + width
+
+			by = canvasBox.Bottom - yr.Translate(bar.Value)
+
+			if bc.UseBaseValue {
+				barBox = Box{
+					Top:    by,
+					Left:   bxl,
+					Right:  bxr,
+					Bottom: canvasBox.Bottom - yr.Translate(bc.BaseValue),
+				}
+			} else {
+				barBox = Box{
+					Top:    by,
+					Left:   bxl,
+					Right:  bxr,
+					Bottom: canvasBox.Bottom,
+				}
 			}
-		} else {
-			barBox = Box{
-				Top:    by,
-				Left:   bxl,
-				Right:  bxr,
-				Bottom: canvasBox.Bottom,
+
+			// bars are colored by their position in a group, or by the group when they aren't grouped.
+			colorIndex := index
+			if len(bc.Groups) == 0 {
+				colorIndex = groupIndex
 			}
+			Draw.Box(r, barBox, bar.Style.InheritFrom(bc.styleDefaultsBar(colorIndex)))
 		}
 
-		Draw.Box(r, barBox, bar.Style.InheritFrom(bc.styleDefaultsBar(index)))
-
-		xoffset += width + spacing
+		xoffset += groupWidth + spacing
 	}
 }
 
@@ -229,6 +262,7 @@ func (bc BarChart) drawXAxis(r Renderer, canvasBox Box) {
 		axisStyle.WriteToRenderer(r)
 
 		width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
+		groupWidth := bc.calculateGroupWidth(width)
 
 		r.MoveTo(canvasBox.Left, canvasBox.Bottom)
 		r.LineTo(canvasBox.Right, canvasBox.Bottom)
@@ -238,26 +272,27 @@ func (bc BarChart) drawXAxis(r Renderer, canvasBox Box) {
 		r.LineTo(canvasBox.Left, canvasBox.Bottom+DefaultVerticalTickHeight)
 		r.Stroke()
 
+		groups := bc.getGroups()
 		cursor := canvasBox.Left
-		for index, bar := range bc.Bars {
+		for index, group := range groups {
 			barLabelBox := Box{
 				Top:    canvasBox.Bottom + DefaultXAxisMargin,
 				Left:   cursor,
-				Right:  cursor + width + spacing,
+				Right:  cursor + groupWidth + spacing,
 				Bottom: bc.GetHeight(),
 			}
 
-			if len(bar.Label) > 0 {
-				Draw.TextWithin(r, bar.Label, barLabelBox, axisStyle)
+			if len(group.Label) > 0 {
+				Draw.TextWithin(r, group.Label, barLabelBox, axisStyle)
 			}
 
 			axisStyle.WriteToRenderer(r)
-			if index < len(bc.Bars)-1 {
+			if index < len(groups)-1 {
 				r.MoveTo(barLabelBox.Right, canvasBox.Bottom)
 				r.LineTo(barLabelBox.Right, canvasBox.Bottom+DefaultVerticalTickHeight)
 				r.Stroke()
 			}
-			cursor += width + spacing
+			cursor += groupWidth + spacing
 		}
 	}
 }
@@ -306,7 +341,7 @@ func (bc BarChart) drawTitle(r Renderer) {
 		textHeight := textBox.Height()
 
 		titleX := (bc.GetWidth() >> 1) - (textWidth >> 1)
-		titleY := bc.TitleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+		titleY := bc.Background.Margin.GetTop() + bc.TitleStyle.Margin.GetTop(bc.TitleStyle.Padding.GetTop(DefaultTitleTop)) + textHeight
 
 		r.Text(bc.Title, titleX, titleY)
 	}
@@ -351,12 +386,45 @@ func (bc BarChart) getAxesTicks(r Renderer, yr Range, yf ValueFormatter) (yticks
 	return
 }
 
+// getGroups returns the groups of bars, where ungrouped bars are each a group of one.
+func (bc BarChart) getGroups() []BarGroup {
+	if len(bc.Groups) > 0 {
+		return bc.Groups
+	}
+	groups := make([]BarGroup, len(bc.Bars))
+	for index, bar := range bc.Bars {
+		groups[index] = BarGroup{Label: bar.Label, Values: []Value{bar}}
+	}
+	return groups
+}
+
+// getGroupSize returns the number of bars in the largest group.
+func (bc BarChart) getGroupSize() (size int) {
+	if len(bc.Groups) == 0 {
+		return 1
+	}
+	for _, g := range bc.Groups {
+		size = MaxInt(size, len(g.Values))
+	}
+	return
+}
+
+// calculateGroupWidth returns the width of a group of bars of a given width.
+func (bc BarChart) calculateGroupWidth(barWidth int) int {
+	size := bc.getGroupSize()
+	if size < 2 {
+		return barWidth
+	}
+	return size*barWidth + (size-1)*bc.GetGroupBarSpacing()
+}
+
 func (bc BarChart) calculateEffectiveBarSpacing(canvasBox Box) int {
+	count := len(bc.getGroups())
 	totalWithBaseSpacing := bc.calculateTotalBarWidth(bc.GetBarWidth(), bc.GetBarSpacing())
 	if totalWithBaseSpacing > canvasBox.Width() {
-		lessBarWidths := canvasBox.Width() - (len(bc.Bars) * bc.GetBarWidth())
+		lessBarWidths := canvasBox.Width() - (count * bc.calculateGroupWidth(bc.GetBarWidth()))
 		if lessBarWidths > 0 {
-			return int(math.Ceil(float64(lessBarWidths) / float64(len(bc.Bars))))
+			return int(math.Ceil(float64(lessBarWidths) / float64(count)))
 		}
 		return 0
 	}
@@ -364,11 +432,17 @@ func (bc BarChart) calculateEffectiveBarSpacing(canvasBox Box) int {
 }
 
 func (bc BarChart) calculateEffectiveBarWidth(canvasBox Box, spacing int) int {
+	count := len(bc.getGroups())
 	totalWithBaseWidth := bc.calculateTotalBarWidth(bc.GetBarWidth(), spacing)
 	if totalWithBaseWidth > canvasBox.Width() {
-		totalLessBarSpacings := canvasBox.Width() - (len(bc.Bars) * spacing)
+		totalLessBarSpacings := canvasBox.Width() - (count * spacing)
 		if totalLessBarSpacings > 0 {
-			return int(math.Ceil(float64(totalLessBarSpacings) / float64(len(bc.Bars))))
+			groupWidth := int(math.Ceil(float64(totalLessBarSpacings) / float64(count)))
+			size := bc.getGroupSize()
+			if size < 2 {
+				return groupWidth
+			}
+			return MaxInt(0, (groupWidth-(size-1)*bc.GetGroupBarSpacing())/size)
 		}
 		return 0
 	}
@@ -376,7 +450,7 @@ func (bc BarChart) calculateEffectiveBarWidth(canvasBox Box, spacing int) int {
 }
 
 func (bc BarChart) calculateTotalBarWidth(barWidth, spacing int) int {
-	return len(bc.Bars) * (barWidth + spacing)
+	return len(bc.getGroups()) * (bc.calculateGroupWidth(barWidth) + spacing)
 }
 
 func (bc BarChart) calculateScaledTotalWidth(canvasBox Box) (width, spacing, total int) {
@@ -398,15 +472,15 @@ func (bc BarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range,
 		axisStyle.WriteToRenderer(r)
 
 		cursor := canvasBox.Left
-		for _, bar := range bc.Bars {
-			if len(bar.Label) > 0 {
+		for _, group := range bc.getGroups() {
+			if len(group.Label) > 0 {
 				barLabelBox := Box{
 					Top:    canvasBox.Bottom + DefaultXAxisMargin,
 					Left:   cursor,
-					Right:  cursor + bc.GetBarWidth() + bc.GetBarSpacing(),
+					Right:  cursor + bc.calculateGroupWidth(bc.GetBarWidth()) + bc.GetBarSpacing(),
 					Bottom: bc.GetHeight(),
 				}
-				lines := Text.WrapFit(r, bar.Label, barLabelBox.Width(), axisStyle)
+				lines := Text.WrapFit(r, group.Label, barLabelBox.Width(), axisStyle)
 				linesBox := Text.MeasureLines(r, lines, axisStyle)
 
 				xaxisHeight = MinInt(linesBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
@@ -433,12 +507,12 @@ func (bc BarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range,
 
 // box returns the chart bounds as a box.
 func (bc BarChart) box() Box {
-	dpr := bc.Background.Padding.GetRight(10)
-	dpb := bc.Background.Padding.GetBottom(50)
+	dpr := bc.Background.Margin.GetRight() + bc.Background.Padding.GetRight(10)
+	dpb := bc.Background.Margin.GetBottom() + bc.Background.Padding.GetBottom(50)
 
 	return Box{
-		Top:    bc.Background.Padding.GetTop(20),
-		Left:   bc.Background.Padding.GetLeft(20),
+		Top:    bc.Background.Margin.GetTop() + bc.Background.Padding.GetTop(20),
+		Left:   bc.Background.Margin.GetLeft() + bc.Background.Padding.GetLeft(20),
 		Right:  bc.GetWidth() - dpr,
 		Bottom: bc.GetHeight() - dpb,
 	}
@@ -308,3 +308,51 @@ func TestBarChatGetTitleFontSize(t *testing.T) {
 	size = BarChart{Width: 128, Height: 128}.getTitleFontSize()
 	testutil.AssertEqual(t, 10, size)
 }
+
+func TestBarChartRenderGroups(t *testing.T) {
+	// replaced new assertions helper
+
+	bc := BarChart{
+		Width: 1024,
+		Title: "Test Title",
+		Groups: []BarGroup{
+			{Label: "Jan", Values: []Value{{Value: 1.0}, {Value: 2.0}}},
+			{Label: "Feb", Values: []Value{{Value: 3.0}, {Value: 4.0}}},
+			{Label: "Mar", Values: []Value{{Value: 5.0}, {Value: 6.0}}},
+		},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	err := bc.Render(PNG, buf)
+	testutil.AssertNil(t, err)
+	testutil.AssertNotZero(t, buf.Len())
+
+	yr := bc.getRanges()
+	testutil.AssertEqual(t, 1.0, yr.GetMin())
+	testutil.AssertEqual(t, 6.0, yr.GetMax())
+}
+
+func TestBarChartCalculateGroupWidth(t *testing.T) {
+	// replaced new assertions helper
+
+	bc := BarChart{
+		Width:           1024,
+		BarWidth:        10,
+		GroupBarSpacing: 2,
+		Groups: []BarGroup{
+			{Label: "One", Values: []Value{{Value: 1.0}, {Value: 2.0}, {Value: 3.0}}},
+			{Label: "Two", Values: []Value{{Value: 4.0}, {Value: 5.0}}},
+		},
+	}
+
+	testutil.AssertEqual(t, 3, bc.getGroupSize())
+	testutil.AssertEqual(t, 34, bc.calculateGroupWidth(10))
+	testutil.AssertEqual(t, 2*(34+DefaultBarSpacing), bc.calculateTotalBarWidth(10, DefaultBarSpacing))
+
+	bc.BarWidth = 250
+	cb := bc.box()
+	bw, bs, total := bc.calculateScaledTotalWidth(cb)
+	testutil.AssertZero(t, bs)
+	testutil.AssertTrue(t, bw < 250)
+	testutil.AssertTrue(t, total <= cb.Width())
+}
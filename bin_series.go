@@ -0,0 +1,86 @@
+package chart
+
+import "fmt"
+
+// Interface Assertions.
+var (
+	_ Series                = (*BinSeries)(nil)
+	_ BoundedValuesProvider = (*BinSeries)(nil)
+)
+
+// BinSeries draws a count for each of a set of bins as a bar spanning the bin, e.g. the counts of a `Histogram`.
+type BinSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// Edges are the ascending edges of the bins, one more than the number of counts.
+	Edges  []float64
+	Counts []float64
+}
+
+// GetName returns the name of the time series.
+func (bs BinSeries) GetName() string {
+	return bs.Name
+}
+
+// GetStyle returns the line style.
+func (bs BinSeries) GetStyle() Style {
+	return bs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bs BinSeries) GetYAxis() YAxisType {
+	return bs.YAxis
+}
+
+// Len returns the number of bounded values, the left and right edge of each bin.
+func (bs BinSeries) Len() int {
+	return len(bs.Counts) << 1
+}
+
+// GetBoundedValues returns the left or right edge of a bin, with its count and zero.
+func (bs BinSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	bin := index >> 1
+	x = bs.Edges[bin+index%2]
+	y1 = bs.Counts[bin]
+	return
+}
+
+// Render renders the series.
+func (bs BinSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := bs.Style.InheritFrom(defaults.InheritFrom(Style{
+		StrokeWidth: 1.0,
+	}))
+	if style.FillColor.IsZero() {
+		style.FillColor = style.GetStrokeColor().WithAlpha(192)
+	}
+
+	bottom := canvasBox.Bottom - yrange.Translate(0)
+	for bin, count := range bs.Counts {
+		Draw.Box(r, Box{
+			Top:    canvasBox.Bottom - yrange.Translate(count),
+			Left:   canvasBox.Left + xrange.Translate(bs.Edges[bin]),
+			Right:  canvasBox.Left + xrange.Translate(bs.Edges[bin+1]),
+			Bottom: bottom,
+		}, style)
+	}
+}
+
+// Validate validates the series.
+func (bs BinSeries) Validate() error {
+	if len(bs.Counts) == 0 {
+		return fmt.Errorf("bin series must have counts set")
+	}
+	if len(bs.Edges) != len(bs.Counts)+1 {
+		return fmt.Errorf("bin series must have one more edge than counts")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its values with the original.
+func (bs BinSeries) CopySeries() Series {
+	bs.Edges = append([]float64(nil), bs.Edges...)
+	bs.Counts = append([]float64(nil), bs.Counts...)
+	return bs
+}
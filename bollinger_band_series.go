@@ -133,3 +133,10 @@ func (bbs BollingerBandsSeries) Validate() error {
 	}
 	return nil
 }
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (bbs BollingerBandsSeries) CopySeries() Series {
+	bbs.InnerSeries = copyValuesProvider(bbs.InnerSeries)
+	bbs.valueBuffer = nil
+	return &bbs
+}
@@ -0,0 +1,177 @@
+package chart
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+const (
+	// DefaultBootstrapSamples is the default number of resamples used to estimate a bootstrap band.
+	DefaultBootstrapSamples = 200
+	// DefaultBootstrapConfidence is the default confidence level of a bootstrap band.
+	DefaultBootstrapConfidence = 0.95
+)
+
+// Interface Assertions.
+var (
+	_ Series                    = (*BootstrapBandSeries)(nil)
+	_ FullBoundedValuesProvider = (*BootstrapBandSeries)(nil)
+)
+
+// BootstrapBandSeries is a confidence band for a statistic (the mean by default) of a trailing
+// window of an inner series, estimated by resampling each window with replacement.
+type BootstrapBandSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Period     int
+	Samples    int
+	Confidence float64
+	Statistic  func(values ...float64) float64
+
+	// Seed seeds the resampling, so that a given series always produces the same band.
+	Seed int64
+
+	InnerSeries ValuesProvider
+
+	cache [][2]float64
+}
+
+// GetName returns the name of the time series.
+func (bbs BootstrapBandSeries) GetName() string {
+	return bbs.Name
+}
+
+// GetStyle returns the line style.
+func (bbs BootstrapBandSeries) GetStyle() Style {
+	return bbs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bbs BootstrapBandSeries) GetYAxis() YAxisType {
+	return bbs.YAxis
+}
+
+// GetPeriod returns the window size.
+func (bbs BootstrapBandSeries) GetPeriod() int {
+	if bbs.Period == 0 {
+		return DefaultSimpleMovingAveragePeriod
+	}
+	return bbs.Period
+}
+
+// GetSamples returns the number of resamples per window.
+func (bbs BootstrapBandSeries) GetSamples() int {
+	if bbs.Samples == 0 {
+		return DefaultBootstrapSamples
+	}
+	return bbs.Samples
+}
+
+// GetConfidence returns the confidence level of the band on the interval (0, 1).
+func (bbs BootstrapBandSeries) GetConfidence() float64 {
+	if bbs.Confidence == 0 {
+		return DefaultBootstrapConfidence
+	}
+	return bbs.Confidence
+}
+
+// GetStatistic returns the statistic the band is computed for.
+func (bbs BootstrapBandSeries) GetStatistic() func(values ...float64) float64 {
+	if bbs.Statistic == nil {
+		return Mean
+	}
+	return bbs.Statistic
+}
+
+// Len returns the number of elements in the series.
+func (bbs BootstrapBandSeries) Len() int {
+	if bbs.InnerSeries == nil {
+		return 0
+	}
+	return bbs.InnerSeries.Len()
+}
+
+// GetBoundedValues gets the bounded value for the series.
+func (bbs *BootstrapBandSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	if bbs.InnerSeries == nil || bbs.InnerSeries.Len() == 0 {
+		return
+	}
+	if len(bbs.cache) == 0 {
+		bbs.ensureCachedValues()
+	}
+	x, _ = bbs.InnerSeries.GetValues(index)
+	y1, y2 = bbs.cache[index][1], bbs.cache[index][0]
+	return
+}
+
+// GetBoundedLastValues returns the last bounded value for the series.
+func (bbs *BootstrapBandSeries) GetBoundedLastValues() (x, y1, y2 float64) {
+	if bbs.InnerSeries == nil || bbs.InnerSeries.Len() == 0 {
+		return
+	}
+	return bbs.GetBoundedValues(bbs.InnerSeries.Len() - 1)
+}
+
+func (bbs *BootstrapBandSeries) ensureCachedValues() {
+	seriesLength := bbs.InnerSeries.Len()
+	period := bbs.GetPeriod()
+	samples := bbs.GetSamples()
+	statistic := bbs.GetStatistic()
+	tail := (1.0 - bbs.GetConfidence()) / 2.0
+	random := rand.New(rand.NewSource(bbs.Seed))
+
+	values := make([]float64, seriesLength)
+	for index := range values {
+		_, values[index] = bbs.InnerSeries.GetValues(index)
+	}
+
+	bbs.cache = make([][2]float64, seriesLength)
+	stats := make([]float64, samples)
+	for index := range values {
+		window := values[MaxInt(0, index-period+1) : index+1]
+		resample := make([]float64, len(window))
+		for sample := range stats {
+			for i := range resample {
+				resample[i] = window[random.Intn(len(window))]
+			}
+			stats[sample] = statistic(resample...)
+		}
+		sort.Float64s(stats)
+		bbs.cache[index] = [2]float64{
+			stats[int(tail*float64(samples-1))],
+			stats[int((1.0-tail)*float64(samples-1))],
+		}
+	}
+}
+
+// Render renders the series.
+func (bbs *BootstrapBandSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	s := bbs.Style.InheritFrom(defaults.InheritFrom(Style{
+		StrokeWidth: 1.0,
+		StrokeColor: DefaultAxisColor.WithAlpha(64),
+		FillColor:   DefaultAxisColor.WithAlpha(32),
+	}))
+
+	Draw.BoundedSeries(r, canvasBox, xrange, yrange, s, bbs)
+}
+
+// Validate validates the series.
+func (bbs BootstrapBandSeries) Validate() error {
+	if bbs.InnerSeries == nil {
+		return fmt.Errorf("bootstrap band series requires InnerSeries to be set")
+	}
+	if confidence := bbs.GetConfidence(); confidence <= 0 || confidence >= 1 {
+		return fmt.Errorf("bootstrap band series requires a confidence on the interval (0, 1)")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (bbs BootstrapBandSeries) CopySeries() Series {
+	bbs.InnerSeries = copyValuesProvider(bbs.InnerSeries)
+	bbs.cache = nil
+	return &bbs
+}
@@ -0,0 +1,53 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestBootstrapBandSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	inner := ContinuousSeries{
+		XValues: LinearRange(1, 50),
+		YValues: LinearRangeWithStep(3, 150, 3),
+	}
+
+	bbs := &BootstrapBandSeries{InnerSeries: inner, Period: 10, Seed: 7}
+	testutil.AssertNil(t, bbs.Validate())
+	testutil.AssertEqual(t, 50, bbs.Len())
+
+	for index := 0; index < bbs.Len(); index++ {
+		x, y1, y2 := bbs.GetBoundedValues(index)
+		testutil.AssertEqual(t, inner.XValues[index], x)
+		testutil.AssertTrue(t, y1 >= y2)
+
+		window := inner.YValues[MaxInt(0, index-9) : index+1]
+		min, max := MinMax(window...)
+		testutil.AssertTrue(t, y2 >= min-1e-9 && y1 <= max+1e-9)
+	}
+
+	// the resampling is deterministic for a given seed.
+	_, ly1, ly2 := bbs.GetBoundedLastValues()
+	_, cy1, cy2 := CopySeries(bbs).(*BootstrapBandSeries).GetBoundedLastValues()
+	testutil.AssertEqual(t, ly1, cy1)
+	testutil.AssertEqual(t, ly2, cy2)
+}
+
+func TestBootstrapBandSeriesConstant(t *testing.T) {
+	// replaced new assertions helper
+
+	bbs := &BootstrapBandSeries{
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{1, 2, 3, 4},
+			YValues: []float64{5, 5, 5, 5},
+		},
+	}
+	_, y1, y2 := bbs.GetBoundedLastValues()
+	testutil.AssertEqual(t, 5.0, y1)
+	testutil.AssertEqual(t, 5.0, y2)
+
+	testutil.AssertNotNil(t, BootstrapBandSeries{}.Validate())
+	testutil.AssertNotNil(t, BootstrapBandSeries{InnerSeries: bbs.InnerSeries, Confidence: 1.5}.Validate())
+}
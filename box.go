@@ -153,6 +153,16 @@ func (b Box) Grow(other Box) Box {
 	}
 }
 
+// Inset returns the box shrunk on each side by the sides of another box, e.g. a margin or padding.
+func (b Box) Inset(other Box) Box {
+	return Box{
+		Top:    b.Top + other.GetTop(),
+		Left:   b.Left + other.GetLeft(),
+		Right:  b.Right - other.GetRight(),
+		Bottom: b.Bottom - other.GetBottom(),
+	}
+}
+
 // Shift pushes a box by x,y.
 func (b Box) Shift(x, y int) Box {
 	return Box{
@@ -0,0 +1,243 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultBoxPlotWidth is the default half width of a box plot, in x axis units.
+	DefaultBoxPlotWidth = 0.3
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*BoxPlotSeries)(nil)
+	_ BoundedValuesProvider = (*BoxPlotSeries)(nil)
+)
+
+// BoxPlot is the summary of a set of observations that a box plot draws; the quartiles, the ends of the
+// whiskers, which are the most extreme observations that are not outliers, and the outliers.
+type BoxPlot struct {
+	Low    float64
+	Q1     float64
+	Median float64
+	Q3     float64
+	High   float64
+
+	Outliers []float64
+}
+
+// NewBoxPlot returns the box plot of a set of observations, where observations more than k interquartile
+// ranges below the first quartile or above the third are outliers.
+func NewBoxPlot(values []float64, k float64) BoxPlot {
+	if len(values) == 0 {
+		return BoxPlot{}
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	bp := BoxPlot{
+		Q1:     quantile(sorted, 0.25),
+		Median: quantile(sorted, 0.5),
+		Q3:     quantile(sorted, 0.75),
+	}
+	iqr := bp.Q3 - bp.Q1
+	lower, upper := bp.Q1-k*iqr, bp.Q3+k*iqr
+
+	bp.Low, bp.High = bp.Median, bp.Median
+	for _, v := range sorted {
+		if v < lower || v > upper {
+			bp.Outliers = append(bp.Outliers, v)
+			continue
+		}
+		bp.Low, bp.High = math.Min(bp.Low, v), math.Max(bp.High, v)
+	}
+	return bp
+}
+
+// BoxPlotSeries draws a box plot for each of a set of categories; a box spanning the quartiles with a
+// line at the median, whiskers out to the most extreme observations that are not outliers, and a dot
+// for each outlier.
+//
+// The box plots are either computed from the observations of each category, or given precomputed as `Boxes`.
+type BoxPlotSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// XValues are the positions of each category, e.g. from `OrdinalRange.Position`.
+	XValues []float64
+	// YValues are the observations of each category.
+	YValues [][]float64
+	// Boxes are precomputed box plots for each category, used in place of `YValues`.
+	Boxes []BoxPlot
+
+	// K is the number of interquartile ranges outside the quartiles beyond which an observation is an outlier.
+	K float64
+	// Width is the half width of each box, in x axis units.
+	Width float64
+
+	OutlierStyle Style
+}
+
+// GetName returns the name of the time series.
+func (bps BoxPlotSeries) GetName() string {
+	return bps.Name
+}
+
+// GetStyle returns the line style.
+func (bps BoxPlotSeries) GetStyle() Style {
+	return bps.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bps BoxPlotSeries) GetYAxis() YAxisType {
+	return bps.YAxis
+}
+
+// GetK returns the outlier threshold or the default.
+func (bps BoxPlotSeries) GetK() float64 {
+	if bps.K == 0 {
+		return DefaultOutlierIQRThreshold
+	}
+	return bps.K
+}
+
+// GetWidth returns the half width of the boxes or the default.
+func (bps BoxPlotSeries) GetWidth() float64 {
+	if bps.Width == 0 {
+		return DefaultBoxPlotWidth
+	}
+	return bps.Width
+}
+
+// GetBoxes returns the box plot of each category, either precomputed or computed from the observations.
+func (bps BoxPlotSeries) GetBoxes() []BoxPlot {
+	if len(bps.Boxes) > 0 {
+		return bps.Boxes
+	}
+	boxes := make([]BoxPlot, len(bps.YValues))
+	for category, values := range bps.YValues {
+		boxes[category] = NewBoxPlot(values, bps.GetK())
+	}
+	return boxes
+}
+
+// Len returns the number of bounded values, the left and right edge of each box.
+func (bps BoxPlotSeries) Len() int {
+	return len(bps.XValues) << 1
+}
+
+// GetBoundedValues returns the left or right edge of a box, with the range of its whiskers and outliers.
+func (bps BoxPlotSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	category := index >> 1
+	x = bps.XValues[category] - bps.GetWidth()
+	if index%2 == 1 {
+		x = bps.XValues[category] + bps.GetWidth()
+	}
+
+	var box BoxPlot
+	if len(bps.Boxes) > 0 {
+		box = bps.Boxes[category]
+	} else {
+		box = NewBoxPlot(bps.YValues[category], bps.GetK())
+	}
+	y1, y2 = box.High, box.Low
+	for _, v := range box.Outliers {
+		y1, y2 = math.Max(y1, v), math.Min(y2, v)
+	}
+	return
+}
+
+// Render renders the series.
+func (bps BoxPlotSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := bps.Style.InheritFrom(defaults.InheritFrom(Style{
+		StrokeWidth: 1.0,
+	}))
+	if style.FillColor.IsZero() {
+		style.FillColor = style.GetStrokeColor().WithAlpha(100)
+	}
+	outlierStyle := bps.OutlierStyle.InheritFrom(Style{
+		DotColor: style.GetStrokeColor(),
+		DotWidth: DefaultOutlierDotWidth / 2,
+	})
+
+	yAt := func(value float64) int {
+		return canvasBox.Bottom - yrange.Translate(value)
+	}
+
+	for category, box := range bps.GetBoxes() {
+		cx := canvasBox.Left + xrange.Translate(bps.XValues[category])
+		halfWidth := canvasBox.Left + xrange.Translate(bps.XValues[category]+bps.GetWidth()) - cx
+		capWidth := halfWidth >> 1
+
+		// whiskers and their caps.
+		style.GetStrokeOptions().WriteToRenderer(r)
+		for _, whisker := range [][2]float64{{box.Q1, box.Low}, {box.Q3, box.High}} {
+			r.MoveTo(cx, yAt(whisker[0]))
+			r.LineTo(cx, yAt(whisker[1]))
+			r.MoveTo(cx-capWidth, yAt(whisker[1]))
+			r.LineTo(cx+capWidth, yAt(whisker[1]))
+		}
+		r.Stroke()
+
+		Draw.Box(r, Box{
+			Top:    yAt(box.Q3),
+			Left:   cx - halfWidth,
+			Right:  cx + halfWidth,
+			Bottom: yAt(box.Q1),
+		}, style)
+
+		medianStyle := style
+		medianStyle.StrokeWidth = style.GetStrokeWidth() * 2
+		medianStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(cx-halfWidth, yAt(box.Median))
+		r.LineTo(cx+halfWidth, yAt(box.Median))
+		r.Stroke()
+
+		outlierStyle.GetDotOptions().WriteToRenderer(r)
+		for _, v := range box.Outliers {
+			r.Circle(outlierStyle.GetDotWidth(), cx, yAt(v))
+			r.Fill()
+		}
+	}
+}
+
+// Validate validates the series.
+func (bps BoxPlotSeries) Validate() error {
+	if len(bps.XValues) == 0 {
+		return fmt.Errorf("box plot series must have xvalues set")
+	}
+	if len(bps.Boxes) > 0 {
+		if len(bps.Boxes) != len(bps.XValues) {
+			return fmt.Errorf("box plot series must have the same number of xvalues as boxes")
+		}
+		return nil
+	}
+	if len(bps.XValues) != len(bps.YValues) {
+		return fmt.Errorf("box plot series must have the same number of xvalues as categories of yvalues")
+	}
+	for _, values := range bps.YValues {
+		if len(values) == 0 {
+			return fmt.Errorf("box plot series must have at least one observation for each category")
+		}
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its values or boxes with the original.
+func (bps BoxPlotSeries) CopySeries() Series {
+	bps.XValues = append([]float64(nil), bps.XValues...)
+	bps.YValues = copyFloat64Slices(bps.YValues)
+	if bps.Boxes != nil {
+		boxes := make([]BoxPlot, len(bps.Boxes))
+		for index, box := range bps.Boxes {
+			box.Outliers = append([]float64(nil), box.Outliers...)
+			boxes[index] = box
+		}
+		bps.Boxes = boxes
+	}
+	return bps
+}
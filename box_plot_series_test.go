@@ -0,0 +1,89 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestNewBoxPlot(t *testing.T) {
+	// replaced new assertions helper
+
+	bp := NewBoxPlot([]float64{9, 1, 2, 3, 4, 5, 6, 7, 8, 100}, 1.5)
+	testutil.AssertInDelta(t, 3.25, bp.Q1, 1e-9)
+	testutil.AssertInDelta(t, 5.5, bp.Median, 1e-9)
+	testutil.AssertInDelta(t, 7.75, bp.Q3, 1e-9)
+	testutil.AssertEqual(t, 1.0, bp.Low)
+	testutil.AssertEqual(t, 9.0, bp.High)
+	testutil.AssertEqual(t, []float64{100}, bp.Outliers)
+
+	testutil.AssertEqual(t, BoxPlot{}, NewBoxPlot(nil, 1.5))
+}
+
+func TestBoxPlotSeriesValues(t *testing.T) {
+	// replaced new assertions helper
+
+	bps := BoxPlotSeries{
+		XValues: []float64{0, 1},
+		YValues: [][]float64{{1, 2, 3, 4, 5}, {1, 2, 3, 4, 50}},
+	}
+	testutil.AssertNil(t, bps.Validate())
+	testutil.AssertEqual(t, 4, bps.Len())
+	testutil.AssertLen(t, bps.GetBoxes(), 2)
+
+	x, y1, y2 := bps.GetBoundedValues(3)
+	testutil.AssertEqual(t, 1+DefaultBoxPlotWidth, x)
+	testutil.AssertEqual(t, 50.0, y1)
+	testutil.AssertEqual(t, 1.0, y2)
+
+	precomputed := BoxPlotSeries{
+		XValues: []float64{0},
+		Boxes:   []BoxPlot{{Low: 1, Q1: 2, Median: 3, Q3: 4, High: 5}},
+	}
+	testutil.AssertNil(t, precomputed.Validate())
+	x, y1, y2 = precomputed.GetBoundedValues(0)
+	testutil.AssertEqual(t, -DefaultBoxPlotWidth, x)
+	testutil.AssertEqual(t, 5.0, y1)
+	testutil.AssertEqual(t, 1.0, y2)
+
+	testutil.AssertNotNil(t, BoxPlotSeries{}.Validate())
+	testutil.AssertNotNil(t, BoxPlotSeries{XValues: []float64{0}, YValues: [][]float64{{}}}.Validate())
+	testutil.AssertNotNil(t, BoxPlotSeries{XValues: []float64{0, 1}, Boxes: []BoxPlot{{}}}.Validate())
+}
+
+func TestBoxPlotSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	graph := Chart{
+		Series: []Series{
+			BoxPlotSeries{
+				XValues: []float64{0, 1},
+				YValues: [][]float64{{1, 2, 3, 4, 5}, {1, 2, 3, 4, 50}},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
+
+func TestBoxPlotSeriesCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	bps := BoxPlotSeries{
+		XValues: []float64{0},
+		YValues: [][]float64{{1, 2, 3}},
+		Boxes:   []BoxPlot{{Low: 1, Q1: 2, Median: 3, Q3: 4, High: 5, Outliers: []float64{9}}},
+	}
+	copied := bps.CopySeries().(BoxPlotSeries)
+	copied.XValues[0] = 10
+	copied.YValues[0][0] = 10
+	copied.Boxes[0].Median = 10
+	copied.Boxes[0].Outliers[0] = 10
+	testutil.AssertEqual(t, 0.0, bps.XValues[0])
+	testutil.AssertEqual(t, 1.0, bps.YValues[0][0])
+	testutil.AssertEqual(t, 3.0, bps.Boxes[0].Median)
+	testutil.AssertEqual(t, 9.0, bps.Boxes[0].Outliers[0])
+}
@@ -66,6 +66,17 @@ func TestBoxGrow(t *testing.T) {
 	testutil.AssertEqual(t, 35, c.Bottom)
 }
 
+func TestBoxInset(t *testing.T) {
+	// replaced new assertions helper
+
+	a := Box{Top: 10, Left: 10, Right: 100, Bottom: 100}
+	b := a.Inset(Box{Top: 1, Left: 2, Right: 3, Bottom: 4})
+	testutil.AssertEqual(t, 11, b.Top)
+	testutil.AssertEqual(t, 12, b.Left)
+	testutil.AssertEqual(t, 97, b.Right)
+	testutil.AssertEqual(t, 96, b.Bottom)
+}
+
 func TestBoxFit(t *testing.T) {
 	// replaced new assertions helper
 
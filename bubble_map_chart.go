@@ -0,0 +1,234 @@
+package chart
+
+import (
+	"errors"
+	"io"
+	"math"
+	"sort"
+)
+
+// GeoPoint is a located value drawn as a bubble on a map.
+type GeoPoint struct {
+	Style Style
+	Label string
+	// Lon and Lat are the longitude and latitude of the point in degrees.
+	Lon, Lat float64
+	// Size is the size of the bubble; the areas of the bubbles are proportional to their sizes.
+	Size float64
+	// Value colors the bubble through the color map of the chart, if it has one.
+	Value float64
+}
+
+// BubbleMapChart is a chart that draws bubbles sized and colored by their values at longitudes and
+// latitudes, over the outlines of a map of features, e.g. countries from `ParseGeoJSON`.
+type BubbleMapChart struct {
+	ChartFrame
+
+	// FeatureStyle is the style of the features of the basemap.
+	FeatureStyle Style
+	BubbleStyle  Style
+	LabelStyle   Style
+	LegendStyle  Style
+
+	Projection MapProjection
+	// ColorMap, if set, colors the bubbles by their values and adds a color bar legend.
+	ColorMap       ColorMap
+	ValueFormatter ValueFormatter
+
+	// MinRadius and MaxRadius bound the radii of the bubbles.
+	MinRadius float64
+	MaxRadius float64
+
+	Features []GeoFeature
+	Points   []GeoPoint
+	Elements []Renderable
+}
+
+// GetMinRadius returns the radius of the smallest bubbles or the default.
+func (bmc BubbleMapChart) GetMinRadius() float64 {
+	if bmc.MinRadius == 0 {
+		return DefaultBubbleMapMinRadius
+	}
+	return bmc.MinRadius
+}
+
+// GetMaxRadius returns the radius of the largest bubbles or the default.
+func (bmc BubbleMapChart) GetMaxRadius() float64 {
+	if bmc.MaxRadius == 0 {
+		return DefaultBubbleMapMaxRadius
+	}
+	return bmc.MaxRadius
+}
+
+// GetValueFormatter returns the legend value formatter or the default.
+func (bmc BubbleMapChart) GetValueFormatter() ValueFormatter {
+	if bmc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return bmc.ValueFormatter
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (bmc BubbleMapChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(bmc.Features) == 0 && len(bmc.Points) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one feature or point"))
+	}
+
+	width, height := bmc.GetWidth(), bmc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if bmc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		bmc.defaultFont = defaultFont
+	}
+	r.SetDPI(bmc.GetDPI(DefaultDPI))
+
+	canvasBox := bmc.getDefaultCanvasBox(r, width, height)
+	mapBox, legendBox := bmc.getLayout(r, canvasBox)
+
+	bmc.drawBackground(r, width, height)
+	bmc.drawCanvas(r, canvasBox)
+	bmc.drawMap(r, mapBox)
+	if bmc.ColorMap != nil && !bmc.LegendStyle.Hidden && len(bmc.Points) > 0 {
+		min, max := bmc.getValueRange()
+		drawColorBarLegend(r, legendBox, bmc.ColorMap, min, max, bmc.GetValueFormatter(), bmc.styleDefaultsLegend())
+	}
+	bmc.drawTitle(r, width, height)
+	for _, a := range bmc.Elements {
+		a(r, canvasBox, bmc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+// getValueRange returns the min and max of the values of the points.
+func (bmc BubbleMapChart) getValueRange() (min, max float64) {
+	min, max = math.MaxFloat64, -math.MaxFloat64
+	for _, p := range bmc.Points {
+		min, max = math.Min(min, p.Value), math.Max(max, p.Value)
+	}
+	return
+}
+
+// getLayout splits the canvas into the map, and the color bar and its labels to the right of it if
+// the bubbles are colored by value.
+func (bmc BubbleMapChart) getLayout(r Renderer, canvasBox Box) (mapBox, legendBox Box) {
+	mapBox = canvasBox
+	if bmc.ColorMap != nil && !bmc.LegendStyle.Hidden && len(bmc.Points) > 0 {
+		min, max := bmc.getValueRange()
+		mapBox.Right -= DefaultColorBarGap + colorBarLegendWidth(r, min, max, bmc.GetValueFormatter(), bmc.styleDefaultsLegend())
+		legendBox = colorBarLegendBox(Box{Top: canvasBox.Top, Right: mapBox.Right, Bottom: canvasBox.Bottom})
+	}
+	// leave room for the largest bubbles at the edges of the map.
+	return mapBox.Inset(Box{
+		Top:    int(bmc.GetMaxRadius()),
+		Left:   int(bmc.GetMaxRadius()),
+		Right:  int(bmc.GetMaxRadius()),
+		Bottom: int(bmc.GetMaxRadius()),
+	}), legendBox
+}
+
+// getRadius returns the radius of a bubble, with areas proportional to sizes up to the largest size.
+func (bmc BubbleMapChart) getRadius(size, maxSize float64) float64 {
+	if size <= 0 || maxSize <= 0 {
+		return bmc.GetMinRadius()
+	}
+	return math.Max(bmc.GetMinRadius(), bmc.GetMaxRadius()*math.Sqrt(size/maxSize))
+}
+
+// drawMap fits the projection to the features, or to the points without any, then draws the features
+// and the bubbles over them, largest first so that smaller bubbles stay visible.
+func (bmc BubbleMapChart) drawMap(r Renderer, mapBox Box) {
+	extent := geoFeaturePoints(bmc.Features)
+	if len(extent) == 0 {
+		for _, p := range bmc.Points {
+			extent = append(extent, [2]float64{p.Lon, p.Lat})
+		}
+	}
+	toCanvas, ok := fitMapProjection(bmc.Projection, extent, mapBox)
+	if !ok {
+		return
+	}
+
+	featureStyle := bmc.styleDefaultsFeature()
+	for _, f := range bmc.Features {
+		drawGeoFeature(r, f, toCanvas, featureStyle)
+	}
+
+	var maxSize float64
+	for _, p := range bmc.Points {
+		maxSize = math.Max(maxSize, p.Size)
+	}
+	points := make([]GeoPoint, len(bmc.Points))
+	copy(points, bmc.Points)
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Size > points[j].Size
+	})
+
+	min, max := bmc.getValueRange()
+	labelStyle := bmc.styleDefaultsLabels()
+	for _, p := range points {
+		style := bmc.styleDefaultsBubble()
+		if bmc.ColorMap != nil {
+			color := bmc.ColorMap(p.Value, min, max)
+			style = bmc.BubbleStyle.InheritFrom(Style{
+				FillColor:   color.WithAlpha(192),
+				StrokeColor: color,
+				StrokeWidth: DefaultStrokeWidth,
+			})
+		}
+		style = p.Style.InheritFrom(style)
+
+		x, y := toCanvas(p.Lon, p.Lat)
+		radius := bmc.getRadius(p.Size, maxSize)
+		style.GetFillAndStrokeOptions().WriteToRenderer(r)
+		r.Circle(radius, x, y)
+		r.FillStroke()
+
+		if len(p.Label) > 0 && !bmc.LabelStyle.Hidden {
+			tb := Draw.MeasureText(r, p.Label, labelStyle)
+			Draw.Text(r, p.Label, x+int(radius)+DefaultBubbleMapLabelGap, y+tb.Height()>>1, labelStyle)
+		}
+	}
+}
+
+func (bmc BubbleMapChart) styleDefaultsFeature() Style {
+	return bmc.FeatureStyle.InheritFrom(Style{
+		FillColor:   ColorLightGray,
+		StrokeColor: bmc.GetColorPalette().BackgroundColor(),
+		StrokeWidth: 0.5,
+	})
+}
+
+func (bmc BubbleMapChart) styleDefaultsBubble() Style {
+	color := bmc.GetColorPalette().GetSeriesColor(0)
+	return bmc.BubbleStyle.InheritFrom(Style{
+		FillColor:   color.WithAlpha(192),
+		StrokeColor: color,
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
+
+func (bmc BubbleMapChart) styleDefaultsLabels() Style {
+	return bmc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: bmc.GetColorPalette().TextColor(),
+		Font:      bmc.GetFont(),
+	})
+}
+
+func (bmc BubbleMapChart) styleDefaultsLegend() Style {
+	return bmc.LegendStyle.InheritFrom(Style{
+		FontSize:    DefaultFontSize,
+		FontColor:   bmc.GetColorPalette().TextColor(),
+		Font:        bmc.GetFont(),
+		StrokeColor: bmc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
@@ -0,0 +1,49 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestBubbleMapChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	features, err := ParseGeoJSON([]byte(testGeoJSON), "name")
+	testutil.AssertNil(t, err)
+
+	points := []GeoPoint{
+		{Label: "a", Lon: 0.5, Lat: 0.5, Size: 10, Value: 1},
+		{Label: "b", Lon: 1.5, Lat: 0.5, Size: 2, Value: 3},
+	}
+	for _, colorMap := range []ColorMap{nil, Jet} {
+		bmc := BubbleMapChart{
+			ChartFrame: ChartFrame{
+				Title: "Test",
+			},
+			ColorMap: colorMap,
+			Features: features,
+			Points:   points,
+		}
+		for _, rp := range []RendererProvider{PNG, SVG} {
+			b := bytes.NewBuffer([]byte{})
+			testutil.AssertNil(t, bmc.Render(rp, b))
+			testutil.AssertNotZero(t, b.Len())
+		}
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, BubbleMapChart{Points: points}.Render(PNG, b))
+	testutil.AssertNotNil(t, BubbleMapChart{}.Render(PNG, b))
+}
+
+func TestBubbleMapChartGetRadius(t *testing.T) {
+	// replaced new assertions helper
+
+	bmc := BubbleMapChart{MinRadius: 2, MaxRadius: 20}
+	testutil.AssertEqual(t, 20.0, bmc.getRadius(100, 100))
+	testutil.AssertEqual(t, 10.0, bmc.getRadius(25, 100))
+	testutil.AssertEqual(t, 2.0, bmc.getRadius(0.01, 100))
+	testutil.AssertEqual(t, 2.0, bmc.getRadius(0, 100))
+}
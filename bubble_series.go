@@ -0,0 +1,182 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultBubbleMinRadius is the default radius of the smallest bubbles of a bubble series.
+	DefaultBubbleMinRadius = 3.0
+	// DefaultBubbleMaxRadius is the default radius of the largest bubbles of a bubble series.
+	DefaultBubbleMaxRadius = 24.0
+)
+
+// Interface Assertions.
+var (
+	_ Series             = (*BubbleSeries)(nil)
+	_ ValuesProvider     = (*BubbleSeries)(nil)
+	_ SizeValuesProvider = (*BubbleSeries)(nil)
+	_ LastValuesProvider = (*BubbleSeries)(nil)
+)
+
+// BubbleSeries draws each x, y point as a bubble whose area is scaled from its size value, between
+// the min radius for the smallest size and the max radius for the largest.
+type BubbleSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	XValueFormatter ValueFormatter
+	YValueFormatter ValueFormatter
+
+	XValues    []float64
+	YValues    []float64
+	SizeValues []float64
+
+	// MinRadius and MaxRadius are the pixel radii of the bubbles with the smallest and largest sizes.
+	MinRadius float64
+	MaxRadius float64
+}
+
+// GetName returns the name of the time series.
+func (bs BubbleSeries) GetName() string {
+	return bs.Name
+}
+
+// GetStyle returns the line style.
+func (bs BubbleSeries) GetStyle() Style {
+	return bs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bs BubbleSeries) GetYAxis() YAxisType {
+	return bs.YAxis
+}
+
+// GetMinRadius returns the radius of the smallest bubbles or the default.
+func (bs BubbleSeries) GetMinRadius() float64 {
+	if bs.MinRadius == 0 {
+		return DefaultBubbleMinRadius
+	}
+	return bs.MinRadius
+}
+
+// GetMaxRadius returns the radius of the largest bubbles or the default.
+func (bs BubbleSeries) GetMaxRadius() float64 {
+	if bs.MaxRadius == 0 {
+		return DefaultBubbleMaxRadius
+	}
+	return bs.MaxRadius
+}
+
+// Len returns the number of elements in the series.
+func (bs BubbleSeries) Len() int {
+	return len(bs.XValues)
+}
+
+// GetValues gets the x,y values at a given index.
+func (bs BubbleSeries) GetValues(index int) (float64, float64) {
+	return bs.XValues[index], bs.YValues[index]
+}
+
+// GetSizeValue gets the size at a given index.
+func (bs BubbleSeries) GetSizeValue(index int) float64 {
+	return bs.SizeValues[index]
+}
+
+// GetLastValues gets the last x,y values.
+func (bs BubbleSeries) GetLastValues() (x, y float64) {
+	if len(bs.XValues) == 0 || len(bs.YValues) == 0 {
+		return
+	}
+	return bs.XValues[len(bs.XValues)-1], bs.YValues[len(bs.YValues)-1]
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (bs BubbleSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if bs.XValueFormatter != nil {
+		x = bs.XValueFormatter
+	} else {
+		x = FloatValueFormatter
+	}
+	if bs.YValueFormatter != nil {
+		y = bs.YValueFormatter
+	} else {
+		y = FloatValueFormatter
+	}
+	return
+}
+
+// Render renders the series, largest bubbles first so that the smaller ones stay visible.
+func (bs BubbleSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := bs.Style.InheritFrom(defaults)
+	if bs.Style.DotColor.IsZero() {
+		style.DotColor = style.GetStrokeColor().WithAlpha(160)
+	}
+	radius := BubbleSizes(bs.SizeValues, bs.GetMinRadius(), bs.GetMaxRadius())
+
+	order := make([]int, bs.Len())
+	for index := range order {
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return bs.SizeValues[order[i]] > bs.SizeValues[order[j]]
+	})
+
+	for _, index := range order {
+		vx, vy := bs.GetValues(index)
+		x := canvasBox.Left + xrange.Translate(vx)
+		y := canvasBox.Bottom - yrange.Translate(vy)
+		style.GetDotOptions().WriteDrawingOptionsToRenderer(r)
+		if style.DotColorProvider != nil {
+			color := style.DotColorProvider(xrange, yrange, index, vx, vy)
+			r.SetFillColor(color)
+			r.SetStrokeColor(color)
+		}
+		r.Circle(radius(xrange, yrange, index, vx, vy), x, y)
+		r.FillStroke()
+	}
+}
+
+// Validate validates the series.
+func (bs BubbleSeries) Validate() error {
+	if len(bs.XValues) == 0 {
+		return fmt.Errorf("bubble series; must have xvalues set")
+	}
+	if len(bs.XValues) != len(bs.YValues) {
+		return fmt.Errorf("bubble series; must have same length xvalues as yvalues")
+	}
+	if len(bs.XValues) != len(bs.SizeValues) {
+		return fmt.Errorf("bubble series; must have same length xvalues as sizevalues")
+	}
+	for _, size := range bs.SizeValues {
+		if size < 0 {
+			return fmt.Errorf("bubble series; sizevalues cannot be negative")
+		}
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its values with the original.
+func (bs BubbleSeries) CopySeries() Series {
+	bs.XValues = append([]float64(nil), bs.XValues...)
+	bs.YValues = append([]float64(nil), bs.YValues...)
+	bs.SizeValues = append([]float64(nil), bs.SizeValues...)
+	return bs
+}
+
+// BubbleSizes returns a size provider that scales the dots of a series to bubbles by a size for each
+// point, with areas proportional to the sizes between a min radius for the smallest size and a max
+// radius for the largest. It can be set as the `DotWidthProvider` of the style of any series.
+func BubbleSizes(sizes []float64, minRadius, maxRadius float64) SizeProvider {
+	min, max := MinMax(sizes...)
+	return func(_, _ Range, index int, _, _ float64) float64 {
+		if index >= len(sizes) || max <= min {
+			return maxRadius
+		}
+		t := math.Sqrt((sizes[index] - min) / (max - min))
+		return minRadius + (maxRadius-minRadius)*t
+	}
+}
@@ -0,0 +1,45 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestBubbleSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	bs := BubbleSeries{
+		XValues:    []float64{1, 2, 3},
+		YValues:    []float64{4, 5, 6},
+		SizeValues: []float64{10, 40, 20},
+	}
+	testutil.AssertNil(t, bs.Validate())
+	testutil.AssertEqual(t, 3, bs.Len())
+	testutil.AssertEqual(t, 40.0, bs.GetSizeValue(1))
+	x, y := bs.GetLastValues()
+	testutil.AssertEqual(t, 3.0, x)
+	testutil.AssertEqual(t, 6.0, y)
+
+	testutil.AssertNotNil(t, BubbleSeries{XValues: []float64{1}, YValues: []float64{1}}.Validate())
+	testutil.AssertNotNil(t, BubbleSeries{XValues: []float64{1}, YValues: []float64{1}, SizeValues: []float64{-1}}.Validate())
+
+	c := Chart{Series: []Series{bs}}
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestBubbleSizes(t *testing.T) {
+	// replaced new assertions helper
+
+	radius := BubbleSizes([]float64{0, 25, 100}, 2, 12)
+	testutil.AssertEqual(t, 2.0, radius(nil, nil, 0, 0, 0))
+	testutil.AssertEqual(t, 7.0, radius(nil, nil, 1, 0, 0))
+	testutil.AssertEqual(t, 12.0, radius(nil, nil, 2, 0, 0))
+
+	// equal sizes all draw at the max radius.
+	radius = BubbleSizes([]float64{5, 5}, 2, 12)
+	testutil.AssertEqual(t, 12.0, radius(nil, nil, 0, 0, 0))
+}
@@ -0,0 +1,65 @@
+package chart
+
+// BumpEntity is an entity ranked over time in a bump chart.
+type BumpEntity struct {
+	Name  string
+	Style Style
+	// Ranks is the rank of the entity in each period, starting from 1 for the top rank.
+	// Periods the entity is not ranked in are 0.
+	Ranks []int
+}
+
+// NewBumpChart returns a chart of the rank of each entity over the periods, with a line for
+// each entity labeled with its name at both ends. The periods are shown in order on an ordinal
+// x-axis, and the ranks on an ordinal y-axis with the top rank at the top.
+func NewBumpChart(periods []interface{}, entities []BumpEntity) Chart {
+	var maxRank int
+	for _, entity := range entities {
+		for _, rank := range entity.Ranks {
+			maxRank = MaxInt(maxRank, rank)
+		}
+	}
+	ranks := make([]interface{}, maxRank)
+	for index := range ranks {
+		ranks[index] = index + 1
+	}
+
+	var series []Series
+	for index, entity := range entities {
+		color := GetDefaultColor(index)
+		line := ContinuousSeries{
+			Name: entity.Name,
+			Style: entity.Style.InheritFrom(Style{
+				StrokeColor: color,
+				StrokeWidth: DefaultBumpLineWidth,
+				DotColor:    color,
+				DotWidth:    DefaultBumpDotWidth,
+			}),
+		}
+		for period, rank := range entity.Ranks {
+			if rank > 0 && period < len(periods) {
+				line.XValues = append(line.XValues, float64(period))
+				line.YValues = append(line.YValues, float64(rank-1))
+			}
+		}
+		if line.Len() == 0 {
+			continue
+		}
+		series = append(series, line, EndLabelSeries{
+			Style:       Style{FontColor: line.Style.StrokeColor},
+			Position:    EndLabelPositionBoth,
+			InnerSeries: line,
+		})
+	}
+
+	return Chart{
+		XAxis: XAxis{
+			Range: NewOrdinalRange(periods...),
+		},
+		YAxis: YAxis{
+			Range: &OrdinalRange{Keys: ranks, Descending: true},
+		},
+		YAxisSecondary: HideYAxis(),
+		Series:         series,
+	}
+}
@@ -0,0 +1,38 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestNewBumpChart(t *testing.T) {
+	// replaced new assertions helper
+
+	c := NewBumpChart([]interface{}{"2021", "2022", "2023"}, []BumpEntity{
+		{Name: "red", Ranks: []int{1, 2, 3}},
+		{Name: "green", Ranks: []int{2, 0, 1}},
+		{Name: "blue", Ranks: []int{3, 1, 2}},
+		{Name: "unranked"},
+	})
+
+	// each ranked entity has a line and its labels.
+	testutil.AssertLen(t, c.Series, 6)
+	green := c.Series[2].(ContinuousSeries)
+	testutil.AssertEqual(t, []float64{0, 2}, green.XValues)
+	testutil.AssertEqual(t, []float64{1, 0}, green.YValues)
+
+	labels := c.Series[3].(EndLabelSeries)
+	testutil.AssertEqual(t, "green", labels.GetLabel())
+	testutil.AssertEqual(t, green.Style.StrokeColor, labels.Style.FontColor)
+
+	ranks := c.YAxis.Range.(*OrdinalRange)
+	testutil.AssertTrue(t, ranks.Descending)
+	testutil.AssertLen(t, ranks.Keys, 3)
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertEqual(t, 2, strings.Count(buffer.String(), ">green<"))
+}
@@ -0,0 +1,323 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// CalendarHeatMapChart is a chart that draws a value per day as a grid of square cells, a column per
+// week and a row per day of the week, colored by intensity through a color map, like the contribution
+// graphs of GitHub. The months are labeled along the top and the days of the week to the left.
+//
+// `Dates[i]` is paired with `Values[i]`, and the values of the same day are summed; days between the
+// start and the end without a value are drawn in the empty color.
+type CalendarHeatMapChart struct {
+	ChartFrame
+
+	CellStyle   Style
+	LabelStyle  Style
+	LegendStyle Style
+
+	// CellSpacing is the pixel spacing between cells.
+	CellSpacing int
+	// EmptyColor is the color of the days without a value.
+	EmptyColor drawing.Color
+	// WeekStart is the day of the week in the first row, it defaults to Sunday.
+	WeekStart time.Weekday
+
+	// Start and End bound the days drawn, they default to the first and last of the dates.
+	Start time.Time
+	End   time.Time
+
+	// ColorMap maps the values to colors, it defaults to `Greens`.
+	ColorMap ColorMap
+	// ValueRange bounds the color map, it defaults to the extent of the daily values.
+	ValueRange     Range
+	ValueFormatter ValueFormatter
+
+	Dates    []time.Time
+	Values   []float64
+	Elements []Renderable
+}
+
+// GetCellSpacing returns the cell spacing or the default.
+func (chc CalendarHeatMapChart) GetCellSpacing() int {
+	if chc.CellSpacing == 0 {
+		return DefaultCalendarHeatMapCellSpacing
+	}
+	return chc.CellSpacing
+}
+
+// GetEmptyColor returns the color of the days without a value or the default.
+func (chc CalendarHeatMapChart) GetEmptyColor() drawing.Color {
+	if chc.EmptyColor.IsZero() {
+		return DefaultCalendarHeatMapEmptyColor
+	}
+	return chc.EmptyColor
+}
+
+// GetColorMap returns the color map or the default.
+func (chc CalendarHeatMapChart) GetColorMap() ColorMap {
+	if chc.ColorMap == nil {
+		return Greens
+	}
+	return chc.ColorMap
+}
+
+// GetValueFormatter returns the value formatter or the default.
+func (chc CalendarHeatMapChart) GetValueFormatter() ValueFormatter {
+	if chc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return chc.ValueFormatter
+}
+
+// GetDays returns the sum of the values of each day, keyed by the day at midnight UTC.
+func (chc CalendarHeatMapChart) GetDays() map[time.Time]float64 {
+	days := make(map[time.Time]float64)
+	for index, date := range chc.Dates {
+		if index < len(chc.Values) && !math.IsNaN(chc.Values[index]) {
+			days[calendarDay(date)] += chc.Values[index]
+		}
+	}
+	return days
+}
+
+// GetDateRange returns the first and last days drawn, at midnight UTC.
+func (chc CalendarHeatMapChart) GetDateRange() (start, end time.Time) {
+	for _, date := range chc.Dates {
+		day := calendarDay(date)
+		if start.IsZero() || day.Before(start) {
+			start = day
+		}
+		if end.IsZero() || day.After(end) {
+			end = day
+		}
+	}
+	if !chc.Start.IsZero() {
+		start = calendarDay(chc.Start)
+	}
+	if !chc.End.IsZero() {
+		end = calendarDay(chc.End)
+	}
+	return
+}
+
+// GetValueRange returns the min and max of the color map, either the value range or the extent of the daily values.
+func (chc CalendarHeatMapChart) GetValueRange() (min, max float64) {
+	if chc.ValueRange != nil && !chc.ValueRange.IsZero() {
+		return chc.ValueRange.GetMin(), chc.ValueRange.GetMax()
+	}
+	min, max = math.MaxFloat64, -math.MaxFloat64
+	for _, value := range chc.GetDays() {
+		min, max = math.Min(min, value), math.Max(max, value)
+	}
+	return
+}
+
+// calendarDay returns the date of a time, in its own location, as midnight UTC so days can be counted
+// without daylight saving changes getting in the way.
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// getFirstDay returns the first day of the week the start falls in.
+func (chc CalendarHeatMapChart) getFirstDay(start time.Time) time.Time {
+	offset := (int(start.Weekday()) - int(chc.WeekStart) + 7) % 7
+	return start.AddDate(0, 0, -offset)
+}
+
+// getCell returns the week column and weekday row of a day.
+func (chc CalendarHeatMapChart) getCell(firstDay, day time.Time) (row, column int) {
+	days := int(day.Sub(firstDay).Hours() / 24)
+	return days % 7, days / 7
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (chc CalendarHeatMapChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := chc.validate(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := chc.GetWidth(), chc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if chc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		chc.defaultFont = defaultFont
+	}
+	r.SetDPI(chc.GetDPI(DefaultDPI))
+
+	canvasBox := chc.getDefaultCanvasBox(r, width, height)
+	gridBox, pitch, legendBox := chc.getLayout(r, canvasBox)
+
+	chc.drawBackground(r, width, height)
+	chc.drawCanvas(r, canvasBox)
+	if pitch > chc.GetCellSpacing() {
+		chc.drawCells(r, gridBox, pitch)
+		chc.drawLabels(r, gridBox, pitch)
+	}
+	if !chc.LegendStyle.Hidden {
+		min, max := chc.GetValueRange()
+		drawColorBarLegend(r, legendBox, chc.GetColorMap(), min, max, chc.GetValueFormatter(), chc.styleDefaultsLegend())
+	}
+	chc.drawTitle(r, width, height)
+	for _, a := range chc.Elements {
+		a(r, canvasBox, chc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (chc CalendarHeatMapChart) validate() error {
+	if len(chc.Dates) == 0 {
+		return errors.New("please provide at least one date")
+	}
+	if len(chc.Dates) != len(chc.Values) {
+		return fmt.Errorf("calendar heat map chart must have as many values as dates")
+	}
+	if start, end := chc.GetDateRange(); end.Before(start) {
+		return fmt.Errorf("calendar heat map chart end must not be before its start")
+	}
+	return nil
+}
+
+// getWeekdayLabel returns the label of a row, which is every other day of the week starting with the second.
+func (chc CalendarHeatMapChart) getWeekdayLabel(row int) string {
+	if row%2 == 0 {
+		return ""
+	}
+	return time.Weekday((int(chc.WeekStart) + row) % 7).String()[:3]
+}
+
+// getLayout returns the box of the grid, with the weekday labels to its left and the month labels above it,
+// the pitch of its square cells, and the box of the color bar legend to the right of it.
+func (chc CalendarHeatMapChart) getLayout(r Renderer, canvasBox Box) (gridBox Box, pitch int, legendBox Box) {
+	gridBox = canvasBox
+	if !chc.LabelStyle.Hidden {
+		labelStyle := chc.styleDefaultsLabels()
+		var rowLabelWidth int
+		for row := 0; row < 7; row++ {
+			rowLabelWidth = MaxInt(rowLabelWidth, Draw.MeasureText(r, chc.getWeekdayLabel(row), labelStyle).Width())
+		}
+		gridBox.Left += rowLabelWidth + DefaultHeatMapLabelGap
+		gridBox.Top += Draw.MeasureText(r, time.January.String()[:3], labelStyle).Height() + DefaultHeatMapLabelGap
+	}
+	if !chc.LegendStyle.Hidden {
+		min, max := chc.GetValueRange()
+		gridBox.Right -= DefaultColorBarGap + colorBarLegendWidth(r, min, max, chc.GetValueFormatter(), chc.styleDefaultsLegend())
+	}
+
+	start, end := chc.GetDateRange()
+	_, weeks := chc.getCell(chc.getFirstDay(start), end)
+	weeks++
+	pitch = MinInt(gridBox.Width()/weeks, gridBox.Height()/7)
+	gridBox.Right = gridBox.Left + pitch*weeks
+	gridBox.Bottom = gridBox.Top + pitch*7
+
+	if !chc.LegendStyle.Hidden {
+		legendBox = colorBarLegendBox(gridBox)
+	}
+	return
+}
+
+// getCellBox returns the box of the cell of a day of the week in a week column.
+func (chc CalendarHeatMapChart) getCellBox(gridBox Box, pitch, row, column int) Box {
+	return Box{
+		Top:    gridBox.Top + row*pitch,
+		Left:   gridBox.Left + column*pitch,
+		Right:  gridBox.Left + (column+1)*pitch - chc.GetCellSpacing(),
+		Bottom: gridBox.Top + (row+1)*pitch - chc.GetCellSpacing(),
+	}
+}
+
+func (chc CalendarHeatMapChart) drawCells(r Renderer, gridBox Box, pitch int) {
+	min, max := chc.GetValueRange()
+	days := chc.GetDays()
+	start, end := chc.GetDateRange()
+	firstDay := chc.getFirstDay(start)
+
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		color := chc.GetEmptyColor()
+		if value, ok := days[day]; ok {
+			color = chc.GetColorMap()(value, min, max)
+		}
+		row, column := chc.getCell(firstDay, day)
+		Draw.Box(r, chc.getCellBox(gridBox, pitch, row, column), chc.CellStyle.InheritFrom(Style{
+			FillColor:   color,
+			StrokeColor: color,
+			StrokeWidth: DefaultStrokeWidth,
+		}))
+	}
+}
+
+// drawLabels draws the weekday labels to the left of the grid, and the month labels above the first
+// week column of each month, skipping those that would overlap the one before.
+func (chc CalendarHeatMapChart) drawLabels(r Renderer, gridBox Box, pitch int) {
+	if chc.LabelStyle.Hidden {
+		return
+	}
+	labelStyle := chc.styleDefaultsLabels()
+	for row := 0; row < 7; row++ {
+		label := chc.getWeekdayLabel(row)
+		if len(label) == 0 {
+			continue
+		}
+		_, cy := chc.getCellBox(gridBox, pitch, row, 0).Center()
+		tb := Draw.MeasureText(r, label, labelStyle)
+		Draw.Text(r, label, gridBox.Left-DefaultHeatMapLabelGap-tb.Width(), cy+tb.Height()>>1, labelStyle)
+	}
+
+	start, _ := chc.GetDateRange()
+	firstDay := chc.getFirstDay(start)
+	lastRight := math.MinInt32
+	var previous time.Month
+	for column := 0; column < gridBox.Width()/pitch; column++ {
+		day := firstDay.AddDate(0, 0, 7*column)
+		if day.Before(start) {
+			day = start
+		}
+		if column > 0 && day.Month() == previous {
+			continue
+		}
+		previous = day.Month()
+
+		label := day.Month().String()[:3]
+		tb := Draw.MeasureText(r, label, labelStyle)
+		x := gridBox.Left + column*pitch
+		if x < lastRight+DefaultHeatMapLabelGap || x+tb.Width() > gridBox.Right {
+			continue
+		}
+		Draw.Text(r, label, x, gridBox.Top-DefaultHeatMapLabelGap, labelStyle)
+		lastRight = x + tb.Width()
+	}
+}
+
+func (chc CalendarHeatMapChart) styleDefaultsLabels() Style {
+	return chc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: chc.GetColorPalette().TextColor(),
+		Font:      chc.GetFont(),
+	})
+}
+
+func (chc CalendarHeatMapChart) styleDefaultsLegend() Style {
+	return chc.LegendStyle.InheritFrom(Style{
+		FontSize:    DefaultFontSize,
+		FontColor:   chc.GetColorPalette().TextColor(),
+		Font:        chc.GetFont(),
+		StrokeColor: chc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
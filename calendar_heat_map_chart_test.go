@@ -0,0 +1,76 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestCalendarHeatMapChartDays(t *testing.T) {
+	// replaced new assertions helper
+
+	chc := CalendarHeatMapChart{
+		Dates: []time.Time{
+			time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 3, 5, 17, 0, 0, 0, time.UTC),
+			time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		},
+		Values: []float64{1, 2, 4},
+	}
+
+	days := chc.GetDays()
+	testutil.AssertLen(t, days, 2)
+	testutil.AssertEqual(t, 3.0, days[time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)])
+
+	start, end := chc.GetDateRange()
+	testutil.AssertEqual(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), start)
+	testutil.AssertEqual(t, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), end)
+
+	min, max := chc.GetValueRange()
+	testutil.AssertEqual(t, 3.0, min)
+	testutil.AssertEqual(t, 4.0, max)
+
+	// march 1st 2024 is a friday, so its week starts on sunday february 25th.
+	firstDay := chc.getFirstDay(start)
+	testutil.AssertEqual(t, time.Date(2024, 2, 25, 0, 0, 0, 0, time.UTC), firstDay)
+	row, column := chc.getCell(firstDay, end)
+	testutil.AssertEqual(t, 2, row)
+	testutil.AssertEqual(t, 1, column)
+
+	chc.WeekStart = time.Monday
+	testutil.AssertEqual(t, time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC), chc.getFirstDay(start))
+	testutil.AssertEqual(t, "Tue", chc.getWeekdayLabel(1))
+	testutil.AssertEmpty(t, chc.getWeekdayLabel(2))
+}
+
+func TestCalendarHeatMapChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, CalendarHeatMapChart{}.validate())
+	testutil.AssertNotNil(t, CalendarHeatMapChart{Dates: []time.Time{time.Now()}}.validate())
+
+	var dates []time.Time
+	var values []float64
+	for day := 0; day < 90; day++ {
+		dates = append(dates, time.Date(2024, 1, 1+day, 0, 0, 0, 0, time.UTC))
+		values = append(values, float64(day%7))
+	}
+	chc := CalendarHeatMapChart{ChartFrame: ChartFrame{Width: 600, Height: 200}, Dates: dates, Values: values}
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, chc.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), ">Jan</text>")
+	testutil.AssertContains(t, buffer.String(), ">Mar</text>")
+	testutil.AssertContains(t, buffer.String(), ">Wed</text>")
+}
+
+func TestGreens(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertEqual(t, greensColors[0], Greens(0, 0, 10))
+	testutil.AssertEqual(t, greensColors[3], Greens(10, 0, 10))
+	testutil.AssertEqual(t, greensColors[3], Greens(20, 0, 10))
+	testutil.AssertEqual(t, greensColors[3], Greens(5, 5, 5))
+}
@@ -0,0 +1,219 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// CalloutRouting is an enum for how the arrow of a callout is routed from its label to its point.
+type CalloutRouting int
+
+const (
+	// CalloutRoutingUnset is the unset state for callout routing; it defaults to `CalloutRoutingElbow`.
+	CalloutRoutingUnset CalloutRouting = 0
+	// CalloutRoutingElbow routes the arrow out of the side of the label and then straight up or down to the point.
+	CalloutRoutingElbow CalloutRouting = 1
+	// CalloutRoutingStraight routes the arrow in a straight line from the edge of the label to the point.
+	CalloutRoutingStraight CalloutRouting = 2
+)
+
+// Callout is a label with an arrow pointing at a data point.
+type Callout struct {
+	Style  Style
+	Label  string
+	XValue float64
+	YValue float64
+
+	// OffsetX and OffsetY are the pixel offsets of the center of the label from the point.
+	// If both are unset the label sits up and to the right of the point.
+	OffsetX int
+	OffsetY int
+}
+
+// GetOffset returns the offset of the label from the point or the default.
+func (c Callout) GetOffset() (x, y int) {
+	if c.OffsetX == 0 && c.OffsetY == 0 {
+		return DefaultCalloutOffsetX, DefaultCalloutOffsetY
+	}
+	return c.OffsetX, c.OffsetY
+}
+
+// Interface Assertions.
+var (
+	_ Series = (*CalloutSeries)(nil)
+)
+
+// CalloutSeries draws labels with arrows pointing from them to data points, e.g. to explain an event
+// in a published chart.
+//
+// The label boxes are drawn with the background, border and padding of the style and kept within the
+// canvas, the arrows with its stroke and an arrowhead at the point.
+type CalloutSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Routing CalloutRouting
+
+	// ArrowSize is the pixel length of the arrowheads.
+	ArrowSize int
+	// MaxWidth is the pixel width the labels wrap at.
+	MaxWidth int
+
+	Callouts []Callout
+}
+
+// GetName returns the name of the series.
+func (cs CalloutSeries) GetName() string {
+	return cs.Name
+}
+
+// GetStyle returns the series style.
+func (cs CalloutSeries) GetStyle() Style {
+	return cs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (cs CalloutSeries) GetYAxis() YAxisType {
+	return cs.YAxis
+}
+
+// GetRouting returns the arrow routing or the default.
+func (cs CalloutSeries) GetRouting() CalloutRouting {
+	if cs.Routing == CalloutRoutingUnset {
+		return CalloutRoutingElbow
+	}
+	return cs.Routing
+}
+
+// GetArrowSize returns the arrowhead size or the default.
+func (cs CalloutSeries) GetArrowSize() int {
+	if cs.ArrowSize == 0 {
+		return DefaultCalloutArrowSize
+	}
+	return cs.ArrowSize
+}
+
+// GetMaxWidth returns the maximum label width or the default.
+func (cs CalloutSeries) GetMaxWidth() int {
+	if cs.MaxWidth == 0 {
+		return DefaultTextBoxMaxWidth
+	}
+	return cs.MaxWidth
+}
+
+func (cs CalloutSeries) styleDefaults(defaults Style) Style {
+	return cs.Style.InheritFrom(Style{
+		Font:        defaults.Font,
+		FontColor:   DefaultTextColor,
+		FontSize:    DefaultAnnotationFontSize,
+		FillColor:   DefaultAnnotationFillColor,
+		StrokeColor: DefaultAxisColor,
+		StrokeWidth: DefaultAxisLineWidth,
+		Padding:     DefaultAnnotationPadding,
+		TextWrap:    TextWrapWord,
+	})
+}
+
+// getBox returns the box the label of a callout to the point (px, py) is drawn in, kept within the canvas.
+func (cs CalloutSeries) getBox(r Renderer, canvasBox Box, c Callout, px, py int, style Style, lines []string) Box {
+	linesBox := Text.MeasureLines(r, lines, style)
+	padding := style.GetPadding()
+	width := linesBox.Width() + padding.GetLeft() + padding.GetRight()
+	height := linesBox.Height() + padding.GetTop() + padding.GetBottom()
+
+	dx, dy := c.GetOffset()
+	left, top := px+dx-width>>1, py+dy-height>>1
+	box := Box{Top: top, Left: left, Right: left + width, Bottom: top + height}
+	return box.Shift(MaxInt(0, canvasBox.Left-box.Left)+MinInt(0, canvasBox.Right-box.Right), MaxInt(0, canvasBox.Top-box.Top)+MinInt(0, canvasBox.Bottom-box.Bottom))
+}
+
+// getPath returns the points of the arrow from the label box to the point (px, py); it is empty
+// if the point is within the box.
+func (cs CalloutSeries) getPath(box Box, px, py int) []Point {
+	if px >= box.Left && px <= box.Right && py >= box.Top && py <= box.Bottom {
+		return nil
+	}
+	cx, cy := box.Center()
+
+	if cs.GetRouting() == CalloutRoutingStraight {
+		// leave the box where the line from its center to the point crosses its edge.
+		dx, dy := float64(px-cx), float64(py-cy)
+		scale := math.Min(
+			math.Abs(float64(box.Width()>>1)/dx),
+			math.Abs(float64(box.Height()>>1)/dy),
+		)
+		return []Point{
+			{X: cx + int(math.Round(dx*scale)), Y: cy + int(math.Round(dy*scale))},
+			{X: px, Y: py},
+		}
+	}
+
+	// the point is above or below the box; go straight up or down to it.
+	if px >= box.Left && px <= box.Right {
+		if py < box.Top {
+			return []Point{{X: px, Y: box.Top}, {X: px, Y: py}}
+		}
+		return []Point{{X: px, Y: box.Bottom}, {X: px, Y: py}}
+	}
+
+	// otherwise leave the side of the box facing the point, across to above or below the point and then to it.
+	sx := box.Left
+	if px > box.Right {
+		sx = box.Right
+	}
+	if py >= box.Top && py <= box.Bottom {
+		return []Point{{X: sx, Y: py}, {X: px, Y: py}}
+	}
+	return []Point{{X: sx, Y: cy}, {X: px, Y: cy}, {X: px, Y: py}}
+}
+
+// Render renders the series.
+func (cs CalloutSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if cs.Style.Hidden {
+		return
+	}
+	seriesStyle := cs.styleDefaults(defaults)
+	for _, c := range cs.Callouts {
+		if c.Style.Hidden || len(c.Label) == 0 {
+			continue
+		}
+		style := c.Style.InheritFrom(seriesStyle)
+		px := canvasBox.Left + xrange.Translate(c.XValue)
+		py := canvasBox.Bottom - yrange.Translate(c.YValue)
+
+		lines := Text.WrapFit(r, c.Label, cs.GetMaxWidth(), style)
+		box := cs.getBox(r, canvasBox, c, px, py, style, lines)
+
+		Draw.Arrow(r, cs.getPath(box, px, py), cs.GetArrowSize(), style)
+		Draw.Box(r, box, style)
+
+		style.GetTextOptions().WriteToRenderer(r)
+		y := box.Top + style.GetPadding().GetTop()
+		for _, line := range lines {
+			lineBox := r.MeasureText(line)
+			r.Text(line, box.Left+style.GetPadding().GetLeft(), y+lineBox.Height())
+			y += lineBox.Height() + style.GetTextLineSpacing()
+		}
+		r.ResetStyle()
+	}
+}
+
+// Validate validates the series.
+func (cs CalloutSeries) Validate() error {
+	if len(cs.Callouts) == 0 {
+		return fmt.Errorf("callout series requires callouts to be set")
+	}
+	if cs.Routing < CalloutRoutingUnset || cs.Routing > CalloutRoutingStraight {
+		return fmt.Errorf("callout series has an invalid routing: %d", cs.Routing)
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its callouts with the original.
+func (cs CalloutSeries) CopySeries() Series {
+	if cs.Callouts != nil {
+		cs.Callouts = append([]Callout(nil), cs.Callouts...)
+	}
+	return cs
+}
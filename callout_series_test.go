@@ -0,0 +1,82 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestCalloutSeriesPath(t *testing.T) {
+	// replaced new assertions helper
+
+	box := Box{Top: 10, Left: 10, Right: 50, Bottom: 30}
+	cs := CalloutSeries{}
+
+	// inside the box there is no arrow.
+	testutil.AssertEmpty(t, cs.getPath(box, 20, 20))
+
+	// below the box the arrow goes straight down.
+	testutil.AssertEqual(t, []Point{{X: 20, Y: 30}, {X: 20, Y: 60}}, cs.getPath(box, 20, 60))
+
+	// beside the box the arrow goes straight across.
+	testutil.AssertEqual(t, []Point{{X: 50, Y: 15}, {X: 80, Y: 15}}, cs.getPath(box, 80, 15))
+
+	// otherwise it goes across and then down with an elbow.
+	testutil.AssertEqual(t, []Point{{X: 50, Y: 20}, {X: 80, Y: 20}, {X: 80, Y: 60}}, cs.getPath(box, 80, 60))
+
+	cs.Routing = CalloutRoutingStraight
+	testutil.AssertEqual(t, []Point{{X: 40, Y: 30}, {X: 60, Y: 50}}, cs.getPath(box, 60, 50))
+}
+
+func TestCalloutSeriesBox(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(200, 200)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	cs := CalloutSeries{}
+	style := cs.styleDefaults(Style{Font: f})
+	canvasBox := Box{Top: 0, Left: 0, Right: 200, Bottom: 100}
+
+	box := cs.getBox(r, canvasBox, Callout{OffsetX: 10, OffsetY: 30}, 100, 20, style, []string{"event"})
+	cx, cy := box.Center()
+	testutil.AssertTrue(t, cx >= 109 && cx <= 111)
+	testutil.AssertTrue(t, cy >= 49 && cy <= 51)
+
+	// the default offset would put the label above the canvas, so it is kept within it.
+	box = cs.getBox(r, canvasBox, Callout{}, 100, 10, style, []string{"event"})
+	testutil.AssertEqual(t, 0, box.Top)
+}
+
+func TestCalloutSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, CalloutSeries{}.Validate())
+	testutil.AssertNotNil(t, CalloutSeries{Routing: 3, Callouts: []Callout{{Label: "event"}}}.Validate())
+	testutil.AssertEqual(t, CalloutRoutingElbow, CalloutSeries{}.GetRouting())
+	testutil.AssertEqual(t, DefaultCalloutArrowSize, CalloutSeries{}.GetArrowSize())
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}},
+			CalloutSeries{Callouts: []Callout{{Label: "launch", XValue: 2, YValue: 3}}},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), ">launch</text>")
+}
+
+func TestCalloutSeriesCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := CalloutSeries{Callouts: []Callout{{Label: "peak", XValue: 2, YValue: 5}}}
+	copied := cs.CopySeries().(CalloutSeries)
+	copied.Callouts[0].Label = "trough"
+	copied.Callouts[0].YValue = 1
+	testutil.AssertEqual(t, "peak", cs.Callouts[0].Label)
+	testutil.AssertEqual(t, 5.0, cs.Callouts[0].YValue)
+}
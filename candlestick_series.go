@@ -0,0 +1,169 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// DefaultCandlestickBodyWidth is the default width of a candle body as a fraction of the space per candle.
+	DefaultCandlestickBodyWidth = 0.7
+)
+
+// Interface Assertions.
+var (
+	_ Series                 = (*CandlestickSeries)(nil)
+	_ BoundedValuesProvider  = (*CandlestickSeries)(nil)
+	_ LastValuesProvider     = (*CandlestickSeries)(nil)
+	_ ValueFormatterProvider = (*CandlestickSeries)(nil)
+)
+
+// CandlestickSeries draws the open, high, low and close of each time bucket as a candle; a wick spans
+// the high and low, and a body spans the open and close, colored by whether the bucket closed up or down.
+type CandlestickSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// UpStyle is the style of candles that close at or above their open.
+	UpStyle Style
+	// DownStyle is the style of candles that close below their open.
+	DownStyle Style
+
+	// BodyWidth is the width of a candle body as a fraction of the space per candle.
+	BodyWidth float64
+
+	XValues     []time.Time
+	OpenValues  []float64
+	HighValues  []float64
+	LowValues   []float64
+	CloseValues []float64
+}
+
+// GetName returns the name of the time series.
+func (cs CandlestickSeries) GetName() string {
+	return cs.Name
+}
+
+// GetStyle returns the line style.
+func (cs CandlestickSeries) GetStyle() Style {
+	return cs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (cs CandlestickSeries) GetYAxis() YAxisType {
+	return cs.YAxis
+}
+
+// GetBodyWidth returns the body width or the default.
+func (cs CandlestickSeries) GetBodyWidth() float64 {
+	if cs.BodyWidth == 0 {
+		return DefaultCandlestickBodyWidth
+	}
+	return cs.BodyWidth
+}
+
+// Len returns the number of elements in the series.
+func (cs CandlestickSeries) Len() int {
+	return len(cs.XValues)
+}
+
+// GetValues gets the time and close of a candle.
+func (cs CandlestickSeries) GetValues(index int) (x, y float64) {
+	x = TimeToFloat64(cs.XValues[index])
+	y = cs.CloseValues[index]
+	return
+}
+
+// GetBoundedValues gets the time, high and low of a candle.
+func (cs CandlestickSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	x = TimeToFloat64(cs.XValues[index])
+	y1 = cs.HighValues[index]
+	y2 = cs.LowValues[index]
+	return
+}
+
+// GetLastValues gets the time and close of the last candle.
+func (cs CandlestickSeries) GetLastValues() (x, y float64) {
+	if len(cs.XValues) == 0 || len(cs.CloseValues) == 0 {
+		return
+	}
+	return cs.GetValues(len(cs.XValues) - 1)
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (cs CandlestickSeries) GetValueFormatters() (x, y ValueFormatter) {
+	x = TimeValueFormatter
+	y = FloatValueFormatter
+	return
+}
+
+// Render renders the series.
+func (cs CandlestickSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if cs.Len() == 0 {
+		return
+	}
+	style := cs.Style.InheritFrom(defaults.InheritFrom(Style{
+		StrokeWidth: 1.0,
+	}))
+	upStyle := cs.UpStyle.InheritFrom(Style{
+		StrokeColor: DefaultCandlestickUpColor,
+		FillColor:   DefaultCandlestickUpColor,
+		StrokeWidth: style.GetStrokeWidth(),
+	})
+	downStyle := cs.DownStyle.InheritFrom(Style{
+		StrokeColor: DefaultCandlestickDownColor,
+		FillColor:   DefaultCandlestickDownColor,
+		StrokeWidth: style.GetStrokeWidth(),
+	})
+
+	halfBodyWidth := MaxInt(1, int(math.Round(cs.GetBodyWidth()*float64(xrange.GetDomain())/float64(cs.Len())))>>1)
+	for index := 0; index < cs.Len(); index++ {
+		candleStyle := upStyle
+		if cs.CloseValues[index] < cs.OpenValues[index] {
+			candleStyle = downStyle
+		}
+
+		x := canvasBox.Left + xrange.Translate(TimeToFloat64(cs.XValues[index]))
+		high := canvasBox.Bottom - yrange.Translate(cs.HighValues[index])
+		low := canvasBox.Bottom - yrange.Translate(cs.LowValues[index])
+		open := canvasBox.Bottom - yrange.Translate(cs.OpenValues[index])
+		close := canvasBox.Bottom - yrange.Translate(cs.CloseValues[index])
+
+		candleStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(x, high)
+		r.LineTo(x, low)
+		r.Stroke()
+
+		Draw.Box(r, Box{
+			Top:    MinInt(open, close),
+			Left:   x - halfBodyWidth,
+			Right:  x + halfBodyWidth,
+			Bottom: MaxInt(open, close),
+		}, candleStyle)
+	}
+}
+
+// Validate validates the series.
+func (cs CandlestickSeries) Validate() error {
+	if len(cs.XValues) == 0 {
+		return fmt.Errorf("candlestick series must have xvalues set")
+	}
+	for _, values := range [][]float64{cs.OpenValues, cs.HighValues, cs.LowValues, cs.CloseValues} {
+		if len(values) != len(cs.XValues) {
+			return fmt.Errorf("candlestick series must have the same number of open, high, low and close values as xvalues")
+		}
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its values with the original.
+func (cs CandlestickSeries) CopySeries() Series {
+	cs.XValues = append([]time.Time(nil), cs.XValues...)
+	cs.OpenValues = append([]float64(nil), cs.OpenValues...)
+	cs.HighValues = append([]float64(nil), cs.HighValues...)
+	cs.LowValues = append([]float64(nil), cs.LowValues...)
+	cs.CloseValues = append([]float64(nil), cs.CloseValues...)
+	return cs
+}
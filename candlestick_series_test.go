@@ -0,0 +1,60 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testCandlestickSeries() CandlestickSeries {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return CandlestickSeries{
+		XValues:     []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)},
+		OpenValues:  []float64{10, 12, 11},
+		HighValues:  []float64{13, 14, 12},
+		LowValues:   []float64{9, 10, 8},
+		CloseValues: []float64{12, 11, 9},
+	}
+}
+
+func TestCandlestickSeriesValues(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := testCandlestickSeries()
+	testutil.AssertEqual(t, 3, cs.Len())
+	testutil.AssertEqual(t, DefaultCandlestickBodyWidth, cs.GetBodyWidth())
+
+	x, y1, y2 := cs.GetBoundedValues(1)
+	testutil.AssertEqual(t, TimeToFloat64(cs.XValues[1]), x)
+	testutil.AssertEqual(t, 14.0, y1)
+	testutil.AssertEqual(t, 10.0, y2)
+
+	x, y := cs.GetLastValues()
+	testutil.AssertEqual(t, TimeToFloat64(cs.XValues[2]), x)
+	testutil.AssertEqual(t, 9.0, y)
+}
+
+func TestCandlestickSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := testCandlestickSeries()
+	testutil.AssertNil(t, cs.Validate())
+
+	cs.LowValues = cs.LowValues[:2]
+	testutil.AssertNotNil(t, cs.Validate())
+	testutil.AssertNotNil(t, CandlestickSeries{}.Validate())
+}
+
+func TestCandlestickSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	graph := Chart{
+		Series: []Series{testCandlestickSeries()},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
@@ -0,0 +1,131 @@
+package chart
+
+import "fmt"
+
+// Interface Assertions.
+var (
+	_ Series              = (*CategorySeries)(nil)
+	_ ValuesProvider      = (*CategorySeries)(nil)
+	_ FirstValuesProvider = (*CategorySeries)(nil)
+	_ LastValuesProvider  = (*CategorySeries)(nil)
+)
+
+// NewCategoryRange returns an ordinal range for the given categories, in order, for a categorical x-axis.
+func NewCategoryRange(categories ...string) *OrdinalRange {
+	keys := make([]interface{}, len(categories))
+	for index, category := range categories {
+		keys[index] = category
+	}
+	return NewOrdinalRange(keys...)
+}
+
+// CategorySeries is a line of values by category, e.g. sales by region, where the value at each index is
+// drawn in the slot of the category at the same index.
+//
+// The x values of the series are the indexes of its values. If the x-axis has no range or categories of
+// its own, the chart ranges it by the categories of the series (see `XAxis.Categories`).
+type CategorySeries struct {
+	Name  string
+	Style Style
+
+	YAxis YAxisType
+
+	YValueFormatter ValueFormatter
+
+	Categories []string
+	Values     []float64
+}
+
+// GetName returns the name of the series.
+func (cs CategorySeries) GetName() string {
+	return cs.Name
+}
+
+// GetStyle returns the line style.
+func (cs CategorySeries) GetStyle() Style {
+	return cs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (cs CategorySeries) GetYAxis() YAxisType {
+	return cs.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (cs CategorySeries) Len() int {
+	return len(cs.Values)
+}
+
+// GetValues gets the x,y values at a given index, where x is the index.
+func (cs CategorySeries) GetValues(index int) (float64, float64) {
+	return float64(index), cs.Values[index]
+}
+
+// GetFirstValues gets the first x,y values.
+func (cs CategorySeries) GetFirstValues() (x, y float64) {
+	if len(cs.Values) == 0 {
+		return
+	}
+	return 0, cs.Values[0]
+}
+
+// GetLastValues gets the last x,y values.
+func (cs CategorySeries) GetLastValues() (x, y float64) {
+	if len(cs.Values) == 0 {
+		return
+	}
+	return float64(len(cs.Values) - 1), cs.Values[len(cs.Values)-1]
+}
+
+// GetCategory returns the category of the value at a given index, or an empty string if it has none.
+func (cs CategorySeries) GetCategory(index int) string {
+	if index < 0 || index >= len(cs.Categories) {
+		return ""
+	}
+	return cs.Categories[index]
+}
+
+// GetValueFormatters returns value formatter defaults for the series, with the categories as x labels.
+func (cs CategorySeries) GetValueFormatters() (x, y ValueFormatter) {
+	x = func(v interface{}) string {
+		if typed, isTyped := v.(float64); isTyped {
+			return cs.GetCategory(int(typed))
+		}
+		return ""
+	}
+	if cs.YValueFormatter != nil {
+		y = cs.YValueFormatter
+	} else {
+		y = FloatValueFormatter
+	}
+	return
+}
+
+// GetXRange returns an ordinal range of the categories, for the x axis.
+func (cs CategorySeries) GetXRange() *OrdinalRange {
+	return NewCategoryRange(cs.Categories...)
+}
+
+// Render renders the series.
+func (cs CategorySeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := cs.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, cs)
+}
+
+// Validate validates the series.
+func (cs CategorySeries) Validate() error {
+	if len(cs.Values) == 0 {
+		return fmt.Errorf("category series; must have values set")
+	}
+	if len(cs.Categories) > 0 && len(cs.Categories) != len(cs.Values) {
+		return fmt.Errorf("category series; must have as many categories as values")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its values with the original.
+func (cs CategorySeries) CopySeries() Series {
+	cs.Categories = append([]string(nil), cs.Categories...)
+	cs.Values = append([]float64(nil), cs.Values...)
+	return cs
+}
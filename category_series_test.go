@@ -0,0 +1,62 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestCategorySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	cs := CategorySeries{
+		Categories: []string{"north", "south", "east"},
+		Values:     []float64{3, 1, 2},
+	}
+	testutil.AssertNil(t, cs.Validate())
+	testutil.AssertEqual(t, 3, cs.Len())
+
+	x, y := cs.GetValues(1)
+	testutil.AssertEqual(t, 1.0, x)
+	testutil.AssertEqual(t, 1.0, y)
+	x, y = cs.GetLastValues()
+	testutil.AssertEqual(t, 2.0, x)
+	testutil.AssertEqual(t, 2.0, y)
+
+	xf, _ := cs.GetValueFormatters()
+	testutil.AssertEqual(t, "east", xf(2.0))
+	testutil.AssertEqual(t, "", xf(3.0))
+
+	position, ok := cs.GetXRange().Position("south")
+	testutil.AssertTrue(t, ok)
+	testutil.AssertEqual(t, 1.0, position)
+
+	testutil.AssertNotNil(t, CategorySeries{}.Validate())
+	testutil.AssertNotNil(t, CategorySeries{Categories: []string{"north"}, Values: []float64{1, 2}}.Validate())
+}
+
+func TestChartGetRangesCategories(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		XAxis: XAxis{Categories: []string{"a", "b", "c"}},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{0, 1, 2}, YValues: []float64{1, 2, 3}},
+		},
+	}
+	xrange, _, _ := c.getRanges()
+	or, isOrdinal := xrange.(*OrdinalRange)
+	testutil.AssertTrue(t, isOrdinal)
+	testutil.AssertLen(t, or.Keys, 3)
+
+	c = Chart{
+		Series: []Series{
+			CategorySeries{Categories: []string{"one", "two"}, Values: []float64{1, 2}},
+			CategorySeries{Values: []float64{2, 1}},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), "two")
+}
@@ -0,0 +1,168 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultChangePointPenalty is the default penalty of a change point detection.
+	DefaultChangePointPenalty = 3.0
+	// DefaultChangePointMinSegmentLength is the default minimum number of values between change points.
+	DefaultChangePointMinSegmentLength = 5
+)
+
+// ChangePointDetection finds the points where the mean of a series shifts, by binary segmentation: the
+// values are split where the split most reduces the sum of squared differences from the segment means,
+// and the segments are split again, for as long as a split reduces it by more than the penalty.
+//
+// The penalty is in units of the variance of the noise, estimated from the differences between
+// consecutive values, times the log of the number of values; raise it to find fewer change points.
+type ChangePointDetection struct {
+	Penalty          float64
+	MinSegmentLength int
+	// MaxChangePoints, if set, limits the number of change points to the strongest ones.
+	MaxChangePoints int
+
+	InnerSeries ValuesProvider
+}
+
+// GetPenalty returns the penalty or the default.
+func (cpd ChangePointDetection) GetPenalty() float64 {
+	if cpd.Penalty == 0 {
+		return DefaultChangePointPenalty
+	}
+	return cpd.Penalty
+}
+
+// GetMinSegmentLength returns the minimum segment length or the default.
+func (cpd ChangePointDetection) GetMinSegmentLength() int {
+	if cpd.MinSegmentLength == 0 {
+		return DefaultChangePointMinSegmentLength
+	}
+	return cpd.MinSegmentLength
+}
+
+// Validate validates the detection.
+func (cpd ChangePointDetection) Validate() error {
+	if cpd.InnerSeries == nil {
+		return fmt.Errorf("change point detection requires InnerSeries to be set")
+	}
+	if cpd.GetMinSegmentLength() < 1 {
+		return fmt.Errorf("change point detection requires a MinSegmentLength of at least 1")
+	}
+	if cpd.GetPenalty() < 0 {
+		return fmt.Errorf("change point detection requires a positive Penalty")
+	}
+	return nil
+}
+
+// Detect returns the indexes of the values that start a new segment, in order.
+func (cpd ChangePointDetection) Detect() ([]int, error) {
+	if err := cpd.Validate(); err != nil {
+		return nil, err
+	}
+	length := cpd.InnerSeries.Len()
+	values := make([]float64, length)
+	sums := make([]float64, length+1)
+	squares := make([]float64, length+1)
+	for index := 0; index < length; index++ {
+		_, values[index] = cpd.InnerSeries.GetValues(index)
+		sums[index+1] = sums[index] + values[index]
+		squares[index+1] = squares[index] + values[index]*values[index]
+	}
+	if length < 2*cpd.GetMinSegmentLength() {
+		return nil, nil
+	}
+
+	// cost is the sum of squared differences from the mean of the values in [start, end).
+	cost := func(start, end int) float64 {
+		sum := sums[end] - sums[start]
+		return squares[end] - squares[start] - sum*sum/float64(end-start)
+	}
+	minLength := cpd.GetMinSegmentLength()
+	bestSplit := func(start, end int) (split int, gain float64) {
+		whole := cost(start, end)
+		for index := start + minLength; index <= end-minLength; index++ {
+			if g := whole - cost(start, index) - cost(index, end); g > gain {
+				split, gain = index, g
+			}
+		}
+		return
+	}
+
+	// the floor keeps rounding errors from splitting values without any noise.
+	threshold := math.Max(cpd.GetPenalty()*cpd.noiseVariance(values)*math.Log(float64(length)), 1e-9)
+	segments := [][2]int{{0, length}}
+	var splits []int
+	for cpd.MaxChangePoints == 0 || len(splits) < cpd.MaxChangePoints {
+		bestSegment, bestIndex, bestGain := -1, 0, threshold
+		for segment, bounds := range segments {
+			if index, gain := bestSplit(bounds[0], bounds[1]); gain > bestGain {
+				bestSegment, bestIndex, bestGain = segment, index, gain
+			}
+		}
+		if bestSegment < 0 {
+			break
+		}
+		bounds := segments[bestSegment]
+		segments[bestSegment] = [2]int{bounds[0], bestIndex}
+		segments = append(segments, [2]int{bestIndex, bounds[1]})
+		splits = append(splits, bestIndex)
+	}
+	sort.Ints(splits)
+	return splits, nil
+}
+
+// noiseVariance estimates the variance of the noise from the median absolute difference between
+// consecutive values, which shifts in the mean barely move.
+func (cpd ChangePointDetection) noiseVariance(values []float64) float64 {
+	differences := make([]float64, len(values)-1)
+	for index := range differences {
+		differences[index] = math.Abs(values[index+1] - values[index])
+	}
+	sigma := ValueSequence(differences...).Median() / (0.6745 * math.Sqrt2)
+	return sigma * sigma
+}
+
+// EventSeries returns the change points as events with lines across the canvas, at the x value of the
+// first value of each new segment and labeled with the change in the mean, to overlay on a chart of the
+// inner series. If there are no change points the series has no events, and should be left off the chart.
+func (cpd ChangePointDetection) EventSeries() (EventSeries, error) {
+	splits, err := cpd.Detect()
+	if err != nil {
+		return EventSeries{}, err
+	}
+
+	yf := ValueFormatter(FloatValueFormatter)
+	if vfp, isVfp := cpd.InnerSeries.(ValueFormatterProvider); isVfp {
+		if _, vf := vfp.GetValueFormatters(); vf != nil {
+			yf = vf
+		}
+	}
+	mean := func(start, end int) float64 {
+		var sum float64
+		for index := start; index < end; index++ {
+			_, y := cpd.InnerSeries.GetValues(index)
+			sum += y
+		}
+		return sum / float64(end-start)
+	}
+
+	es := EventSeries{
+		Name:  "Change Points",
+		Lines: true,
+	}
+	bounds := append(append([]int{0}, splits...), cpd.InnerSeries.Len())
+	for index, split := range splits {
+		x, _ := cpd.InnerSeries.GetValues(split)
+		shift := mean(split, bounds[index+2]) - mean(bounds[index], split)
+		label := yf(shift)
+		if shift > 0 {
+			label = "+" + label
+		}
+		es.Events = append(es.Events, Event{XValue: x, Label: label})
+	}
+	return es, nil
+}
@@ -0,0 +1,59 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func changePointTestSeries(means ...float64) ContinuousSeries {
+	var cs ContinuousSeries
+	for segment, mean := range means {
+		for index := 0; index < 20; index++ {
+			noise := 0.5
+			if index%2 == 0 {
+				noise = -0.5
+			}
+			cs.XValues = append(cs.XValues, float64(segment*20+index))
+			cs.YValues = append(cs.YValues, mean+noise)
+		}
+	}
+	return cs
+}
+
+func TestChangePointDetectionDetect(t *testing.T) {
+	// replaced new assertions helper
+
+	_, err := ChangePointDetection{}.Detect()
+	testutil.AssertNotNil(t, err)
+
+	splits, err := ChangePointDetection{InnerSeries: changePointTestSeries(10, 15, 12)}.Detect()
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []int{20, 40}, splits)
+
+	// the strongest change point is kept.
+	splits, err = ChangePointDetection{InnerSeries: changePointTestSeries(10, 15, 12), MaxChangePoints: 1}.Detect()
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []int{20}, splits)
+
+	splits, err = ChangePointDetection{InnerSeries: changePointTestSeries(10, 10)}.Detect()
+	testutil.AssertNil(t, err)
+	testutil.AssertEmpty(t, splits)
+
+	splits, err = ChangePointDetection{InnerSeries: ContinuousSeries{XValues: []float64{0, 1, 2}, YValues: []float64{1, 5, 1}}}.Detect()
+	testutil.AssertNil(t, err)
+	testutil.AssertEmpty(t, splits)
+}
+
+func TestChangePointDetectionEventSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	es, err := ChangePointDetection{InnerSeries: changePointTestSeries(10, 15, 12)}.EventSeries()
+	testutil.AssertNil(t, err)
+	testutil.AssertTrue(t, es.Lines)
+	testutil.AssertLen(t, es.Events, 2)
+	testutil.AssertEqual(t, 20.0, es.Events[0].XValue)
+	testutil.AssertEqual(t, "+5.00", es.Events[0].Label)
+	testutil.AssertEqual(t, 40.0, es.Events[1].XValue)
+	testutil.AssertEqual(t, "-3.00", es.Events[1].Label)
+}
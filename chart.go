@@ -1,12 +1,18 @@
 package chart
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"image"
 	"io"
+	"io/ioutil"
 	"math"
+	"time"
 
 	"github.com/golang/freetype/truetype"
+	xdraw "golang.org/x/image/draw"
 )
 
 // Chart is what we're drawing.
@@ -20,6 +26,15 @@ type Chart struct {
 	Height int
 	DPI    float64
 
+	// CanvasWidth and CanvasHeight, if set, size the chart to fit a canvas of the given size
+	// along with the title, axes and annotations around it, and any elements drawn outside it,
+	// in place of `Width` and `Height`.
+	CanvasWidth  int
+	CanvasHeight int
+
+	// Background and Canvas are not drawn if they are hidden, leaving them
+	// transparent so the chart can be composited over another image.
+	// `Canvas.Margin` is the space between the canvas and the axes around it.
 	Background Style
 	Canvas     Style
 
@@ -33,7 +48,23 @@ type Chart struct {
 	Series   []Series
 	Elements []Renderable
 
-	Log Logger
+	Log    Logger
+	Tracer Tracer
+
+	// RecoverPanics converts panics raised while rendering into a `*RenderError`
+	// rather than propagating them to the caller. The other chart types can use `RecoverRender`.
+	RecoverPanics bool
+
+	// SnapshotSeries copies each series with `CopySeries` when a render starts, so that
+	// callers can keep writing to the underlying values while the render is in progress.
+	// Series that do not implement `SeriesCopier`, and inner series that do not, e.g. `MACDSeries`,
+	// are not copied, so their values are still shared.
+	SnapshotSeries bool
+
+	// Sparkline draws just the series, at a small size, e.g. to embed in a table: the axes, title,
+	// annotations, elements and most of the padding are left out, and the size defaults to
+	// `DefaultSparklineWidth` by `DefaultSparklineHeight`.
+	Sparkline bool
 }
 
 // GetDPI returns the dpi for the chart.
@@ -58,6 +89,9 @@ func (c Chart) GetFont() *truetype.Font {
 // GetWidth returns the chart width or the default value.
 func (c Chart) GetWidth() int {
 	if c.Width == 0 {
+		if c.Sparkline {
+			return DefaultSparklineWidth
+		}
 		return DefaultChartWidth
 	}
 	return c.Width
@@ -66,87 +100,310 @@ func (c Chart) GetWidth() int {
 // GetHeight returns the chart height or the default value.
 func (c Chart) GetHeight() int {
 	if c.Height == 0 {
+		if c.Sparkline {
+			return DefaultSparklineHeight
+		}
 		return DefaultChartHeight
 	}
 	return c.Height
 }
 
+// Clone returns a copy of the chart, including its styles and axes.
+// Series and elements are copied by reference, so a cloned chart can have its series
+// replaced without affecting the original.
+func (c Chart) Clone() Chart {
+	c.Background = c.Background.Clone()
+	c.Canvas = c.Canvas.Clone()
+	c.TitleStyle = c.TitleStyle.Clone()
+	c.XAxis = c.XAxis.Clone()
+	c.YAxis = c.YAxis.Clone()
+	c.YAxisSecondary = c.YAxisSecondary.Clone()
+	if c.Series != nil {
+		c.Series = append([]Series{}, c.Series...)
+	}
+	if c.Elements != nil {
+		c.Elements = append([]Renderable{}, c.Elements...)
+	}
+	return c
+}
+
+// RenderContext renders the chart like `Render`, tracing the render and its stages as spans
+// that are children of the span in the context if the chart's tracer is a `ContextTracer`.
+func (c Chart) RenderContext(ctx context.Context, rp RendererProvider, w io.Writer) error {
+	typed, isTyped := c.Tracer.(ContextTracer)
+	if !isTyped || ctx == nil {
+		return c.Render(rp, w)
+	}
+	started := time.Now()
+	ctx, end := typed.StartStage(ctx, RenderStageRender)
+	c.Tracer = contextTracer{ctx: ctx, tracer: typed}
+	err := c.Render(rp, w)
+	end(TraceInfo{Stage: RenderStageRender, Started: started, Elapsed: time.Since(started), Err: err})
+	return err
+}
+
+// RenderInto renders the chart into a region of an existing image, e.g. to compose it with other
+// drawing, sizing the chart to the region in place of its width and height. The region is clipped to
+// the bounds of the image. An `*image.RGBA` is drawn into directly, without an intermediate buffer.
+// Errors returned are of type `*RenderError`.
+func (c Chart) RenderInto(dst xdraw.Image, bounds image.Rectangle) error {
+	bounds = bounds.Intersect(dst.Bounds())
+	if bounds.Empty() {
+		return newRenderError(RenderStageRenderer, errors.New("the region to render into is empty"))
+	}
+	c.Width, c.Height = bounds.Dx(), bounds.Dy()
+	c.CanvasWidth, c.CanvasHeight = 0, 0
+	return c.Render(imageRegion(dst, bounds), ioutil.Discard)
+}
+
+// RenderBytes renders the chart with the given renderer, returning the encoded output, e.g. to
+// set the length of a response before writing it. Errors returned are of type `*RenderError`.
+func (c Chart) RenderBytes(rp RendererProvider) ([]byte, error) {
+	buffer := bytes.NewBuffer(nil)
+	if err := c.Render(rp, buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// WriterTo returns an io.WriterTo that renders the chart with the given renderer, counting the bytes written.
+func (c Chart) WriterTo(rp RendererProvider) io.WriterTo {
+	return chartWriterTo{c: c, rp: rp}
+}
+
+// chartWriterTo renders a chart as an io.WriterTo.
+type chartWriterTo struct {
+	c  Chart
+	rp RendererProvider
+}
+
+// WriteTo implements io.WriterTo.
+func (cwt chartWriterTo) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	err := cwt.c.Render(cwt.rp, cw)
+	return int64(cw.n), err
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
-func (c Chart) Render(rp RendererProvider, w io.Writer) error {
-	if len(c.Series) == 0 {
-		return errors.New("please provide at least one series")
+// Errors returned are of type `*RenderError`.
+//
+// Render does not modify the chart or its axis ranges, so a chart may be rendered
+// concurrently as long as its series are not modified while rendering. Note that some
+// derived series (e.g. `EMASeries`, `MinSeries`) cache their values on first use, and
+// should not be shared between concurrent renders.
+func (c Chart) Render(rp RendererProvider, w io.Writer) (err error) {
+	stage, seriesIndex := RenderStageValidate, 0
+	var span stageSpan
+	defer func() {
+		if c.RecoverPanics {
+			if r := recover(); r != nil {
+				err = c.recoverRenderError(stage, seriesIndex, r)
+			}
+		}
+		// end the stage that was in progress when the render failed, with the error.
+		var info TraceInfo
+		if span.stage == RenderStageSeries && seriesIndex < len(c.Series) {
+			info = traceSeriesInfo(seriesIndex, c.Series[seriesIndex], Box{})
+		}
+		info.Err = err
+		span.End(info)
+	}()
+
+	if c.SnapshotSeries {
+		c.Series = c.snapshotSeries()
 	}
-	if err := c.checkHasVisibleSeries(); err != nil {
+	if c, err = c.prepare(); err != nil {
 		return err
 	}
 
-	c.YAxisSecondary.AxisType = YAxisSecondary
+	if c.CanvasWidth > 0 || c.CanvasHeight > 0 {
+		stage = RenderStageLayout
+		if c, err = c.shrinkWrap(rp); err != nil {
+			return newRenderError(stage, err)
+		}
+	}
 
+	stage = RenderStageRenderer
 	r, err := rp(c.GetWidth(), c.GetHeight())
 	if err != nil {
-		return err
+		return newRenderError(RenderStageRenderer, err)
+	}
+	r.SetDPI(c.GetDPI(DefaultDPI))
+
+	stage = RenderStageBackground
+	span = startStage(c.Tracer, stage)
+	c.drawBackground(r)
+	span.End(TraceInfo{})
+
+	stage = RenderStageRanges
+	span = startStage(c.Tracer, stage)
+	l, err := c.layoutRanges()
+	if err != nil {
+		r.Save(w)
+		return newRenderError(RenderStageRanges, err)
+	}
+	span.End(TraceInfo{Canvas: l.canvasBox})
+
+	stage = RenderStageLayout
+	span = startStage(c.Tracer, stage)
+	l = c.layoutCanvas(r, l)
+	canvasBox := l.canvasBox
+	c.drawCanvas(r, canvasBox)
+	span.End(TraceInfo{Canvas: canvasBox})
+
+	stage = RenderStageAxes
+	span = startStage(c.Tracer, stage)
+	c.drawAxes(r, canvasBox, l.xr, l.yr, l.yra, l.xt, l.yt, l.yta)
+	span.End(TraceInfo{Canvas: canvasBox})
+
+	stage = RenderStageSeries
+	for index, series := range c.Series {
+		seriesIndex, span = index, startStage(c.Tracer, stage)
+		c.drawSeries(r, canvasBox, l.xr, l.yr, l.yra, series, index)
+		span.End(traceSeriesInfo(index, series, canvasBox))
+	}
+
+	stage = RenderStageElements
+	span = startStage(c.Tracer, stage)
+	c.drawTitle(r)
+
+	for _, a := range c.Elements {
+		a(r, canvasBox, c.styleDefaultsElements())
 	}
+	span.End(TraceInfo{Canvas: canvasBox})
+
+	stage = RenderStageEncode
+	span = startStage(c.Tracer, stage)
+	cw := &countingWriter{w: w}
+	if _, isCollector := w.(RGBACollector); isCollector || c.Tracer == nil {
+		err = r.Save(w)
+	} else {
+		err = r.Save(cw)
+	}
+	span.End(TraceInfo{Canvas: canvasBox, Bytes: cw.n, Err: err})
+	return newRenderError(RenderStageEncode, err)
+}
+
+// prepare validates the chart and sets it up to be laid out, e.g. as a sparkline or with the default font.
+func (c Chart) prepare() (Chart, error) {
+	if c.Sparkline {
+		c = c.asSparkline()
+	}
+
+	if len(c.Series) == 0 {
+		return c, newRenderError(RenderStageValidate, errors.New("please provide at least one series"))
+	}
+	if err := c.checkHasVisibleSeries(); err != nil {
+		return c, newRenderError(RenderStageValidate, err)
+	}
+	if err := c.validateSeries(); err != nil {
+		return c, err
+	}
+	c.YAxisSecondary.AxisType = YAxisSecondary
 
 	if c.Font == nil {
 		defaultFont, err := GetDefaultFont()
 		if err != nil {
-			return err
+			return c, newRenderError(RenderStageFonts, err)
 		}
 		c.defaultFont = defaultFont
 	}
-	r.SetDPI(c.GetDPI(DefaultDPI))
-
-	c.drawBackground(r)
-
-	var xt, yt, yta []Tick
-	xr, yr, yra := c.getRanges()
-	canvasBox := c.getDefaultCanvasBox()
-	xf, yf, yfa := c.getValueFormatters()
-
-	Debugf(c.Log, "chart; canvas box: %v", canvasBox)
+	return c, nil
+}
 
-	xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+// chartLayout is where a chart is laid out on a renderer: its canvas box, and the ranges and ticks
+// of its axes fit to it.
+type chartLayout struct {
+	canvasBox   Box
+	xr, yr, yra Range
+	xt, yt, yta []Tick
+}
 
-	err = c.checkRanges(xr, yr, yra)
+// layout lays out the chart on a renderer without drawing to it, e.g. to line up the canvases
+// of the charts of a stack.
+func (c Chart) layout(r Renderer) (chartLayout, error) {
+	l, err := c.layoutRanges()
 	if err != nil {
-		r.Save(w)
-		return err
+		return l, err
 	}
+	return c.layoutCanvas(r, l), nil
+}
+
+// layoutRanges fits the ranges to the default canvas box, before it is adjusted for the axes.
+func (c Chart) layoutRanges() (chartLayout, error) {
+	l := chartLayout{canvasBox: c.getDefaultCanvasBox()}
+	Debugf(c.Log, "chart; canvas box: %v", l.canvasBox)
 
+	xr, yr, yra := c.getRanges()
+	l.xr, l.yr, l.yra = c.setRangeDomains(l.canvasBox, xr, yr, yra)
+	return l, c.checkRanges(l.xr, l.yr, l.yra)
+}
+
+// layoutCanvas shrinks the canvas box to make room for the axes and annotations, refitting the
+// ranges and ticks to it.
+func (c Chart) layoutCanvas(r Renderer, l chartLayout) chartLayout {
+	xf, yf, yfa := c.getValueFormatters()
 	if c.hasAxes() {
-		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
-		canvasBox = c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
-		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		l.xt, l.yt, l.yta = c.getAxesTicks(r, l.xr, l.yr, l.yra, xf, yf, yfa)
+		l.canvasBox = c.getAxesAdjustedCanvasBox(r, l.canvasBox, l.xr, l.yr, l.yra, l.xt, l.yt, l.yta)
+		l.xr, l.yr, l.yra = c.setRangeDomains(l.canvasBox, l.xr, l.yr, l.yra)
 
-		Debugf(c.Log, "chart; axes adjusted canvas box: %v", canvasBox)
+		Debugf(c.Log, "chart; axes adjusted canvas box: %v", l.canvasBox)
 
 		// do a second pass in case things haven't settled yet.
-		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
-		canvasBox = c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
-		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		l.xt, l.yt, l.yta = c.getAxesTicks(r, l.xr, l.yr, l.yra, xf, yf, yfa)
+		l.canvasBox = c.getAxesAdjustedCanvasBox(r, l.canvasBox, l.xr, l.yr, l.yra, l.xt, l.yt, l.yta)
+		l.xr, l.yr, l.yra = c.setRangeDomains(l.canvasBox, l.xr, l.yr, l.yra)
 	}
 
 	if c.hasAnnotationSeries() {
-		canvasBox = c.getAnnotationAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xf, yf, yfa)
-		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
-		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		l.canvasBox = c.getAnnotationAdjustedCanvasBox(r, l.canvasBox, l.xr, l.yr, l.yra, xf, yf, yfa)
+		l.xr, l.yr, l.yra = c.setRangeDomains(l.canvasBox, l.xr, l.yr, l.yra)
+		l.xt, l.yt, l.yta = c.getAxesTicks(r, l.xr, l.yr, l.yra, xf, yf, yfa)
 
-		Debugf(c.Log, "chart; annotation adjusted canvas box: %v", canvasBox)
+		Debugf(c.Log, "chart; annotation adjusted canvas box: %v", l.canvasBox)
 	}
+	return l
+}
 
-	c.drawCanvas(r, canvasBox)
-	c.drawAxes(r, canvasBox, xr, yr, yra, xt, yt, yta)
-	for index, series := range c.Series {
-		c.drawSeries(r, canvasBox, xr, yr, yra, series, index)
+// asSparkline returns the chart with its axes, title, annotations and elements (e.g. legends) hidden,
+// and unless it is set, the padding reduced to just enough to keep the lines from being clipped at the edges.
+func (c Chart) asSparkline() Chart {
+	c.TitleStyle.Hidden = true
+	c.XAxis.Style.Hidden = true
+	c.YAxis.Style.Hidden = true
+	c.YAxisSecondary.Style.Hidden = true
+	c.Elements = nil
+	series := make([]Series, len(c.Series))
+	for index, s := range c.Series {
+		// hide rather than drop the annotations, so the series keep their default colors.
+		if as, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
+			as.Style.Hidden = true
+			s = as
+		}
+		series[index] = s
 	}
+	c.Series = series
+	if c.Background.Padding.IsZero() {
+		c.Background.Padding = NewBox(DefaultSparklinePadding, DefaultSparklinePadding, DefaultSparklinePadding, DefaultSparklinePadding)
+	}
+	return c
+}
 
-	c.drawTitle(r)
-
-	for _, a := range c.Elements {
-		a(r, canvasBox, c.styleDefaultsElements())
+func (c Chart) recoverRenderError(stage RenderStage, seriesIndex int, r interface{}) error {
+	cause := panicError(r)
+	if stage == RenderStageSeries && seriesIndex < len(c.Series) {
+		return newSeriesRenderError(seriesIndex, c.Series[seriesIndex], cause)
 	}
+	return newRenderError(stage, cause)
+}
 
-	return r.Save(w)
+func (c Chart) snapshotSeries() []Series {
+	output := make([]Series, len(c.Series))
+	for index, s := range c.Series {
+		output[index] = CopySeries(s)
+	}
+	return output
 }
 
 func (c Chart) checkHasVisibleSeries() error {
@@ -162,10 +419,17 @@ func (c Chart) checkHasVisibleSeries() error {
 
 func (c Chart) validateSeries() error {
 	var err error
-	for _, s := range c.Series {
+	for index, s := range c.Series {
+		if s.GetStyle().Hidden {
+			continue
+		}
+		// series without values draw nothing, rather than failing the render.
+		if vp, isValuesProvider := s.(ValuesProvider); isValuesProvider && vp.Len() == 0 {
+			continue
+		}
 		err = s.Validate()
 		if err != nil {
-			return err
+			return newSeriesRenderError(index, s, err)
 		}
 	}
 	return nil
@@ -226,21 +490,27 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 	}
 
 	if c.XAxis.Range == nil {
-		xrange = &ContinuousRange{}
+		if categories := c.getCategories(); len(categories) > 0 {
+			categoryRange := NewCategoryRange(categories...)
+			categoryRange.Descending = c.XAxis.Descending
+			xrange = categoryRange
+		} else {
+			xrange = &ContinuousRange{Descending: c.XAxis.Descending}
+		}
 	} else {
-		xrange = c.XAxis.Range
+		xrange = CloneRange(c.XAxis.Range)
 	}
 
 	if c.YAxis.Range == nil {
 		yrange = &ContinuousRange{}
 	} else {
-		yrange = c.YAxis.Range
+		yrange = CloneRange(c.YAxis.Range)
 	}
 
 	if c.YAxisSecondary.Range == nil {
 		yrangeAlt = &ContinuousRange{}
 	} else {
-		yrangeAlt = c.YAxisSecondary.Range
+		yrangeAlt = CloneRange(c.YAxisSecondary.Range)
 	}
 
 	if len(c.XAxis.Ticks) > 0 {
@@ -280,7 +550,7 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 
 	if len(c.YAxisSecondary.Ticks) > 0 {
 		tickMin, tickMax := math.MaxFloat64, -math.MaxFloat64
-		for _, t := range c.YAxis.Ticks {
+		for _, t := range c.YAxisSecondary.Ticks {
 			tickMin = math.Min(tickMin, t.Value)
 			tickMax = math.Max(tickMax, t.Value)
 		}
@@ -299,9 +569,46 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		}
 	}
 
+	if !c.YAxis.Break.IsZero() {
+		yrange = c.getBrokenRange(yrange, c.YAxis.Break)
+	}
+
+	if c.YAxisSecondary.Transform != nil {
+		yrangeAlt = c.getTransformedRange(yrange)
+	} else if !c.YAxisSecondary.Break.IsZero() {
+		yrangeAlt = c.getBrokenRange(yrangeAlt, c.YAxisSecondary.Break)
+	}
+
 	return
 }
 
+// getBrokenRange returns a continuous range with the bounds of a given range, but with a break cut out of it.
+func (c Chart) getBrokenRange(ra Range, ab AxisBreak) Range {
+	return &BrokenRange{
+		ContinuousRange: ContinuousRange{Min: ra.GetMin(), Max: ra.GetMax(), Descending: ra.IsDescending()},
+		Break:           ab,
+	}
+}
+
+// getTransformedRange returns a range for the secondary y-axis that mirrors the primary range
+// in the units of the secondary axis transform.
+func (c Chart) getTransformedRange(yrange Range) Range {
+	min, max := c.YAxisSecondary.Transform(yrange.GetMin()), c.YAxisSecondary.Transform(yrange.GetMax())
+	descending := yrange.IsDescending()
+	if min > max {
+		min, max, descending = max, min, !descending
+	}
+	transformed := ContinuousRange{Min: min, Max: max, Descending: descending}
+	if br, isBroken := yrange.(*BrokenRange); isBroken {
+		breakMin, breakMax := c.YAxisSecondary.Transform(br.Break.Min), c.YAxisSecondary.Transform(br.Break.Max)
+		return &BrokenRange{
+			ContinuousRange: transformed,
+			Break:           AxisBreak{Min: math.Min(breakMin, breakMax), Max: math.Max(breakMin, breakMax), Style: br.Break.Style},
+		}
+	}
+	return &transformed
+}
+
 func (c Chart) checkRanges(xr, yr, yra Range) error {
 	Debugf(c.Log, "checking xrange: %v", xr)
 	xDelta := xr.GetDelta()
@@ -324,7 +631,7 @@ func (c Chart) checkRanges(xr, yr, yra Range) error {
 		return errors.New("nan y-range delta")
 	}
 
-	if c.hasSecondarySeries() {
+	if c.hasSecondaryAxis() {
 		Debugf(c.Log, "checking secondary yrange: %v", yra)
 		yraDelta := yra.GetDelta()
 		if math.IsInf(yraDelta, 0) {
@@ -339,7 +646,17 @@ func (c Chart) checkRanges(xr, yr, yra Range) error {
 }
 
 func (c Chart) getDefaultCanvasBox() Box {
-	return c.Box()
+	return c.Box().Inset(c.Canvas.Margin)
+}
+
+// getAxesBoxes returns the boxes the x and y axes are measured and drawn around, i.e. the canvas box
+// pushed out by the canvas margin on the sides the axes are drawn on.
+func (c Chart) getAxesBoxes(canvasBox Box) (xaxisBox, yaxisBox Box) {
+	xaxisBox, yaxisBox = canvasBox.Clone(), canvasBox.Clone()
+	xaxisBox.Bottom += c.Canvas.Margin.GetBottom()
+	yaxisBox.Left -= c.Canvas.Margin.GetLeft()
+	yaxisBox.Right += c.Canvas.Margin.GetRight()
+	return
 }
 
 func (c Chart) getValueFormatters() (x, y, ya ValueFormatter) {
@@ -371,9 +688,47 @@ func (c Chart) hasAxes() bool {
 	return !c.XAxis.Style.Hidden || !c.YAxis.Style.Hidden || !c.YAxisSecondary.Style.Hidden
 }
 
+// hasTimeXValues returns if the x values of the series are times.
+func (c Chart) hasTimeXValues() bool {
+	for _, s := range c.Series {
+		switch s.(type) {
+		case TimeSeries, *TimeSeries:
+			return true
+		}
+	}
+	return false
+}
+
+// getCategories returns the categories of the x-axis, or of the first category series that has them.
+func (c Chart) getCategories() []string {
+	if len(c.XAxis.Categories) > 0 {
+		return c.XAxis.Categories
+	}
+	for _, s := range c.Series {
+		if cs, isCategorySeries := s.(CategorySeries); isCategorySeries && len(cs.Categories) > 0 {
+			return cs.Categories
+		}
+	}
+	return nil
+}
+
+// getXAxis returns the x-axis with a time tick generator if the series have times for x values and
+// the axis doesn't set its own ticks.
+func (c Chart) getXAxis(xr Range) XAxis {
+	xa := c.XAxis
+	if len(xa.Ticks) > 0 || xa.TickGenerator != nil || !c.hasTimeXValues() {
+		return xa
+	}
+	if _, isTickProvider := xr.(TicksProvider); isTickProvider {
+		return xa
+	}
+	xa.TickGenerator = TimeTickGenerator{ValueFormatter: xa.ValueFormatter}
+	return xa
+}
+
 func (c Chart) getAxesTicks(r Renderer, xr, yr, yar Range, xf, yf, yfa ValueFormatter) (xticks, yticks, yticksAlt []Tick) {
 	if !c.XAxis.Style.Hidden {
-		xticks = c.XAxis.GetTicks(r, xr, c.styleDefaultsAxes(), xf)
+		xticks = c.getXAxis(xr).GetTicks(r, xr, c.styleDefaultsAxes(), xf)
 	}
 	if !c.YAxis.Style.Hidden {
 		yticks = c.YAxis.GetTicks(r, yr, c.styleDefaultsAxes(), yf)
@@ -385,24 +740,28 @@ func (c Chart) getAxesTicks(r Renderer, xr, yr, yar Range, xf, yf, yfa ValueForm
 }
 
 func (c Chart) getAxesAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range, xticks, yticks, yticksAlt []Tick) Box {
+	return canvasBox.OuterConstrain(c.Box(), c.getAxesOuterBox(r, canvasBox, xr, yr, yra, xticks, yticks, yticksAlt))
+}
+
+func (c Chart) getAxesOuterBox(r Renderer, canvasBox Box, xr, yr, yra Range, xticks, yticks, yticksAlt []Tick) Box {
 	axesOuterBox := canvasBox.Clone()
+	xaxisBox, yaxisBox := c.getAxesBoxes(canvasBox)
 	if !c.XAxis.Style.Hidden {
-		axesBounds := c.XAxis.Measure(r, canvasBox, xr, c.styleDefaultsAxes(), xticks)
+		axesBounds := c.XAxis.Measure(r, xaxisBox, xr, c.styleDefaultsAxes(), xticks)
 		Debugf(c.Log, "chart; x-axis measured %v", axesBounds)
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
 	}
 	if !c.YAxis.Style.Hidden {
-		axesBounds := c.YAxis.Measure(r, canvasBox, yr, c.styleDefaultsAxes(), yticks)
+		axesBounds := c.YAxis.Measure(r, yaxisBox, yr, c.styleDefaultsAxes(), yticks)
 		Debugf(c.Log, "chart; y-axis measured %v", axesBounds)
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
 	}
-	if !c.YAxisSecondary.Style.Hidden && c.hasSecondarySeries() {
-		axesBounds := c.YAxisSecondary.Measure(r, canvasBox, yra, c.styleDefaultsAxes(), yticksAlt)
+	if !c.YAxisSecondary.Style.Hidden && c.hasSecondaryAxis() {
+		axesBounds := c.YAxisSecondary.Measure(r, yaxisBox, yra, c.styleDefaultsAxes(), yticksAlt)
 		Debugf(c.Log, "chart; y-axis secondary measured %v", axesBounds)
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
 	}
-
-	return canvasBox.OuterConstrain(c.Box(), axesOuterBox)
+	return axesOuterBox
 }
 
 func (c Chart) setRangeDomains(canvasBox Box, xr, yr, yra Range) (Range, Range, Range) {
@@ -414,8 +773,8 @@ func (c Chart) setRangeDomains(canvasBox Box, xr, yr, yra Range) (Range, Range,
 
 func (c Chart) hasAnnotationSeries() bool {
 	for _, s := range c.Series {
-		if as, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
-			if !as.GetStyle().Hidden {
+		if ms, isMeasuredSeries := s.(MeasuredSeries); isMeasuredSeries {
+			if !ms.GetStyle().Hidden {
 				return true
 			}
 		}
@@ -423,6 +782,10 @@ func (c Chart) hasAnnotationSeries() bool {
 	return false
 }
 
+func (c Chart) hasSecondaryAxis() bool {
+	return c.YAxisSecondary.Transform != nil || c.hasSecondarySeries()
+}
+
 func (c Chart) hasSecondarySeries() bool {
 	for _, s := range c.Series {
 		if s.GetYAxis() == YAxisSecondary {
@@ -433,35 +796,194 @@ func (c Chart) hasSecondarySeries() bool {
 }
 
 func (c Chart) getAnnotationAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range, xf, yf, yfa ValueFormatter) Box {
+	return canvasBox.OuterConstrain(c.Box(), c.getAnnotationOuterBox(r, canvasBox, xr, yr, yra))
+}
+
+func (c Chart) getAnnotationOuterBox(r Renderer, canvasBox Box, xr, yr, yra Range) Box {
 	annotationSeriesBox := canvasBox.Clone()
 	for seriesIndex, s := range c.Series {
-		if as, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
-			if !as.GetStyle().Hidden {
+		if ms, isMeasuredSeries := s.(MeasuredSeries); isMeasuredSeries {
+			if !ms.GetStyle().Hidden {
 				style := c.styleDefaultsSeries(seriesIndex)
 				var annotationBounds Box
-				if as.YAxis == YAxisPrimary {
-					annotationBounds = as.Measure(r, canvasBox, xr, yr, style)
-				} else if as.YAxis == YAxisSecondary {
-					annotationBounds = as.Measure(r, canvasBox, xr, yra, style)
+				if ms.GetYAxis() == YAxisPrimary {
+					annotationBounds = ms.Measure(r, canvasBox, xr, yr, style)
+				} else if ms.GetYAxis() == YAxisSecondary {
+					annotationBounds = ms.Measure(r, canvasBox, xr, yra, style)
 				}
 
 				annotationSeriesBox = annotationSeriesBox.Grow(annotationBounds)
 			}
 		}
 	}
+	return annotationSeriesBox
+}
+
+// shrinkWrap returns the chart with its width and height computed from the
+// canvas size and the space needed for the title, axes and annotations.
+func (c Chart) shrinkWrap(rp RendererProvider) (Chart, error) {
+	if c.CanvasWidth > 0 {
+		c.Width = c.CanvasWidth + c.Canvas.Margin.GetLeft() + c.Canvas.Margin.GetRight() +
+			c.Background.Margin.GetLeft() + c.Background.Padding.GetLeft(DefaultBackgroundPadding.Left) +
+			c.Background.Margin.GetRight() + c.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	}
+	if c.CanvasHeight > 0 {
+		c.Height = c.CanvasHeight + c.Canvas.Margin.GetTop() + c.Canvas.Margin.GetBottom() +
+			c.Background.Margin.GetTop() + c.Background.Padding.GetTop(DefaultBackgroundPadding.Top) +
+			c.Background.Margin.GetBottom() + c.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+	}
+
+	r, err := rp(c.GetWidth(), c.GetHeight())
+	if err != nil {
+		return c, err
+	}
+	r.SetDPI(c.GetDPI(DefaultDPI))
+
+	if c.CanvasHeight > 0 && len(c.Title) > 0 && !c.TitleStyle.Hidden {
+		r.SetFont(c.TitleStyle.GetFont(c.GetFont()))
+		r.SetFontSize(c.TitleStyle.GetFontSize(DefaultTitleFontSize))
+		titleBottom := c.getTitleTop() + r.MeasureText(c.Title).Height() + DefaultTitleTop
+		if top := c.Box().Top; titleBottom > top {
+			c.Background.Padding.Top = titleBottom - c.Background.Margin.GetTop()
+			c.Height += titleBottom - top
+		}
+	}
+
+	box, canvasBox := c.Box(), c.getDefaultCanvasBox()
+	xr, yr, yra := c.getRanges()
+	xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+	if c.checkRanges(xr, yr, yra) != nil {
+		// leave the range errors to be reported by the render.
+		return c, nil
+	}
+
+	contentBox := canvasBox.Clone()
+	if c.hasAxes() {
+		xf, yf, yfa := c.getValueFormatters()
+		xt, yt, yta := c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		contentBox = c.getAxesOuterBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
+	}
+	if c.hasAnnotationSeries() {
+		contentBox = contentBox.Grow(c.getAnnotationOuterBox(r, canvasBox, xr, yr, yra))
+	}
+
+	if c.CanvasWidth > 0 {
+		c.Width += MaxInt(0, box.Left-contentBox.Left) + MaxInt(0, contentBox.Right-box.Right)
+	}
+	if c.CanvasHeight > 0 {
+		c.Height += MaxInt(0, box.Top-contentBox.Top) + MaxInt(0, contentBox.Bottom-box.Bottom)
+	}
+	if len(c.Elements) > 0 {
+		c = c.padToElements(r, canvasBox, box.Grow(contentBox))
+	}
+	return c, nil
+}
+
+// padToElements grows the padding of the chart, and its size to match, so that elements drawn outside
+// the canvas box fit within the bounds of the rest of the chart rather than spilling off it.
+func (c Chart) padToElements(r Renderer, canvasBox, bounds Box) Chart {
+	br := &boundsRenderer{Renderer: r}
+	for _, a := range c.Elements {
+		a(br, canvasBox, c.styleDefaultsElements())
+	}
+	if !br.drawn {
+		return c
+	}
 
-	return canvasBox.OuterConstrain(c.Box(), annotationSeriesBox)
+	padding := c.Background.GetPadding(DefaultBackgroundPadding)
+	if c.CanvasWidth > 0 {
+		left, right := MaxInt(0, bounds.Left-br.bounds.Left), MaxInt(0, br.bounds.Right-bounds.Right)
+		padding.Left += left
+		padding.Right += right
+		c.Width += left + right
+	}
+	if c.CanvasHeight > 0 {
+		top, bottom := MaxInt(0, bounds.Top-br.bounds.Top), MaxInt(0, br.bounds.Bottom-bounds.Bottom)
+		padding.Top += top
+		padding.Bottom += bottom
+		c.Height += top + bottom
+	}
+	padding.IsSet = true
+	c.Background.Padding = padding
+	return c
 }
 
+// boundsRenderer measures the bounds of what is drawn with it, without drawing to the renderer it wraps.
+type boundsRenderer struct {
+	Renderer
+
+	bounds        Box
+	drawn         bool
+	rotateRadians *float64
+}
+
+func (br *boundsRenderer) add(box Box) {
+	if !br.drawn {
+		br.bounds, br.drawn = box, true
+		return
+	}
+	br.bounds = br.bounds.Grow(box)
+}
+
+func (br *boundsRenderer) addPoint(x, y int) {
+	br.add(Box{Top: y, Left: x, Right: x, Bottom: y})
+}
+
+func (br *boundsRenderer) MoveTo(x, y int) { br.addPoint(x, y) }
+func (br *boundsRenderer) LineTo(x, y int) { br.addPoint(x, y) }
+func (br *boundsRenderer) QuadCurveTo(cx, cy, x, y int) {
+	br.addPoint(cx, cy)
+	br.addPoint(x, y)
+}
+func (br *boundsRenderer) ArcTo(cx, cy int, rx, ry, _, _ float64) {
+	br.add(Box{Top: cy - int(ry), Left: cx - int(rx), Right: cx + int(rx), Bottom: cy + int(ry)})
+}
+func (br *boundsRenderer) Circle(radius float64, x, y int) {
+	br.add(Box{Top: y - int(radius), Left: x - int(radius), Right: x + int(radius), Bottom: y + int(radius)})
+}
+func (br *boundsRenderer) Text(body string, x, y int) {
+	size := br.MeasureText(body)
+	box := Box{Top: y - size.Height(), Left: x, Right: x + size.Width(), Bottom: y}
+	if br.rotateRadians == nil {
+		br.add(box)
+		return
+	}
+	// text is rotated about the point it is drawn at.
+	for _, corner := range []Point{{X: box.Left, Y: box.Top}, {X: box.Right, Y: box.Top}, {X: box.Right, Y: box.Bottom}, {X: box.Left, Y: box.Bottom}} {
+		br.addPoint(RotateCoordinate(x, y, corner.X, corner.Y, *br.rotateRadians))
+	}
+}
+func (br *boundsRenderer) SetTextRotation(radians float64) { br.rotateRadians = &radians }
+func (br *boundsRenderer) ClearTextRotation()              { br.rotateRadians = nil }
+func (br *boundsRenderer) Close()                          {}
+func (br *boundsRenderer) Stroke()                         {}
+func (br *boundsRenderer) Fill()                           {}
+func (br *boundsRenderer) FillStroke()                     {}
+func (br *boundsRenderer) Save(_ io.Writer) error          { return nil }
+
 func (c Chart) getBackgroundStyle() Style {
 	return c.Background.InheritFrom(c.styleDefaultsBackground())
 }
 
 func (c Chart) drawBackground(r Renderer) {
-	Draw.Box(r, Box{
-		Right:  c.GetWidth(),
-		Bottom: c.GetHeight(),
-	}, c.getBackgroundStyle())
+	if c.Background.Hidden {
+		return
+	}
+	drawBackground(r, c.GetWidth(), c.GetHeight(), c.getBackgroundStyle())
+}
+
+// drawBackground draws the background of a chart of a given size, inset by the style's margin.
+func drawBackground(r Renderer, width, height int, style Style) {
+	box := Box{
+		Right:  width,
+		Bottom: height,
+	}.Inset(style.Margin)
+	if style.CornerRadius > 0 {
+		// keep rounded borders from being clipped at the edges of the image.
+		inset := int(math.Ceil(style.StrokeWidth / 2.0))
+		box = box.Inset(Box{Top: inset, Left: inset, Right: inset, Bottom: inset})
+	}
+	Draw.Box(r, box, style)
 }
 
 func (c Chart) getCanvasStyle() Style {
@@ -469,18 +991,22 @@ func (c Chart) getCanvasStyle() Style {
 }
 
 func (c Chart) drawCanvas(r Renderer, canvasBox Box) {
+	if c.Canvas.Hidden {
+		return
+	}
 	Draw.Box(r, canvasBox, c.getCanvasStyle())
 }
 
 func (c Chart) drawAxes(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, xticks, yticks, yticksAlt []Tick) {
+	xaxisBox, yaxisBox := c.getAxesBoxes(canvasBox)
 	if !c.XAxis.Style.Hidden {
-		c.XAxis.Render(r, canvasBox, xrange, c.styleDefaultsAxes(), xticks)
+		c.XAxis.Render(r, xaxisBox, xrange, c.styleDefaultsAxes(), xticks)
 	}
 	if !c.YAxis.Style.Hidden {
-		c.YAxis.Render(r, canvasBox, yrange, c.styleDefaultsAxes(), yticks)
+		c.YAxis.Render(r, yaxisBox, yrange, c.styleDefaultsAxes(), yticks)
 	}
 	if !c.YAxisSecondary.Style.Hidden {
-		c.YAxisSecondary.Render(r, canvasBox, yrangeAlt, c.styleDefaultsAxes(), yticksAlt)
+		c.YAxisSecondary.Render(r, yaxisBox, yrangeAlt, c.styleDefaultsAxes(), yticksAlt)
 	}
 }
 
@@ -507,12 +1033,18 @@ func (c Chart) drawTitle(r Renderer) {
 		textHeight := textBox.Height()
 
 		titleX := (c.GetWidth() >> 1) - (textWidth >> 1)
-		titleY := c.TitleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+		titleY := c.getTitleTop() + textHeight
 
 		r.Text(c.Title, titleX, titleY)
 	}
 }
 
+// getTitleTop returns the distance from the top of the chart to the top of the title.
+// The title margin is preferred, falling back to the title padding.
+func (c Chart) getTitleTop() int {
+	return c.Background.Margin.GetTop() + c.TitleStyle.Margin.GetTop(c.TitleStyle.Padding.GetTop(DefaultTitleTop))
+}
+
 func (c Chart) styleDefaultsBackground() Style {
 	return Style{
 		FillColor:   c.GetColorPalette().BackgroundColor(),
@@ -565,12 +1097,12 @@ func (c Chart) GetColorPalette() ColorPalette {
 
 // Box returns the chart bounds as a box.
 func (c Chart) Box() Box {
-	dpr := c.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
-	dpb := c.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+	dpr := c.Background.Margin.GetRight() + c.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := c.Background.Margin.GetBottom() + c.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
 
 	return Box{
-		Top:    c.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
-		Left:   c.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Top:    c.Background.Margin.GetTop() + c.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   c.Background.Margin.GetLeft() + c.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
 		Right:  c.GetWidth() - dpr,
 		Bottom: c.GetHeight() - dpb,
 	}
@@ -0,0 +1,178 @@
+package chart
+
+import (
+	"github.com/golang/freetype/truetype"
+)
+
+// ChartFrame is the frame shared by the standalone chart types: their size, title, background and
+// canvas, and the defaults they are drawn with. Chart types embed it, e.g.
+//
+//	chart.WaffleChart{
+//		ChartFrame: chart.ChartFrame{Title: "Votes", Width: 512, Height: 512},
+//		Values:     values,
+//	}
+type ChartFrame struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+}
+
+// GetDPI returns the dpi for the chart.
+func (cf ChartFrame) GetDPI(defaults ...float64) float64 {
+	if cf.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return cf.DPI
+}
+
+// GetFont returns the text font.
+func (cf ChartFrame) GetFont() *truetype.Font {
+	if cf.Font == nil {
+		return cf.defaultFont
+	}
+	return cf.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (cf ChartFrame) GetWidth() int {
+	if cf.Width == 0 {
+		return DefaultChartWidth
+	}
+	return cf.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (cf ChartFrame) GetHeight() int {
+	if cf.Height == 0 {
+		return DefaultChartHeight
+	}
+	return cf.Height
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (cf ChartFrame) GetColorPalette() ColorPalette {
+	if cf.ColorPalette != nil {
+		return cf.ColorPalette
+	}
+	return AlternateColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (cf ChartFrame) Box() Box {
+	return cf.box(cf.GetWidth(), cf.GetHeight())
+}
+
+// box returns the bounds of a chart of a given size, less the background margin and padding.
+//
+// The frame methods that draw take the size of the chart rather than using the frame's own, so that
+// charts with their own default size are drawn at it.
+func (cf ChartFrame) box(width, height int) Box {
+	dpr := cf.Background.Margin.GetRight() + cf.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := cf.Background.Margin.GetBottom() + cf.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+
+	return Box{
+		Top:    cf.Background.Margin.GetTop() + cf.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   cf.Background.Margin.GetLeft() + cf.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Right:  width - dpr,
+		Bottom: height - dpb,
+	}
+}
+
+// getDefaultCanvasBox returns the box of a chart of a given size less space for the title.
+func (cf ChartFrame) getDefaultCanvasBox(r Renderer, width, height int) Box {
+	canvasBox := cf.box(width, height)
+	if len(cf.Title) > 0 && !cf.TitleStyle.Hidden {
+		tb := Draw.MeasureText(r, cf.Title, cf.styleDefaultsTitle(width, height))
+		canvasBox.Top += tb.Height() + DefaultTitleTop
+	}
+	return canvasBox
+}
+
+func (cf ChartFrame) drawBackground(r Renderer, width, height int) {
+	if cf.Background.Hidden {
+		return
+	}
+	drawBackground(r, width, height, cf.getBackgroundStyle())
+}
+
+func (cf ChartFrame) drawCanvas(r Renderer, canvasBox Box) {
+	if cf.Canvas.Hidden {
+		return
+	}
+	Draw.Box(r, canvasBox, cf.getCanvasStyle())
+}
+
+func (cf ChartFrame) drawTitle(r Renderer, width, height int) {
+	if len(cf.Title) > 0 && !cf.TitleStyle.Hidden {
+		Draw.TextWithin(r, cf.Title, cf.box(width, height), cf.styleDefaultsTitle(width, height))
+	}
+}
+
+func (cf ChartFrame) getBackgroundStyle() Style {
+	return cf.Background.InheritFrom(cf.styleDefaultsBackground())
+}
+
+func (cf ChartFrame) getCanvasStyle() Style {
+	return cf.Canvas.InheritFrom(cf.styleDefaultsCanvas())
+}
+
+func (cf ChartFrame) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   cf.GetColorPalette().BackgroundColor(),
+		StrokeColor: cf.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (cf ChartFrame) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   cf.GetColorPalette().CanvasColor(),
+		StrokeColor: cf.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (cf ChartFrame) styleDefaultsElements() Style {
+	return Style{
+		Font: cf.GetFont(),
+	}
+}
+
+func (cf ChartFrame) styleDefaultsTitle(width, height int) Style {
+	return cf.TitleStyle.InheritFrom(Style{
+		FontColor:           cf.GetColorPalette().TextColor(),
+		Font:                cf.GetFont(),
+		FontSize:            cf.getTitleFontSize(width, height),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (cf ChartFrame) getTitleFontSize(width, height int) float64 {
+	effectiveDimension := MinInt(width, height)
+	if effectiveDimension >= 2048 {
+		return 48
+	} else if effectiveDimension >= 1024 {
+		return 24
+	} else if effectiveDimension >= 512 {
+		return 18
+	} else if effectiveDimension >= 256 {
+		return 12
+	}
+	return 10
+}
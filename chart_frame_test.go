@@ -0,0 +1,89 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestChartFrameDefaults(t *testing.T) {
+	// replaced new assertions helper
+
+	cf := ChartFrame{}
+	testutil.AssertEqual(t, DefaultChartWidth, cf.GetWidth())
+	testutil.AssertEqual(t, DefaultChartHeight, cf.GetHeight())
+	testutil.AssertEqual(t, DefaultDPI, cf.GetDPI())
+	testutil.AssertEqual(t, 72.0, cf.GetDPI(72.0))
+	testutil.AssertEqual(t, AlternateColorPalette, cf.GetColorPalette())
+
+	cf = ChartFrame{Width: 300, Height: 200, DPI: 96}
+	testutil.AssertEqual(t, 300, cf.GetWidth())
+	testutil.AssertEqual(t, 200, cf.GetHeight())
+	testutil.AssertEqual(t, 96.0, cf.GetDPI())
+}
+
+func TestChartFrameBox(t *testing.T) {
+	// replaced new assertions helper
+
+	cf := ChartFrame{
+		Width:  300,
+		Height: 200,
+		Background: Style{
+			Margin:  Box{Top: 1, Left: 2, Right: 3, Bottom: 4},
+			Padding: Box{Top: 10, Left: 20, Right: 30, Bottom: 40},
+		},
+	}
+	testutil.AssertEqual(t, Box{Top: 11, Left: 22, Right: 267, Bottom: 156}, cf.Box())
+
+	r, err := PNG(300, 200)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	cf.Font = f
+	testutil.AssertEqual(t, cf.Box(), cf.getDefaultCanvasBox(r, 300, 200))
+
+	cf.Title = "Title"
+	titled := cf.getDefaultCanvasBox(r, 300, 200)
+	testutil.AssertTrue(t, titled.Top > cf.Box().Top)
+	testutil.AssertEqual(t, cf.Box().Bottom, titled.Bottom)
+}
+
+func TestChartFrameEmbedded(t *testing.T) {
+	// replaced new assertions helper
+
+	wc := WaffleChart{ChartFrame: ChartFrame{Title: "Votes", Width: 300}}
+	testutil.AssertEqual(t, "Votes", wc.Title)
+	testutil.AssertEqual(t, 300, wc.GetWidth())
+	testutil.AssertEqual(t, DefaultChartHeight, wc.GetHeight())
+}
+
+func TestChartFrameRoundedBackground(t *testing.T) {
+	// replaced new assertions helper
+
+	wc := WaffleChart{
+		ChartFrame: ChartFrame{
+			Width:  200,
+			Height: 100,
+			Background: Style{
+				FillColor:    drawing.ColorBlue,
+				StrokeColor:  drawing.ColorBlack,
+				StrokeWidth:  4,
+				CornerRadius: 10,
+			},
+		},
+		Values: []Value{{Value: 1, Label: "a"}},
+	}
+
+	iw := &ImageWriter{}
+	testutil.AssertNil(t, wc.Render(PNG, iw))
+	img, err := iw.Image()
+	testutil.AssertNil(t, err)
+
+	// the border is inset by half its width, so it is drawn whole within the image.
+	_, _, _, cornerAlpha := img.At(0, 0).RGBA()
+	testutil.AssertZero(t, cornerAlpha)
+	_, _, b, a := img.At(100, 3).RGBA()
+	testutil.AssertZero(t, b)
+	testutil.AssertNotZero(t, a)
+}
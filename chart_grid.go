@@ -0,0 +1,151 @@
+package chart
+
+import (
+	"errors"
+	"io"
+	"math"
+)
+
+// ChartGrid lays out a set of charts as small multiples in rows and columns on a single image.
+type ChartGrid struct {
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+
+	// Columns is the number of charts in each row, it defaults to the square root of the number
+	// of charts, rounded up.
+	Columns int
+	// Charts are drawn left to right and top to bottom; their `Width` and `Height` are set by the grid.
+	Charts []Chart
+}
+
+// SmallMultiples returns a grid of charts of the values split into a number of consecutive windows of
+// about the same length, in order, each titled with its first and last x values. The charts share the
+// y range of all of the values, so the windows can be compared with each other.
+func SmallMultiples(vs ValuesProvider, windows int) ChartGrid {
+	values := valuesToContinuousSeries(vs)
+	length := values.Len()
+	windows = MinInt(MaxInt(windows, 1), length)
+
+	miny, maxy := math.MaxFloat64, -math.MaxFloat64
+	for _, y := range values.YValues {
+		miny, maxy = math.Min(miny, y), math.Max(maxy, y)
+	}
+	xf, _ := values.GetValueFormatters()
+
+	var grid ChartGrid
+	for window := 0; window < windows; window++ {
+		start, end := window*length/windows, (window+1)*length/windows
+		series := values
+		series.XValues = values.XValues[start:end]
+		series.YValues = values.YValues[start:end]
+		grid.Charts = append(grid.Charts, Chart{
+			Title: xf(series.XValues[0]) + " - " + xf(series.XValues[len(series.XValues)-1]),
+			TitleStyle: Style{
+				FontSize: DefaultSmallMultiplesTitleFontSize,
+			},
+			Background: Style{
+				Padding: DefaultSmallMultiplesPadding,
+			},
+			YAxis: YAxis{
+				Range: &ContinuousRange{Min: miny, Max: maxy},
+			},
+			YAxisSecondary: YAxis{
+				Style: Hidden(),
+			},
+			Series: []Series{series},
+		})
+	}
+	return grid
+}
+
+// GetWidth returns the grid width or the default value.
+func (cg ChartGrid) GetWidth() int {
+	if cg.Width == 0 {
+		return DefaultChartWidth
+	}
+	return cg.Width
+}
+
+// GetHeight returns the grid height or the default value.
+func (cg ChartGrid) GetHeight() int {
+	if cg.Height == 0 {
+		return DefaultChartHeight
+	}
+	return cg.Height
+}
+
+// GetDPI returns the dpi for the grid.
+func (cg ChartGrid) GetDPI(defaults ...float64) float64 {
+	if cg.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return cg.DPI
+}
+
+// GetColumns returns the number of columns or the default.
+func (cg ChartGrid) GetColumns() int {
+	if cg.Columns > 0 {
+		return cg.Columns
+	}
+	return MaxInt(1, int(math.Ceil(math.Sqrt(float64(len(cg.Charts))))))
+}
+
+// GetRows returns the number of rows needed for the charts.
+func (cg ChartGrid) GetRows() int {
+	columns := cg.GetColumns()
+	return (len(cg.Charts) + columns - 1) / columns
+}
+
+// GetCellBox returns the box of the chart at a given index.
+func (cg ChartGrid) GetCellBox(index int) Box {
+	columns, rows := cg.GetColumns(), MaxInt(1, cg.GetRows())
+	row, column := index/columns, index%columns
+	edge := func(length, index, count int) int {
+		return length * index / count
+	}
+	return Box{
+		Top:    edge(cg.GetHeight(), row, rows),
+		Left:   edge(cg.GetWidth(), column, columns),
+		Right:  edge(cg.GetWidth(), column+1, columns),
+		Bottom: edge(cg.GetHeight(), row+1, rows),
+	}
+}
+
+// Render renders the grid with the given renderer to the given io.Writer.
+func (cg ChartGrid) Render(rp RendererProvider, w io.Writer) error {
+	if len(cg.Charts) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one chart"))
+	}
+
+	r, err := rp(cg.GetWidth(), cg.GetHeight())
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+	r.SetDPI(cg.GetDPI(DefaultDPI))
+
+	if !cg.Background.Hidden {
+		Draw.Box(r, Box{Right: cg.GetWidth(), Bottom: cg.GetHeight()}, cg.Background.InheritFrom(Style{
+			FillColor:   DefaultBackgroundColor,
+			StrokeColor: DefaultBackgroundStrokeColor,
+			StrokeWidth: DefaultBackgroundStrokeWidth,
+		}))
+	}
+
+	for index, c := range cg.Charts {
+		cell := cg.GetCellBox(index)
+		c = c.Clone()
+		c.Width, c.Height = cell.Width(), cell.Height()
+		c.CanvasWidth, c.CanvasHeight = 0, 0
+		c.DPI = cg.GetDPI(DefaultDPI)
+		if err = renderPanel(r, c, cell); err != nil {
+			return err
+		}
+	}
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
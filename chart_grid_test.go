@@ -0,0 +1,72 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestChartGridLayout(t *testing.T) {
+	// replaced new assertions helper
+
+	cg := ChartGrid{Width: 300, Height: 200, Charts: make([]Chart, 5)}
+	testutil.AssertEqual(t, 3, cg.GetColumns())
+	testutil.AssertEqual(t, 2, cg.GetRows())
+	testutil.AssertEqual(t, Box{Top: 100, Left: 100, Right: 200, Bottom: 200}, cg.GetCellBox(4))
+
+	cg.Columns = 1
+	testutil.AssertEqual(t, 5, cg.GetRows())
+	testutil.AssertEqual(t, Box{Top: 40, Left: 0, Right: 300, Bottom: 80}, cg.GetCellBox(1))
+
+	testutil.AssertNotNil(t, ChartGrid{}.Render(PNG, bytes.NewBuffer(nil)))
+}
+
+func TestSmallMultiples(t *testing.T) {
+	// replaced new assertions helper
+
+	values := ContinuousSeries{
+		XValues: LinearRange(1, 10),
+		YValues: []float64{5, 1, 2, 3, 4, 6, 7, 8, 9, 20},
+	}
+	cg := SmallMultiples(values, 3)
+	testutil.AssertLen(t, cg.Charts, 3)
+	testutil.AssertEqual(t, "1.00 - 3.00", cg.Charts[0].Title)
+	testutil.AssertEqual(t, "7.00 - 10.00", cg.Charts[2].Title)
+
+	// every window shares the y range of all the values.
+	for _, c := range cg.Charts {
+		testutil.AssertEqual(t, 1.0, c.YAxis.Range.GetMin())
+		testutil.AssertEqual(t, 20.0, c.YAxis.Range.GetMax())
+	}
+	testutil.AssertEqual(t, 4, cg.Charts[2].Series[0].(ContinuousSeries).Len())
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, cg.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), ">4.00 - 6.00</text>")
+}
+
+func TestChartGridRenderClipsCharts(t *testing.T) {
+	// replaced new assertions helper
+
+	// the first chart draws over the whole grid, but is clipped to its own cell.
+	overflow := func(r Renderer, _ Box, _ Style) {
+		Draw.Box(r, Box{Right: 200, Bottom: 100}, Style{FillColor: drawing.ColorRed, StrokeColor: drawing.ColorRed, StrokeWidth: 1})
+	}
+	cg := ChartGrid{
+		Width:  200,
+		Height: 100,
+		Charts: []Chart{
+			{Series: []Series{ContinuousSeries{XValues: []float64{0, 10}, YValues: []float64{1, 2}}}, Elements: []Renderable{overflow}},
+			{Series: []Series{ContinuousSeries{XValues: []float64{0, 10}, YValues: []float64{1, 2}}}, Background: Style{Hidden: true}, Canvas: Style{Hidden: true}},
+		},
+	}
+
+	collector := &ImageWriter{}
+	testutil.AssertNil(t, cg.Render(PNG, collector))
+	img, err := collector.Image()
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, drawing.ColorRed, drawing.ColorFromAlphaMixedRGBA(img.At(50, 50).RGBA()))
+	testutil.AssertNotEqual(t, drawing.ColorRed, drawing.ColorFromAlphaMixedRGBA(img.At(150, 5).RGBA()))
+}
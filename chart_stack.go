@@ -0,0 +1,345 @@
+package chart
+
+import (
+	"errors"
+	"image"
+	"io"
+	"io/ioutil"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// ChartStack lays out a set of charts as subplots stacked vertically on a single image.
+type ChartStack struct {
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+
+	// Charts are drawn top to bottom; their `Width` and `Height` are set by the stack.
+	Charts []Chart
+	// Weights sets the relative height of each chart, charts without a weight have a weight of 1.
+	Weights []float64
+
+	// LinkXAxes gives every chart the same x range and aligns their canvases,
+	// so that a given x value lines up vertically across the stack.
+	LinkXAxes bool
+}
+
+// IndicatorPanel is a sub-chart below the main chart of an indicator stack, e.g. the RSI, MACD or
+// volume of a price chart.
+type IndicatorPanel struct {
+	// Name labels the y-axis of the panel.
+	Name string
+	// Weight is the height of the panel relative to the main chart, it defaults to a quarter of it.
+	Weight float64
+	// YAxis configures the y-axis of the panel, e.g. a fixed range of 0 to 100 for an RSI.
+	YAxis  YAxis
+	Series []Series
+}
+
+// NewIndicatorStack returns a stack of the main chart with the panels below it, sharing linked x axes,
+// with only the bottom panel showing the x-axis, as configured and formatted on the main chart.
+func NewIndicatorStack(main Chart, panels ...IndicatorPanel) ChartStack {
+	stack := ChartStack{
+		Charts:    []Chart{main},
+		Weights:   []float64{DefaultIndicatorMainWeight},
+		LinkXAxes: true,
+	}
+	for _, panel := range panels {
+		yaxis := panel.YAxis
+		if len(yaxis.Name) == 0 {
+			yaxis.Name = panel.Name
+		}
+		weight := panel.Weight
+		if weight == 0 {
+			weight = DefaultIndicatorPanelWeight
+		}
+		stack.Charts = append(stack.Charts, Chart{
+			YAxis: yaxis,
+			YAxisSecondary: YAxis{
+				Style: Hidden(),
+			},
+			Series: panel.Series,
+		})
+		stack.Weights = append(stack.Weights, weight)
+	}
+	if !main.hasSecondaryAxis() {
+		stack.Charts[0].YAxisSecondary.Style.Hidden = true
+	}
+	if len(panels) > 0 {
+		// the bottom panel formats and ticks the x-axis like the main chart would, e.g. as times for a time series.
+		last := len(stack.Charts) - 1
+		stack.Charts[last].XAxis = main.getXAxis(main.XAxis.Range)
+		stack.Charts[last].XAxis.ValueFormatter, _, _ = main.getValueFormatters()
+		stack.Charts[0].XAxis = HideXAxis()
+		for index := 1; index < last; index++ {
+			stack.Charts[index].XAxis = HideXAxis()
+		}
+	}
+	return stack
+}
+
+// GetWidth returns the stack width or the default value.
+func (cs ChartStack) GetWidth() int {
+	if cs.Width == 0 {
+		return DefaultChartWidth
+	}
+	return cs.Width
+}
+
+// GetHeight returns the stack height or the default value.
+func (cs ChartStack) GetHeight() int {
+	if cs.Height == 0 {
+		return DefaultChartHeight
+	}
+	return cs.Height
+}
+
+// GetDPI returns the dpi for the stack.
+func (cs ChartStack) GetDPI(defaults ...float64) float64 {
+	if cs.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return cs.DPI
+}
+
+// GetWeight returns the relative height of the chart at a given index.
+func (cs ChartStack) GetWeight(index int) float64 {
+	if index < len(cs.Weights) && cs.Weights[index] > 0 {
+		return cs.Weights[index]
+	}
+	return 1
+}
+
+// Render renders the stack with the given renderer to the given io.Writer.
+func (cs ChartStack) Render(rp RendererProvider, w io.Writer) error {
+	if len(cs.Charts) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one chart"))
+	}
+
+	charts, err := cs.layoutCharts(rp)
+	if err != nil {
+		return err
+	}
+
+	r, err := rp(cs.GetWidth(), cs.GetHeight())
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+	r.SetDPI(cs.GetDPI(DefaultDPI))
+
+	if !cs.Background.Hidden {
+		Draw.Box(r, Box{Right: cs.GetWidth(), Bottom: cs.GetHeight()}, cs.Background.InheritFrom(Style{
+			FillColor:   DefaultBackgroundColor,
+			StrokeColor: DefaultBackgroundStrokeColor,
+			StrokeWidth: DefaultBackgroundStrokeWidth,
+		}))
+	}
+
+	var top int
+	for _, c := range charts {
+		if err = renderPanel(r, c, Box{Top: top, Right: c.Width, Bottom: top + c.Height}); err != nil {
+			return err
+		}
+		top += c.Height
+	}
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+// layoutCharts sizes the charts to fit the stack, and links their x axes if enabled.
+func (cs ChartStack) layoutCharts(rp RendererProvider) ([]Chart, error) {
+	var totalWeight float64
+	for index := range cs.Charts {
+		totalWeight += cs.GetWeight(index)
+	}
+
+	charts := make([]Chart, len(cs.Charts))
+	height, remaining := cs.GetHeight(), cs.GetHeight()
+	for index, c := range cs.Charts {
+		c = c.Clone()
+		c.Width = cs.GetWidth()
+		c.Height = int(math.Floor(float64(height) * cs.GetWeight(index) / totalWeight))
+		if index == len(cs.Charts)-1 {
+			c.Height = remaining
+		}
+		c.DPI = cs.GetDPI(DefaultDPI)
+		c.CanvasWidth, c.CanvasHeight = 0, 0
+		remaining -= c.Height
+		charts[index] = c
+	}
+
+	if !cs.LinkXAxes {
+		return charts, nil
+	}
+
+	minx, maxx := math.MaxFloat64, -math.MaxFloat64
+	for _, c := range charts {
+		xrange, _, _ := c.getRanges()
+		minx = math.Min(minx, xrange.GetMin())
+		maxx = math.Max(maxx, xrange.GetMax())
+	}
+	for index := range charts {
+		xrange := CloneRange(charts[index].XAxis.Range)
+		if xrange == nil {
+			xrange = &ContinuousRange{}
+		}
+		xrange.SetMin(minx)
+		xrange.SetMax(maxx)
+		charts[index].XAxis.Range = xrange
+	}
+
+	// the axes of each chart take up different amounts of space, so pad each canvas
+	// out to the narrowest one. the tick labels can change as the canvases are resized,
+	// so measure a second time to take up any slack.
+	for pass := 0; pass < 2; pass++ {
+		canvases := make([]Box, len(charts))
+		left, right := 0, cs.GetWidth()
+		for index, c := range charts {
+			canvas, err := measureCanvas(c, rp)
+			if err != nil {
+				return nil, err
+			}
+			canvases[index] = canvas
+			left = MaxInt(left, canvas.Left)
+			right = MinInt(right, canvas.Right)
+		}
+		for index := range charts {
+			padding := charts[index].Background.GetPadding(DefaultBackgroundPadding)
+			charts[index].Background.Padding = Box{
+				Top:    padding.Top,
+				Left:   padding.Left + (left - canvases[index].Left),
+				Right:  padding.Right + (canvases[index].Right - right),
+				Bottom: padding.Bottom,
+				IsSet:  true,
+			}
+		}
+	}
+	return charts, nil
+}
+
+// measureCanvas lays out a chart without drawing it, returning the resulting canvas box.
+func measureCanvas(c Chart, rp RendererProvider) (Box, error) {
+	c, err := c.prepare()
+	if err != nil {
+		return Box{}, err
+	}
+	r, err := rp(c.GetWidth(), c.GetHeight())
+	if err != nil {
+		return Box{}, newRenderError(RenderStageRenderer, err)
+	}
+	r.SetDPI(c.GetDPI(DefaultDPI))
+	l, err := c.layout(r)
+	if err != nil {
+		return Box{}, newRenderError(RenderStageRanges, err)
+	}
+	return l.canvasBox, nil
+}
+
+// renderPanel renders a chart onto a box of an existing renderer, clipped to the box if the renderer
+// is a `ClipRenderer` so that the chart cannot draw over its neighbours.
+func renderPanel(r Renderer, c Chart, box Box) error {
+	if clipper, isClipper := r.(ClipRenderer); isClipper {
+		clipper.SetClip(box)
+		defer clipper.ClearClip()
+	}
+	return c.Render(offsetRendererProvider(r, box.Left, box.Top), ioutil.Discard)
+}
+
+// offsetRendererProvider returns a renderer provider that draws onto an existing renderer
+// with a given offset, ignoring the requested size.
+func offsetRendererProvider(r Renderer, dx, dy int) RendererProvider {
+	return func(_, _ int) (Renderer, error) {
+		or := &offsetRenderer{r: r, dx: dx, dy: dy}
+		_, isImageRenderer := r.(ImageRenderer)
+		_, isSVGImageRenderer := r.(SVGImageRenderer)
+		if isImageRenderer && isSVGImageRenderer {
+			return &offsetSVGImageRenderer{offsetImageRenderer{or}}, nil
+		}
+		if isImageRenderer {
+			return &offsetImageRenderer{or}, nil
+		}
+		return or, nil
+	}
+}
+
+// offsetRenderer translates drawing calls onto another renderer, and does not save.
+type offsetRenderer struct {
+	r      Renderer
+	dx, dy int
+	// x, y and startX, startY are the current point and the start of the current path, untranslated,
+	// to flatten cubic curves from if the renderer cannot draw them.
+	x, y, startX, startY int
+}
+
+func (or *offsetRenderer) ResetStyle()                     { or.r.ResetStyle() }
+func (or *offsetRenderer) GetDPI() float64                 { return or.r.GetDPI() }
+func (or *offsetRenderer) SetDPI(dpi float64)              { or.r.SetDPI(dpi) }
+func (or *offsetRenderer) SetClassName(classname string)   { or.r.SetClassName(classname) }
+func (or *offsetRenderer) SetStrokeColor(c drawing.Color)  { or.r.SetStrokeColor(c) }
+func (or *offsetRenderer) SetFillColor(c drawing.Color)    { or.r.SetFillColor(c) }
+func (or *offsetRenderer) SetStrokeWidth(width float64)    { or.r.SetStrokeWidth(width) }
+func (or *offsetRenderer) SetStrokeDashArray(da []float64) { or.r.SetStrokeDashArray(da) }
+func (or *offsetRenderer) MoveTo(x, y int) {
+	or.r.MoveTo(x+or.dx, y+or.dy)
+	or.x, or.y, or.startX, or.startY = x, y, x, y
+}
+func (or *offsetRenderer) LineTo(x, y int) {
+	or.r.LineTo(x+or.dx, y+or.dy)
+	or.x, or.y = x, y
+}
+func (or *offsetRenderer) QuadCurveTo(cx, cy, x, y int) {
+	or.r.QuadCurveTo(cx+or.dx, cy+or.dy, x+or.dx, y+or.dy)
+	or.x, or.y = x, y
+}
+func (or *offsetRenderer) CubicCurveTo(cx1, cy1, cx2, cy2, x, y int) {
+	Draw.CubicCurveTo(or.r, or.x+or.dx, or.y+or.dy, cx1+or.dx, cy1+or.dy, cx2+or.dx, cy2+or.dy, x+or.dx, y+or.dy)
+	or.x, or.y = x, y
+}
+func (or *offsetRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	or.r.ArcTo(cx+or.dx, cy+or.dy, rx, ry, startAngle, delta)
+	endAngle := startAngle + delta
+	or.x, or.y = cx+int(math.Round(rx*math.Cos(endAngle))), cy+int(math.Round(ry*math.Sin(endAngle)))
+}
+func (or *offsetRenderer) Close() {
+	or.r.Close()
+	or.x, or.y = or.startX, or.startY
+}
+func (or *offsetRenderer) Stroke()                         { or.r.Stroke() }
+func (or *offsetRenderer) Fill()                           { or.r.Fill() }
+func (or *offsetRenderer) FillStroke()                     { or.r.FillStroke() }
+func (or *offsetRenderer) Circle(radius float64, x, y int) { or.r.Circle(radius, x+or.dx, y+or.dy) }
+func (or *offsetRenderer) SetFont(f *truetype.Font)        { or.r.SetFont(f) }
+func (or *offsetRenderer) SetFontColor(c drawing.Color)    { or.r.SetFontColor(c) }
+func (or *offsetRenderer) SetFontSize(size float64)        { or.r.SetFontSize(size) }
+func (or *offsetRenderer) Text(body string, x, y int)      { or.r.Text(body, x+or.dx, y+or.dy) }
+func (or *offsetRenderer) MeasureText(body string) Box     { return or.r.MeasureText(body) }
+func (or *offsetRenderer) SetTextRotation(radians float64) { or.r.SetTextRotation(radians) }
+func (or *offsetRenderer) ClearTextRotation()              { or.r.ClearTextRotation() }
+func (or *offsetRenderer) Save(_ io.Writer) error          { return nil }
+
+func (or *offsetRenderer) offset(box Box) Box {
+	return box.Shift(or.dx, or.dy)
+}
+
+type offsetImageRenderer struct {
+	*offsetRenderer
+}
+
+func (oir *offsetImageRenderer) DrawImage(img image.Image, box Box) {
+	oir.r.(ImageRenderer).DrawImage(img, oir.offset(box))
+}
+
+type offsetSVGImageRenderer struct {
+	offsetImageRenderer
+}
+
+func (osr *offsetSVGImageRenderer) DrawSVGImage(svg []byte, box Box) {
+	osr.r.(SVGImageRenderer).DrawSVGImage(svg, osr.offset(box))
+}
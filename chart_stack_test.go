@@ -0,0 +1,144 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestChartStackRender(t *testing.T) {
+	// replaced new assertions helper
+
+	stack := ChartStack{
+		Width:  400,
+		Height: 300,
+		Charts: []Chart{
+			{Series: []Series{ContinuousSeries{XValues: []float64{0, 10}, YValues: []float64{1, 2}}}},
+			{Series: []Series{ContinuousSeries{XValues: []float64{5, 20}, YValues: []float64{1000, 2000000}}}},
+		},
+		Weights:   []float64{2},
+		LinkXAxes: true,
+	}
+
+	charts, err := stack.layoutCharts(PNG)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, 200, charts[0].Height)
+	testutil.AssertEqual(t, 100, charts[1].Height)
+
+	top, err := measureCanvas(charts[0], PNG)
+	testutil.AssertNil(t, err)
+	bottom, err := measureCanvas(charts[1], PNG)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, top.Left, bottom.Left)
+	testutil.AssertEqual(t, top.Right, bottom.Right)
+
+	for _, c := range charts {
+		xrange, _, _ := c.getRanges()
+		testutil.AssertEqual(t, 0.0, xrange.GetMin())
+		testutil.AssertEqual(t, 20.0, xrange.GetMax())
+	}
+
+	// the original charts are not modified.
+	testutil.AssertNil(t, stack.Charts[0].XAxis.Range)
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, stack.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+
+	buffer.Reset()
+	testutil.AssertNil(t, stack.Render(SVG, buffer))
+	testutil.AssertEqual(t, 1, bytes.Count(buffer.Bytes(), []byte("<svg")))
+}
+
+func TestChartStackRenderEmpty(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, ChartStack{}.Render(PNG, bytes.NewBuffer(nil)))
+}
+
+func TestChartStackRenderClipsCharts(t *testing.T) {
+	// replaced new assertions helper
+
+	// the bottom chart draws over the whole stack, but is clipped to its own half.
+	overflow := func(r Renderer, _ Box, _ Style) {
+		Draw.Box(r, Box{Top: -100, Right: 100, Bottom: 100}, Style{FillColor: drawing.ColorRed, StrokeColor: drawing.ColorRed, StrokeWidth: 1})
+	}
+	stack := ChartStack{
+		Width:  100,
+		Height: 200,
+		Charts: []Chart{
+			{Series: []Series{ContinuousSeries{XValues: []float64{0, 10}, YValues: []float64{1, 2}}}},
+			{Series: []Series{ContinuousSeries{XValues: []float64{0, 10}, YValues: []float64{1, 2}}}, Elements: []Renderable{overflow}},
+		},
+	}
+
+	collector := &ImageWriter{}
+	testutil.AssertNil(t, stack.Render(PNG, collector))
+	img, err := collector.Image()
+	testutil.AssertNil(t, err)
+	testutil.AssertNotEqual(t, drawing.ColorRed, drawing.ColorFromAlphaMixedRGBA(img.At(50, 50).RGBA()))
+	testutil.AssertEqual(t, drawing.ColorRed, drawing.ColorFromAlphaMixedRGBA(img.At(50, 150).RGBA()))
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, stack.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), `<clipPath id="clip2"><rect x="0" y="100" width="100" height="100"/></clipPath>`)
+}
+
+func TestOffsetRendererCubicCurveTo(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+	lr := &lineRenderer{Renderer: r}
+	or, err := offsetRendererProvider(lr, 10, 20)(100, 100)
+	testutil.AssertNil(t, err)
+
+	// the curve is flattened from the current point when the renderer cannot draw it.
+	or.MoveTo(0, 0)
+	or.LineTo(10, 0)
+	or.(CubicCurveRenderer).CubicCurveTo(10, 50, 60, 50, 60, 0)
+	testutil.AssertLen(t, lr.lines, cubicCurveSegments+1)
+	testutil.AssertEqual(t, Point{X: 20, Y: 20}, lr.lines[0])
+	testutil.AssertEqual(t, Point{X: 70, Y: 20}, lr.lines[cubicCurveSegments])
+}
+
+func TestNewIndicatorStack(t *testing.T) {
+	// replaced new assertions helper
+
+	x := []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+	main := Chart{Series: []Series{TimeSeries{XValues: x, YValues: []float64{10, 12, 11}}}}
+	stack := NewIndicatorStack(main,
+		IndicatorPanel{Name: "Volume", Series: []Series{ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{5, 6, 7}}}},
+		IndicatorPanel{Name: "RSI", Weight: 2, YAxis: YAxis{Range: &ContinuousRange{Min: 0, Max: 100}}, Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{40, 60, 50}},
+		}},
+	)
+
+	testutil.AssertLen(t, stack.Charts, 3)
+	testutil.AssertTrue(t, stack.LinkXAxes)
+	testutil.AssertEqual(t, DefaultIndicatorMainWeight, stack.GetWeight(0))
+	testutil.AssertEqual(t, DefaultIndicatorPanelWeight, stack.GetWeight(1))
+	testutil.AssertEqual(t, 2.0, stack.GetWeight(2))
+
+	testutil.AssertTrue(t, stack.Charts[0].XAxis.Style.Hidden)
+	testutil.AssertTrue(t, stack.Charts[1].XAxis.Style.Hidden)
+	testutil.AssertFalse(t, stack.Charts[2].XAxis.Style.Hidden)
+	testutil.AssertEqual(t, "Volume", stack.Charts[1].YAxis.Name)
+	testutil.AssertEqual(t, 100.0, stack.Charts[2].YAxis.Range.GetMax())
+
+	// the bottom panel formats the shared axis as times, like the main chart.
+	testutil.AssertEqual(t, "2024-01-01", stack.Charts[2].XAxis.GetValueFormatter()(TimeToFloat64(x[0])))
+	_, isTimeTicks := stack.Charts[2].XAxis.TickGenerator.(TimeTickGenerator)
+	testutil.AssertTrue(t, isTimeTicks)
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, stack.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
@@ -2,14 +2,18 @@ package chart
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"image"
 	"image/png"
 	"math"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/wcharczuk/go-chart/v2/drawing"
 	"github.com/wcharczuk/go-chart/v2/testutil"
+	xdraw "golang.org/x/image/draw"
 )
 
 func TestChartGetDPI(t *testing.T) {
@@ -162,6 +166,36 @@ func TestChartGetRangesUseTicks(t *testing.T) {
 	testutil.AssertTrue(t, yar.IsZero(), yar.String())
 }
 
+func TestChartGetRangesUseSecondaryTicks(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		YAxisSecondary: YAxis{
+			Ticks: []Tick{
+				{100.0, "100"},
+				{200.0, "200"},
+			},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+			ContinuousSeries{
+				YAxis:   YAxisSecondary,
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{120.0, 150.0, 180.0},
+			},
+		},
+	}
+
+	_, yr, yar := c.getRanges()
+	testutil.AssertEqual(t, 1.0, yr.GetMin())
+	testutil.AssertEqual(t, 3.0, yr.GetMax())
+	testutil.AssertEqual(t, 100.0, yar.GetMin())
+	testutil.AssertEqual(t, 200.0, yar.GetMax())
+}
+
 func TestChartGetRangesUseUserRanges(t *testing.T) {
 	// replaced new assertions helper
 
@@ -241,6 +275,24 @@ func TestChartGetDefaultCanvasBox(t *testing.T) {
 	testutil.AssertEqual(t, DefaultBackgroundPadding.Left+1, canvasBoxCustom.Left)
 	testutil.AssertEqual(t, c.GetWidth()-(DefaultBackgroundPadding.Right+1), canvasBoxCustom.Right)
 	testutil.AssertEqual(t, c.GetHeight()-(DefaultBackgroundPadding.Bottom+1), canvasBoxCustom.Bottom)
+
+	margined := Chart{
+		Background: Style{
+			Margin: Box{Top: 10, Left: 20, Right: 30, Bottom: 40},
+		},
+	}
+	canvasBoxMargined := margined.getDefaultCanvasBox()
+	testutil.AssertEqual(t, DefaultBackgroundPadding.Top+10, canvasBoxMargined.Top)
+	testutil.AssertEqual(t, DefaultBackgroundPadding.Left+20, canvasBoxMargined.Left)
+	testutil.AssertEqual(t, c.GetWidth()-(DefaultBackgroundPadding.Right+30), canvasBoxMargined.Right)
+	testutil.AssertEqual(t, c.GetHeight()-(DefaultBackgroundPadding.Bottom+40), canvasBoxMargined.Bottom)
+
+	canvasMargined := Chart{
+		Canvas: Style{
+			Margin: Box{Top: 10, Left: 20, Right: 30, Bottom: 40},
+		},
+	}
+	testutil.AssertEqual(t, canvasBoxMargined, canvasMargined.getDefaultCanvasBox())
 }
 
 func TestChartGetValueFormatters(t *testing.T) {
@@ -341,6 +393,42 @@ func TestChartGetAxesTicks(t *testing.T) {
 	testutil.AssertNotEmpty(t, yat)
 }
 
+func TestChartGetAxesTicksTimeSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	r.SetFont(f)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.Local)
+	c := Chart{
+		XAxis: XAxis{
+			Range: &ContinuousRange{Domain: 1000},
+		},
+		Series: []Series{
+			TimeSeries{
+				XValues: []time.Time{start, start.AddDate(3, 0, 0)},
+				YValues: []float64{1, 2},
+			},
+		},
+	}
+	xr, yr, yar := c.getRanges()
+	xt, _, _ := c.getAxesTicks(r, xr, yr, yar, TimeValueFormatter, FloatValueFormatter, FloatValueFormatter)
+	testutil.AssertNotEmpty(t, xt)
+	for _, tick := range xt {
+		tickTime := TimeFromFloat64(tick.Value)
+		testutil.AssertEqual(t, 1, tickTime.Day())
+		testutil.AssertEqual(t, 0, tickTime.Hour())
+	}
+
+	// the axis ticks take precedence.
+	c.XAxis.Ticks = []Tick{{Value: TimeToFloat64(start), Label: "start"}}
+	xt, _, _ = c.getAxesTicks(r, xr, yr, yar, TimeValueFormatter, FloatValueFormatter, FloatValueFormatter)
+	testutil.AssertLen(t, xt, 1)
+}
+
 func TestChartSingleSeries(t *testing.T) {
 	// replaced new assertions helper
 	now := time.Now()
@@ -573,3 +661,755 @@ func TestChartE2ELineWithFill(t *testing.T) {
 	testutil.AssertEqual(t, defaultSeriesColor, at(i, 0, 49))
 	testutil.AssertEqual(t, defaultSeriesColor, at(i, 49, 0))
 }
+
+func TestChartRenderError(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+			ContinuousSeries{
+				Name:    "broken",
+				XValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	err := c.Render(PNG, bytes.NewBuffer(nil))
+	testutil.AssertNotNil(t, err)
+
+	var renderErr *RenderError
+	testutil.AssertTrue(t, errors.As(err, &renderErr))
+	testutil.AssertEqual(t, RenderStageSeries, renderErr.Stage)
+	testutil.AssertEqual(t, 1, renderErr.SeriesIndex)
+	testutil.AssertEqual(t, "broken", renderErr.SeriesName)
+	testutil.AssertNotNil(t, errors.Unwrap(err))
+
+	c.Series = []Series{
+		ContinuousSeries{
+			XValues: []float64{1.0, 1.0},
+			YValues: []float64{1.0, 1.0},
+		},
+	}
+	err = c.Render(PNG, bytes.NewBuffer(nil))
+	testutil.AssertTrue(t, errors.As(err, &renderErr))
+	testutil.AssertEqual(t, RenderStageRanges, renderErr.Stage)
+	testutil.AssertNotNil(t, errors.Unwrap(err))
+}
+
+func TestChartRenderWithEmptySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+			ContinuousSeries{Name: "empty"},
+		},
+	}
+	testutil.AssertNil(t, c.Render(PNG, bytes.NewBuffer(nil)))
+}
+
+type panicSeries struct {
+	ContinuousSeries
+}
+
+func (ps panicSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	panic("render failed")
+}
+
+func TestChartRenderRecoverPanics(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		RecoverPanics: true,
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+			panicSeries{ContinuousSeries{
+				Name:    "panics",
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			}},
+		},
+	}
+
+	err := c.Render(PNG, bytes.NewBuffer(nil))
+	testutil.AssertNotNil(t, err)
+
+	var renderErr *RenderError
+	testutil.AssertTrue(t, errors.As(err, &renderErr))
+	testutil.AssertEqual(t, RenderStageSeries, renderErr.Stage)
+	testutil.AssertEqual(t, 1, renderErr.SeriesIndex)
+}
+
+func TestRecoverRender(t *testing.T) {
+	// replaced new assertions helper
+
+	panics := func(width, height int) (Renderer, error) {
+		panic("renderer unavailable")
+	}
+	pie := PieChart{Values: []Value{{Value: 1, Label: "a"}, {Value: 2, Label: "b"}}}
+
+	err := RecoverRender(func() error { return pie.Render(panics, bytes.NewBuffer(nil)) })
+	testutil.AssertNotNil(t, err)
+	var renderErr *RenderError
+	testutil.AssertTrue(t, errors.As(err, &renderErr))
+	testutil.AssertEqual(t, RenderStageUnset, renderErr.Stage)
+	testutil.AssertContains(t, err.Error(), "renderer unavailable")
+
+	testutil.AssertNil(t, RecoverRender(func() error { return pie.Render(PNG, bytes.NewBuffer(nil)) }))
+
+	// errors returned by the render are passed through as they are.
+	expected := PieChart{}.Render(PNG, bytes.NewBuffer(nil))
+	testutil.AssertNotNil(t, expected)
+	testutil.AssertEqual(t, expected, RecoverRender(func() error { return PieChart{}.Render(PNG, bytes.NewBuffer(nil)) }))
+}
+
+func TestChartRenderEmptySeriesAnnotation(t *testing.T) {
+	// replaced new assertions helper
+
+	empty := ContinuousSeries{}
+	lv := LastValueAnnotationSeries(empty)
+	testutil.AssertLen(t, lv.Annotations, 1)
+
+	x, y := TimeSeries{}.GetLastValues()
+	testutil.AssertZero(t, x)
+	testutil.AssertZero(t, y)
+	testutil.AssertZero(t, (ContinuousRange{Min: 1, Max: 1, Domain: 100}).Translate(1))
+}
+
+func TestChartRenderTracer(t *testing.T) {
+	// replaced new assertions helper
+
+	var stages []RenderStage
+	var encoded int
+	c := Chart{
+		Tracer: TracerFunc(func(ti TraceInfo) {
+			stages = append(stages, ti.Stage)
+			if ti.Stage == RenderStageSeries {
+				testutil.AssertEqual(t, 3, ti.Values)
+			}
+			if ti.Stage == RenderStageEncode {
+				encoded = ti.Bytes
+			}
+		}),
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertEqual(t, []RenderStage{
+		RenderStageBackground,
+		RenderStageRanges,
+		RenderStageLayout,
+		RenderStageAxes,
+		RenderStageSeries,
+		RenderStageElements,
+		RenderStageEncode,
+	}, stages)
+	testutil.AssertEqual(t, buffer.Len(), encoded)
+}
+
+type spanTracer struct {
+	traced  []RenderStage
+	started []string
+	ended   []string
+	infos   []TraceInfo
+}
+
+func (st *spanTracer) Trace(ti TraceInfo) {
+	st.traced = append(st.traced, ti.Stage)
+}
+
+func (st *spanTracer) StartStage(ctx context.Context, stage RenderStage) (context.Context, func(TraceInfo)) {
+	parent, _ := ctx.Value(spanTracerKey{}).(string)
+	name := parent + "/" + stage.String()
+	st.started = append(st.started, name)
+	return context.WithValue(ctx, spanTracerKey{}, name), func(ti TraceInfo) {
+		st.ended = append(st.ended, name)
+		st.infos = append(st.infos, ti)
+	}
+}
+
+type spanTracerKey struct{}
+
+func TestChartRenderContextTracer(t *testing.T) {
+	// replaced new assertions helper
+
+	tracer := new(spanTracer)
+	c := Chart{
+		Tracer: tracer,
+		Series: []Series{
+			ContinuousSeries{
+				Name:    "a",
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), spanTracerKey{}, "request")
+	testutil.AssertNil(t, c.RenderContext(ctx, PNG, bytes.NewBuffer(nil)))
+	testutil.AssertEmpty(t, tracer.traced)
+	testutil.AssertEqual(t, []string{
+		"request/render",
+		"request/render/background",
+		"request/render/ranges",
+		"request/render/layout",
+		"request/render/axes",
+		"request/render/series",
+		"request/render/elements",
+		"request/render/encode",
+	}, tracer.started)
+
+	// each stage span ends before the next starts, and the render span ends last.
+	testutil.AssertEqual(t, []string{
+		"request/render/background",
+		"request/render/ranges",
+		"request/render/layout",
+		"request/render/axes",
+		"request/render/series",
+		"request/render/elements",
+		"request/render/encode",
+		"request/render",
+	}, tracer.ended)
+	testutil.AssertEqual(t, "a", tracer.infos[4].SeriesName)
+	testutil.AssertEqual(t, RenderStageRender, tracer.infos[7].Stage)
+	testutil.AssertTrue(t, tracer.infos[7].Elapsed >= tracer.infos[6].Elapsed)
+
+	testutil.AssertNil(t, c.Render(PNG, bytes.NewBuffer(nil)))
+	testutil.AssertNotEmpty(t, tracer.traced)
+}
+
+func TestChartRenderContextTracerError(t *testing.T) {
+	// replaced new assertions helper
+
+	// a single value has no x-range, which fails the render in the ranges stage.
+	tracer := new(spanTracer)
+	c := Chart{
+		Tracer: tracer,
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1.0}, YValues: []float64{1.0}},
+		},
+	}
+	ctx := context.WithValue(context.Background(), spanTracerKey{}, "request")
+	err := c.RenderContext(ctx, PNG, bytes.NewBuffer(nil))
+	testutil.AssertNotNil(t, err)
+	testutil.AssertEqual(t, []string{"request/render", "request/render/background", "request/render/ranges"}, tracer.started)
+	testutil.AssertEqual(t, []string{"request/render/background", "request/render/ranges", "request/render"}, tracer.ended)
+	testutil.AssertNil(t, tracer.infos[0].Err)
+	testutil.AssertEqual(t, RenderStageRanges, tracer.infos[1].Stage)
+	testutil.AssertEqual(t, err, tracer.infos[1].Err)
+	testutil.AssertEqual(t, err, tracer.infos[2].Err)
+
+	// a recovered panic ends the span of the series that panicked.
+	tracer = new(spanTracer)
+	c = Chart{
+		Tracer:        tracer,
+		RecoverPanics: true,
+		Series: []Series{
+			panicSeries{ContinuousSeries{
+				Name:    "panics",
+				XValues: []float64{1.0, 2.0},
+				YValues: []float64{1.0, 2.0},
+			}},
+		},
+	}
+	err = c.RenderContext(ctx, PNG, bytes.NewBuffer(nil))
+	testutil.AssertNotNil(t, err)
+	testutil.AssertEqual(t, len(tracer.started), len(tracer.ended))
+	testutil.AssertEqual(t, "request/render/series", tracer.ended[4])
+	testutil.AssertEqual(t, "panics", tracer.infos[4].SeriesName)
+	testutil.AssertEqual(t, err, tracer.infos[4].Err)
+}
+
+func TestChartClone(t *testing.T) {
+	// replaced new assertions helper
+
+	template := Chart{
+		YAxis: YAxis{
+			Range: &ContinuousRange{Min: 0, Max: 10},
+			Style: Style{StrokeDashArray: []float64{2, 2}},
+			Break: AxisBreak{Min: 4, Max: 6, Style: Style{StrokeDashArray: []float64{3, 3}}},
+		},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+		},
+	}
+
+	clone := template.Clone()
+	clone.YAxis.Style.StrokeDashArray[0] = 5
+	clone.YAxis.Break.Style.StrokeDashArray[0] = 5
+	clone.YAxis.Range.SetMax(20)
+	clone.Series[0] = ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{3, 4}}
+
+	testutil.AssertEqual(t, 2.0, template.YAxis.Style.StrokeDashArray[0])
+	testutil.AssertEqual(t, 3.0, template.YAxis.Break.Style.StrokeDashArray[0])
+	testutil.AssertEqual(t, 10.0, template.YAxis.Range.GetMax())
+	testutil.AssertEqual(t, 2.0, template.Series[0].(ContinuousSeries).YValues[1])
+}
+
+func TestChartRenderConcurrent(t *testing.T) {
+	// replaced new assertions helper
+
+	template := Chart{
+		XAxis: XAxis{Range: &ContinuousRange{Min: 0, Max: 10}},
+		YAxis: YAxis{Range: &ContinuousRange{Min: 0, Max: 100}},
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for index := 0; index < 8; index++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := template.Clone()
+			c.Series = []Series{
+				ContinuousSeries{XValues: LinearRange(0, 10), YValues: RandomValuesWithMax(11, 100)},
+			}
+			errs <- c.Render(PNG, bytes.NewBuffer(nil))
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		testutil.AssertNil(t, err)
+	}
+
+	testutil.AssertZero(t, template.XAxis.Range.GetDomain())
+	testutil.AssertZero(t, template.YAxis.Range.GetDomain())
+}
+
+func TestChartRenderShrinkWrap(t *testing.T) {
+	// replaced new assertions helper
+
+	var canvas Box
+	c := Chart{
+		Title:        "Shrink Wrapped",
+		CanvasWidth:  400,
+		CanvasHeight: 300,
+		YAxis: YAxis{
+			Name: "Requests",
+			ValueFormatter: func(v interface{}) string {
+				return FloatValueFormatterWithFormat(v, "%0.2f requests/sec")
+			},
+		},
+		Tracer: TracerFunc(func(ti TraceInfo) {
+			if ti.Stage == RenderStageLayout {
+				canvas = ti.Canvas
+			}
+		}),
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0, 4.0},
+				YValues: []float64{1000.0, 2000.0, 3000.0, 4000.0},
+			},
+		},
+	}
+
+	iw := &ImageWriter{}
+	testutil.AssertNil(t, c.Render(PNG, iw))
+	img, err := iw.Image()
+	testutil.AssertNil(t, err)
+
+	testutil.AssertTrue(t, img.Bounds().Dx() > 400)
+	testutil.AssertTrue(t, img.Bounds().Dy() > 300)
+	testutil.AssertInDelta(t, 400, float64(canvas.Width()), 2)
+	testutil.AssertInDelta(t, 300, float64(canvas.Height()), 2)
+	testutil.AssertTrue(t, canvas.Top > DefaultTitleTop)
+}
+
+func TestChartRenderShrinkWrapElements(t *testing.T) {
+	// replaced new assertions helper
+
+	render := func(elements ...Renderable) (canvas Box, bounds image.Rectangle) {
+		c := Chart{
+			CanvasWidth: 400,
+			Tracer: TracerFunc(func(ti TraceInfo) {
+				if ti.Stage == RenderStageLayout {
+					canvas = ti.Canvas
+				}
+			}),
+			Elements: elements,
+			Series: []Series{
+				ContinuousSeries{
+					XValues: []float64{1.0, 2.0, 3.0, 4.0},
+					YValues: []float64{1.0, 2.0, 3.0, 4.0},
+				},
+			},
+		}
+		iw := &ImageWriter{}
+		testutil.AssertNil(t, c.Render(PNG, iw))
+		img, err := iw.Image()
+		testutil.AssertNil(t, err)
+		return canvas, img.Bounds()
+	}
+
+	// a key drawn well to the left of the canvas, past the axes.
+	key := func(r Renderer, cb Box, defaults Style) {
+		Draw.Box(r, Box{Top: cb.Top, Left: cb.Left - 200, Right: cb.Left - 120, Bottom: cb.Top + 50}, Style{FillColor: drawing.ColorWhite, StrokeColor: DefaultAxisColor, StrokeWidth: 1})
+	}
+
+	canvas, bounds := render()
+	keyCanvas, keyBounds := render(key)
+
+	// the chart widens to fit the key, moving the canvas right, and the canvas keeps its width.
+	testutil.AssertTrue(t, keyBounds.Dx() > bounds.Dx())
+	testutil.AssertEqual(t, keyBounds.Dx()-bounds.Dx(), keyCanvas.Left-canvas.Left)
+	testutil.AssertTrue(t, keyCanvas.Left-200 >= 0)
+	testutil.AssertInDelta(t, 400, float64(keyCanvas.Width()), 2)
+}
+
+func TestChartRenderShrinkWrapError(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		CanvasWidth: 400,
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0},
+				YValues: []float64{1.0, 2.0},
+			},
+		},
+	}
+	failing := func(int, int) (Renderer, error) {
+		return nil, errors.New("no renderer")
+	}
+	err := c.Render(failing, bytes.NewBuffer(nil))
+	var renderErr *RenderError
+	testutil.AssertTrue(t, errors.As(err, &renderErr))
+	testutil.AssertEqual(t, RenderStageLayout, renderErr.Stage)
+}
+
+func TestChartRenderCanvasMargin(t *testing.T) {
+	// replaced new assertions helper
+
+	render := func(margin Box) (canvas Box, bounds image.Rectangle) {
+		c := Chart{
+			CanvasWidth:  400,
+			CanvasHeight: 300,
+			Canvas:       Style{Margin: margin},
+			XAxis:        XAxis{Name: "Time"},
+			YAxis:        YAxis{Name: "Requests"},
+			Tracer: TracerFunc(func(ti TraceInfo) {
+				if ti.Stage == RenderStageLayout {
+					canvas = ti.Canvas
+				}
+			}),
+			Series: []Series{
+				ContinuousSeries{
+					XValues: []float64{1.0, 2.0, 3.0, 4.0},
+					YValues: []float64{1000.0, 2000.0, 3000.0, 4000.0},
+				},
+			},
+		}
+		iw := &ImageWriter{}
+		testutil.AssertNil(t, c.Render(PNG, iw))
+		img, err := iw.Image()
+		testutil.AssertNil(t, err)
+		return canvas, img.Bounds()
+	}
+
+	canvas, bounds := render(Box{})
+	marginCanvas, marginBounds := render(Box{Right: 20, Bottom: 25})
+
+	// the canvas keeps its size, and the chart grows by the margin between it and the axes.
+	testutil.AssertEqual(t, canvas.Width(), marginCanvas.Width())
+	testutil.AssertEqual(t, canvas.Height(), marginCanvas.Height())
+	testutil.AssertEqual(t, bounds.Dx()+20, marginBounds.Dx())
+	testutil.AssertEqual(t, bounds.Dy()+25, marginBounds.Dy())
+	testutil.AssertEqual(t, canvas.Top, marginCanvas.Top)
+	testutil.AssertEqual(t, canvas.Left, marginCanvas.Left)
+}
+
+func TestChartRenderRoundedBackground(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Width:  200,
+		Height: 100,
+		Background: Style{
+			FillColor:    drawing.ColorBlue,
+			StrokeColor:  drawing.ColorBlack,
+			StrokeWidth:  2,
+			CornerRadius: 10,
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	iw := &ImageWriter{}
+	testutil.AssertNil(t, c.Render(PNG, iw))
+	img, err := iw.Image()
+	testutil.AssertNil(t, err)
+
+	_, _, _, cornerAlpha := img.At(0, 0).RGBA()
+	testutil.AssertZero(t, cornerAlpha)
+	_, _, b, _ := img.At(100, 3).RGBA()
+	testutil.AssertNotZero(t, b)
+}
+
+func TestChartRenderTransparentBackground(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Width:      200,
+		Height:     100,
+		Background: Style{Hidden: true},
+		Canvas:     Style{Hidden: true},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	iw := &ImageWriter{}
+	testutil.AssertNil(t, c.Render(PNG, iw))
+	img, err := iw.Image()
+	testutil.AssertNil(t, err)
+
+	_, _, _, backgroundAlpha := img.At(1, 1).RGBA()
+	testutil.AssertZero(t, backgroundAlpha)
+	_, _, _, canvasAlpha := img.At(10, 10).RGBA()
+	testutil.AssertZero(t, canvasAlpha)
+
+	svg := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, svg))
+	testutil.AssertNotContains(t, svg.String(), "M 0 0\nL 200 0")
+}
+
+func TestChartSecondaryAxisTransform(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		YAxis: YAxis{
+			Range: &ContinuousRange{Min: 0, Max: 100},
+		},
+		YAxisSecondary: YAxis{
+			Name:      "Fahrenheit",
+			Transform: func(v float64) float64 { return v*9/5 + 32 },
+		},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{10, 50, 90}},
+		},
+	}
+	testutil.AssertTrue(t, c.hasSecondaryAxis())
+
+	_, _, yra := c.getRanges()
+	testutil.AssertEqual(t, 32.0, yra.GetMin())
+	testutil.AssertEqual(t, 212.0, yra.GetMax())
+	testutil.AssertFalse(t, yra.IsDescending())
+
+	c.YAxisSecondary.Transform = func(v float64) float64 { return -v }
+	_, _, yra = c.getRanges()
+	testutil.AssertEqual(t, -100.0, yra.GetMin())
+	testutil.AssertEqual(t, 0.0, yra.GetMax())
+	testutil.AssertTrue(t, yra.IsDescending())
+
+	var canvas Box
+	c.Tracer = TracerFunc(func(info TraceInfo) {
+		if info.Stage == RenderStageLayout {
+			canvas = info.Canvas
+		}
+	})
+	testutil.AssertNil(t, c.Render(PNG, bytes.NewBuffer(nil)))
+	// the mirrored tick labels and name push the canvas in from the left.
+	testutil.AssertTrue(t, canvas.Left > c.Box().Left+20)
+}
+
+func TestChartRenderInto(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Background: Style{FillColor: drawing.ColorBlue},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	region := image.Rect(50, 40, 250, 140)
+	rgba := image.NewRGBA(image.Rect(0, 0, 300, 200))
+	nrgba := image.NewNRGBA(image.Rect(0, 0, 300, 200))
+	for _, dst := range []xdraw.Image{rgba, nrgba} {
+		testutil.AssertNil(t, c.RenderInto(dst, region))
+
+		_, _, _, outsideAlpha := dst.At(49, 39).RGBA()
+		testutil.AssertZero(t, outsideAlpha)
+		_, _, _, outsideAlpha = dst.At(250, 140).RGBA()
+		testutil.AssertZero(t, outsideAlpha)
+		r, _, b, _ := dst.At(51, 41).RGBA()
+		testutil.AssertZero(t, r)
+		testutil.AssertNotZero(t, b)
+		_, _, b, _ = dst.At(249, 139).RGBA()
+		testutil.AssertNotZero(t, b)
+	}
+
+	err := c.RenderInto(rgba, image.Rect(400, 400, 500, 500))
+	testutil.AssertNotNil(t, err)
+}
+
+func TestChartRenderBytes(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	contents, err := c.RenderBytes(SVG)
+	testutil.AssertNil(t, err)
+	testutil.AssertContains(t, string(contents), "<svg")
+
+	b := bytes.NewBuffer(nil)
+	n, err := c.WriterTo(SVG).WriteTo(b)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, int64(len(contents)), n)
+	testutil.AssertEqual(t, string(contents), b.String())
+
+	_, err = Chart{}.RenderBytes(SVG)
+	testutil.AssertNotNil(t, err)
+}
+
+func TestChartSparkline(t *testing.T) {
+	// replaced new assertions helper
+
+	series := ContinuousSeries{
+		Name:    "Hidden",
+		XValues: []float64{1.0, 2.0, 3.0, 4.0},
+		YValues: []float64{1.0, 3.0, 2.0, 4.0},
+	}
+	c := Chart{
+		Title:     "Hidden",
+		Sparkline: true,
+		Series: []Series{
+			series,
+			LastValueAnnotationSeries(series),
+		},
+	}
+	c.Elements = []Renderable{Legend(&c)}
+	testutil.AssertEqual(t, DefaultSparklineWidth, c.GetWidth())
+	testutil.AssertEqual(t, DefaultSparklineHeight, c.GetHeight())
+
+	var canvas Box
+	c.Tracer = TracerFunc(func(ti TraceInfo) {
+		if ti.Stage == RenderStageLayout {
+			canvas = ti.Canvas
+		}
+	})
+	svg := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, svg))
+	testutil.AssertNotContains(t, svg.String(), "<text")
+	testutil.AssertEqual(t, Box{
+		Top:    DefaultSparklinePadding,
+		Left:   DefaultSparklinePadding,
+		Right:  DefaultSparklineWidth - DefaultSparklinePadding,
+		Bottom: DefaultSparklineHeight - DefaultSparklinePadding,
+	}, canvas)
+
+	// the chart itself is not modified.
+	testutil.AssertLen(t, c.Elements, 1)
+	testutil.AssertFalse(t, c.Series[1].GetStyle().Hidden)
+}
+
+func TestChartRenderAxisNames(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		XAxis: XAxis{Name: "Elapsed Time"},
+		YAxis: YAxis{Name: "Latency"},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{3, 1, 2}},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), "Elapsed Time")
+	testutil.AssertContains(t, buffer.String(), "rotate(90.00")
+}
+
+func TestChartGetRangesXAxisDescending(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	}
+	xrange, _, _ := c.getRanges()
+	testutil.AssertFalse(t, xrange.IsDescending())
+	xrange.SetDomain(100)
+	testutil.AssertTrue(t, xrange.Translate(1) < xrange.Translate(3))
+
+	c.XAxis.Descending = true
+	xrange, _, _ = c.getRanges()
+	testutil.AssertTrue(t, xrange.IsDescending())
+	xrange.SetDomain(100)
+	testutil.AssertTrue(t, xrange.Translate(1) > xrange.Translate(3))
+
+	c.XAxis.Categories = []string{"a", "b", "c"}
+	xrange, _, _ = c.getRanges()
+	testutil.AssertTrue(t, xrange.IsDescending())
+
+	c.XAxis.Range = &ContinuousRange{}
+	xrange, _, _ = c.getRanges()
+	testutil.AssertFalse(t, xrange.IsDescending())
+}
+
+func TestChartRenderTransparentFill(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Width:      200,
+		Height:     100,
+		Background: Style{FillColor: ColorTransparent},
+		Canvas:     Style{FillColor: ColorTransparent},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+	testutil.AssertTrue(t, c.getBackgroundStyle().GetFillColor().IsTransparent())
+	testutil.AssertTrue(t, c.getCanvasStyle().GetFillColor().IsTransparent())
+
+	iw := &ImageWriter{}
+	testutil.AssertNil(t, c.Render(PNG, iw))
+	img, err := iw.Image()
+	testutil.AssertNil(t, err)
+
+	_, _, _, backgroundAlpha := img.At(2, 2).RGBA()
+	testutil.AssertZero(t, backgroundAlpha)
+	_, _, _, canvasAlpha := img.At(40, 40).RGBA()
+	testutil.AssertZero(t, canvasAlpha)
+
+	svg := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, svg))
+	testutil.AssertNotContains(t, svg.String(), "fill:rgba(255,255,255,1.0)")
+}
@@ -0,0 +1,267 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// ChordChart is a chart that draws the flows between a set of groups, e.g. trade between currencies, as
+// arcs around a circle, one for each group, joined by ribbons as wide as the flows between them.
+//
+// `Matrix[i][j]` is the flow from group i to group j; the arc of each group spans its total outgoing flow,
+// and the ribbon between two groups spans each of their flows to the other at either end.
+type ChordChart struct {
+	ChartFrame
+
+	GroupStyle  Style
+	RibbonStyle Style
+	LabelStyle  Style
+
+	// GroupWidth is the pixel thickness of the group arcs, it defaults to a twelfth of the radius.
+	GroupWidth int
+	// GroupPadding is the angle, in radians, between groups.
+	GroupPadding float64
+
+	Labels   []string
+	Matrix   [][]float64
+	Elements []Renderable
+}
+
+// chordGroup is the angles a group of a chord chart spans, and of each of its flows.
+type chordGroup struct {
+	Start, End float64
+	Flows      [][2]float64
+}
+
+// GetHeight returns the chart height or the default value.
+func (cc ChordChart) GetHeight() int {
+	if cc.Height == 0 {
+		return DefaultChartWidth
+	}
+	return cc.Height
+}
+
+// Box returns the chart bounds as a box, at the chart's own default size.
+func (cc ChordChart) Box() Box {
+	return cc.box(cc.GetWidth(), cc.GetHeight())
+}
+
+// GetGroupPadding returns the angle between groups or the default value.
+func (cc ChordChart) GetGroupPadding() float64 {
+	if cc.GroupPadding == 0 {
+		return DefaultChordGroupPadding
+	}
+	return cc.GroupPadding
+}
+
+// GetGroupWidth returns the thickness of the group arcs, or the default for a given radius.
+func (cc ChordChart) GetGroupWidth(radius int) int {
+	if cc.GroupWidth > 0 {
+		return cc.GroupWidth
+	}
+	return MaxInt(1, radius/12)
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (cc ChordChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := cc.validate(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := cc.GetWidth(), cc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if cc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		cc.defaultFont = defaultFont
+	}
+	r.SetDPI(cc.GetDPI(DefaultDPI))
+
+	canvasBox := cc.getDefaultCanvasBox(r, width, height)
+
+	cc.drawBackground(r, width, height)
+	cc.drawCanvas(r, canvasBox)
+	cc.drawChords(r, canvasBox)
+	cc.drawTitle(r, width, height)
+	for _, a := range cc.Elements {
+		a(r, canvasBox, cc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (cc ChordChart) validate() error {
+	if len(cc.Matrix) == 0 {
+		return errors.New("please provide a matrix of at least one group")
+	}
+	var total float64
+	for _, row := range cc.Matrix {
+		if len(row) != len(cc.Matrix) {
+			return fmt.Errorf("chord chart matrix must be square")
+		}
+		for _, v := range row {
+			if v < 0 {
+				return fmt.Errorf("chord chart flows cannot be negative")
+			}
+			total += v
+		}
+	}
+	if total == 0 {
+		return fmt.Errorf("chord chart must contain at least (1) non-zero flow")
+	}
+	return nil
+}
+
+// getGroups returns the angles of each group, spread clockwise from the top in proportion to their
+// outgoing flows, with the angles of each of their flows in turn.
+func (cc ChordChart) getGroups() []chordGroup {
+	var total float64
+	for _, row := range cc.Matrix {
+		total += Sum(row...)
+	}
+	scale := math.Max(0, _2pi-cc.GetGroupPadding()*float64(len(cc.Matrix))) / total
+
+	groups := make([]chordGroup, len(cc.Matrix))
+	angle := DefaultChordStartAngle
+	for index, row := range cc.Matrix {
+		group := chordGroup{
+			Start: angle,
+			Flows: make([][2]float64, len(row)),
+		}
+		for target, v := range row {
+			group.Flows[target] = [2]float64{angle, angle + v*scale}
+			angle += v * scale
+		}
+		group.End = angle
+		groups[index] = group
+		angle += cc.GetGroupPadding()
+	}
+	return groups
+}
+
+// drawChords draws the ribbons between each pair of groups, then the group arcs and labels over them.
+func (cc ChordChart) drawChords(r Renderer, canvasBox Box) {
+	cx, cy := canvasBox.Center()
+	labelStyle := cc.styleDefaultsLabels()
+
+	var labelWidth, labelHeight int
+	for _, label := range cc.Labels {
+		tb := Draw.MeasureText(r, label, labelStyle)
+		labelWidth = MaxInt(labelWidth, tb.Width())
+		labelHeight = MaxInt(labelHeight, tb.Height())
+	}
+	if labelWidth > 0 && !cc.LabelStyle.Hidden {
+		labelWidth += DefaultChordLabelGap
+		labelHeight += DefaultChordLabelGap
+	} else {
+		labelWidth, labelHeight = 0, 0
+	}
+
+	outer := MinInt(canvasBox.Width()>>1-labelWidth, canvasBox.Height()>>1-labelHeight)
+	if outer <= 0 {
+		return
+	}
+	groupWidth := cc.GetGroupWidth(outer)
+	inner := float64(outer - groupWidth)
+
+	groups := cc.getGroups()
+	for source := range groups {
+		for target := source; target < len(groups); target++ {
+			if cc.Matrix[source][target]+cc.Matrix[target][source] == 0 {
+				continue
+			}
+			// ribbons take the color of the group with the larger flow.
+			colorIndex := source
+			if cc.Matrix[target][source] > cc.Matrix[source][target] {
+				colorIndex = target
+			}
+			cc.styleChordRibbon(colorIndex).WriteToRenderer(r)
+			cc.drawRibbon(r, cx, cy, inner, groups[source].Flows[target], groups[target].Flows[source])
+		}
+	}
+
+	for index, group := range groups {
+		if group.End <= group.Start {
+			continue
+		}
+		radius := inner + float64(groupWidth)/2
+		cc.styleChordGroup(index, groupWidth).GetStrokeOptions().WriteToRenderer(r)
+		r.ArcTo(cx, cy, radius, radius, group.Start, group.End-group.Start)
+		r.Stroke()
+
+		if index < len(cc.Labels) && len(cc.Labels[index]) > 0 && !cc.LabelStyle.Hidden {
+			theta := (group.Start + group.End) / 2
+			lx, ly := cc.pointAt(cx, cy, float64(outer+DefaultChordLabelGap), theta)
+			tb := Draw.MeasureText(r, cc.Labels[index], labelStyle)
+			if math.Cos(theta) < 0 {
+				lx -= tb.Width()
+			}
+			ly += tb.Height() >> 1
+			if math.Sin(theta) > 0.5 {
+				ly += tb.Height() >> 1
+			} else if math.Sin(theta) < -0.5 {
+				ly -= tb.Height() >> 1
+			}
+			Draw.Text(r, cc.Labels[index], lx, ly, labelStyle)
+		}
+	}
+}
+
+// drawRibbon draws a ribbon from the arc of one flow, through the center, to the arc of another and back.
+//
+// Each curve starts with a line to the end of the arc before it, as rasterized curves start from the
+// last point of the path rather than the end of an arc.
+func (cc ChordChart) drawRibbon(r Renderer, cx, cy int, radius float64, from, to [2]float64) {
+	r.ArcTo(cx, cy, radius, radius, from[0], from[1]-from[0])
+	r.LineTo(cc.pointAt(cx, cy, radius, from[1]))
+	// a group's flow to itself is a single arc, closed by a curve back to its start.
+	if from != to {
+		x, y := cc.pointAt(cx, cy, radius, to[0])
+		r.QuadCurveTo(cx, cy, x, y)
+		r.ArcTo(cx, cy, radius, radius, to[0], to[1]-to[0])
+		r.LineTo(cc.pointAt(cx, cy, radius, to[1]))
+	}
+	x, y := cc.pointAt(cx, cy, radius, from[0])
+	r.QuadCurveTo(cx, cy, x, y)
+	r.Close()
+	r.FillStroke()
+}
+
+// pointAt returns the point on a circle at an angle clockwise from three o'clock, as `Renderer.ArcTo` measures it.
+func (cc ChordChart) pointAt(cx, cy int, radius, theta float64) (x, y int) {
+	x = cx + int(math.Round(radius*math.Cos(theta)))
+	y = cy + int(math.Round(radius*math.Sin(theta)))
+	return
+}
+
+func (cc ChordChart) styleChordGroup(index, groupWidth int) Style {
+	return cc.GroupStyle.InheritFrom(Style{
+		StrokeColor: cc.GetColorPalette().GetSeriesColor(index),
+		StrokeWidth: float64(groupWidth),
+	})
+}
+
+func (cc ChordChart) styleChordRibbon(index int) Style {
+	color := cc.GetColorPalette().GetSeriesColor(index)
+	return cc.RibbonStyle.InheritFrom(Style{
+		FillColor:   color.WithAlpha(128),
+		StrokeColor: color.WithAlpha(192),
+		StrokeWidth: 1,
+	})
+}
+
+func (cc ChordChart) styleDefaultsLabels() Style {
+	return cc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: cc.GetColorPalette().TextColor(),
+		Font:      cc.GetFont(),
+	})
+}
@@ -0,0 +1,65 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestChordChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	cc := ChordChart{
+		ChartFrame: ChartFrame{
+			Title: "Test",
+		},
+		Labels: []string{"A", "B", "C"},
+		Matrix: [][]float64{
+			{1, 2, 3},
+			{4, 0, 1},
+			{0, 2, 2},
+		},
+	}
+
+	for _, rp := range []RendererProvider{PNG, SVG} {
+		b := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, cc.Render(rp, b))
+		testutil.AssertNotZero(t, b.Len())
+	}
+}
+
+func TestChordChartRenderInvalid(t *testing.T) {
+	// replaced new assertions helper
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, ChordChart{}.Render(PNG, b))
+	testutil.AssertNotNil(t, ChordChart{Matrix: [][]float64{{1, 2}}}.Render(PNG, b))
+	testutil.AssertNotNil(t, ChordChart{Matrix: [][]float64{{0, 0}, {0, 0}}}.Render(PNG, b))
+	testutil.AssertNotNil(t, ChordChart{Matrix: [][]float64{{1, -1}, {0, 1}}}.Render(PNG, b))
+}
+
+func TestChordChartGetGroups(t *testing.T) {
+	// replaced new assertions helper
+
+	cc := ChordChart{
+		GroupPadding: 0.1,
+		Matrix: [][]float64{
+			{1, 1},
+			{2, 0},
+		},
+	}
+
+	groups := cc.getGroups()
+	testutil.AssertLen(t, groups, 2)
+
+	scale := (_2pi - 0.2) / 4
+	testutil.AssertInDelta(t, DefaultChordStartAngle, groups[0].Start, 1e-9)
+	testutil.AssertInDelta(t, DefaultChordStartAngle+2*scale, groups[0].End, 1e-9)
+	testutil.AssertInDelta(t, groups[0].End+0.1, groups[1].Start, 1e-9)
+	testutil.AssertInDelta(t, 2*scale, groups[1].Flows[0][1]-groups[1].Flows[0][0], 1e-9)
+	testutil.AssertInDelta(t, 0, groups[1].Flows[1][1]-groups[1].Flows[1][0], 1e-9)
+
+	// the groups and padding go once around the circle.
+	testutil.AssertInDelta(t, DefaultChordStartAngle+_2pi, groups[1].End+0.1, 1e-9)
+}
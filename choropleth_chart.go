@@ -0,0 +1,227 @@
+package chart
+
+import (
+	"errors"
+	"io"
+	"math"
+)
+
+// MapProjection is an enum for the ways longitude and latitude can be projected onto a map.
+type MapProjection int
+
+const (
+	// MapProjectionUnset is the unset state for map projections; it defaults to `MapProjectionEquirectangular`.
+	MapProjectionUnset MapProjection = 0
+	// MapProjectionEquirectangular maps longitude and latitude directly to x and y.
+	MapProjectionEquirectangular MapProjection = 1
+	// MapProjectionMercator stretches latitudes away from the equator so that shapes keep their angles.
+	MapProjectionMercator MapProjection = 2
+)
+
+// Project returns the x and y of a longitude and latitude in degrees, with y increasing northward.
+func (mp MapProjection) Project(lon, lat float64) (x, y float64) {
+	if mp == MapProjectionMercator {
+		lat = math.Max(-DefaultMercatorMaxLatitude, math.Min(DefaultMercatorMaxLatitude, lat))
+		return lon, RadiansToDegrees(math.Log(math.Tan(math.Pi/4 + DegreesToRadians(lat)/2)))
+	}
+	return lon, lat
+}
+
+// ChoroplethChart is a chart that draws a map of features, e.g. countries from `ParseGeoJSON`, each filled
+// with the color of its value through a color map, with a color bar legend.
+type ChoroplethChart struct {
+	ChartFrame
+
+	// FeatureStyle is the style of the features, e.g. the stroke of their borders.
+	FeatureStyle Style
+	// MissingStyle is the style of features without a value.
+	MissingStyle Style
+	LegendStyle  Style
+
+	Projection MapProjection
+	// ColorMap maps the values to colors, it defaults to `Jet`.
+	ColorMap       ColorMap
+	ValueFormatter ValueFormatter
+
+	Features []GeoFeature
+	// Values are the values of the features by name; features are drawn in the order of `Features`,
+	// looking up their values, so the order the map is iterated in does not matter.
+	Values   map[string]float64
+	Elements []Renderable
+}
+
+// GetColorMap returns the color map or the default.
+func (cc ChoroplethChart) GetColorMap() ColorMap {
+	if cc.ColorMap == nil {
+		return Jet
+	}
+	return cc.ColorMap
+}
+
+// GetValueFormatter returns the legend value formatter or the default.
+func (cc ChoroplethChart) GetValueFormatter() ValueFormatter {
+	if cc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return cc.ValueFormatter
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (cc ChoroplethChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(cc.Features) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one feature"))
+	}
+
+	width, height := cc.GetWidth(), cc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if cc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		cc.defaultFont = defaultFont
+	}
+	r.SetDPI(cc.GetDPI(DefaultDPI))
+
+	canvasBox := cc.getDefaultCanvasBox(r, width, height)
+	mapBox, legendBox := cc.getLayout(r, canvasBox)
+
+	cc.drawBackground(r, width, height)
+	cc.drawCanvas(r, canvasBox)
+	cc.drawFeatures(r, mapBox)
+	cc.drawLegend(r, legendBox)
+	cc.drawTitle(r, width, height)
+	for _, a := range cc.Elements {
+		a(r, canvasBox, cc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+// getValueRange returns the min and max of the values of the features.
+func (cc ChoroplethChart) getValueRange() (min, max float64, ok bool) {
+	min, max = math.MaxFloat64, -math.MaxFloat64
+	for _, f := range cc.Features {
+		if value, hasValue := cc.Values[f.Name]; hasValue {
+			min, max, ok = math.Min(min, value), math.Max(max, value), true
+		}
+	}
+	return
+}
+
+// getLayout splits the canvas into the map, and the color bar and its labels to the right of it.
+func (cc ChoroplethChart) getLayout(r Renderer, canvasBox Box) (mapBox, legendBox Box) {
+	mapBox = canvasBox
+	min, max, ok := cc.getValueRange()
+	if !ok || cc.LegendStyle.Hidden {
+		return
+	}
+	mapBox.Right -= DefaultColorBarGap + colorBarLegendWidth(r, min, max, cc.GetValueFormatter(), cc.styleDefaultsLegend())
+	legendBox = colorBarLegendBox(Box{Top: canvasBox.Top, Right: mapBox.Right, Bottom: canvasBox.Bottom})
+	return
+}
+
+// drawFeatures projects the features to fill the map box as far as they can while keeping their aspect,
+// then draws each of them.
+func (cc ChoroplethChart) drawFeatures(r Renderer, mapBox Box) {
+	toCanvas, ok := fitMapProjection(cc.Projection, geoFeaturePoints(cc.Features), mapBox)
+	if !ok {
+		return
+	}
+
+	min, max, _ := cc.getValueRange()
+	for _, f := range cc.Features {
+		style := cc.MissingStyle.InheritFrom(cc.styleDefaultsFeature().InheritFrom(Style{
+			FillColor: ColorLightGray,
+		}))
+		if value, hasValue := cc.Values[f.Name]; hasValue {
+			style = cc.styleDefaultsFeature().InheritFrom(Style{
+				FillColor: cc.GetColorMap()(value, min, max),
+			})
+		}
+		drawGeoFeature(r, f, toCanvas, style)
+	}
+}
+
+// geoFeaturePoints returns the [longitude, latitude] points of the rings of some features.
+func geoFeaturePoints(features []GeoFeature) (points [][2]float64) {
+	for _, f := range features {
+		for _, polygon := range f.Polygons {
+			for _, ring := range polygon {
+				points = append(points, ring...)
+			}
+		}
+	}
+	return
+}
+
+// fitMapProjection returns a function that projects longitudes and latitudes into a box, scaled so
+// that a set of points fills it as far as they can while keeping their aspect.
+func fitMapProjection(projection MapProjection, points [][2]float64, b Box) (toCanvas func(lon, lat float64) (int, int), ok bool) {
+	minX, minY := math.MaxFloat64, math.MaxFloat64
+	maxX, maxY := -math.MaxFloat64, -math.MaxFloat64
+	for _, point := range points {
+		x, y := projection.Project(point[0], point[1])
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+	}
+	if minX > maxX || b.Width() <= 0 || b.Height() <= 0 {
+		return nil, false
+	}
+
+	scale := math.Min(float64(b.Width())/math.Max(maxX-minX, 1e-9), float64(b.Height())/math.Max(maxY-minY, 1e-9))
+	cx, cy := b.Center()
+	midX, midY := (minX+maxX)/2, (minY+maxY)/2
+	return func(lon, lat float64) (int, int) {
+		x, y := projection.Project(lon, lat)
+		return cx + int(math.Round((x-midX)*scale)), cy - int(math.Round((y-midY)*scale))
+	}, true
+}
+
+// drawGeoFeature fills and strokes the polygons of a feature.
+func drawGeoFeature(r Renderer, f GeoFeature, toCanvas func(lon, lat float64) (int, int), style Style) {
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	for _, polygon := range f.Polygons {
+		for _, ring := range polygon {
+			if len(ring) == 0 {
+				continue
+			}
+			r.MoveTo(toCanvas(ring[0][0], ring[0][1]))
+			for _, point := range ring[1:] {
+				r.LineTo(toCanvas(point[0], point[1]))
+			}
+			r.Close()
+		}
+	}
+	r.FillStroke()
+}
+
+// drawLegend draws the color bar with the min, middle and max values beside it.
+func (cc ChoroplethChart) drawLegend(r Renderer, legendBox Box) {
+	min, max, ok := cc.getValueRange()
+	if !ok || cc.LegendStyle.Hidden {
+		return
+	}
+	drawColorBarLegend(r, legendBox, cc.GetColorMap(), min, max, cc.GetValueFormatter(), cc.styleDefaultsLegend())
+}
+
+func (cc ChoroplethChart) styleDefaultsFeature() Style {
+	return cc.FeatureStyle.InheritFrom(Style{
+		StrokeColor: cc.GetColorPalette().BackgroundColor(),
+		StrokeWidth: 0.5,
+	})
+}
+
+func (cc ChoroplethChart) styleDefaultsLegend() Style {
+	return cc.LegendStyle.InheritFrom(Style{
+		FontSize:    DefaultFontSize,
+		FontColor:   cc.GetColorPalette().TextColor(),
+		Font:        cc.GetFont(),
+		StrokeColor: cc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
@@ -0,0 +1,69 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestMapProjectionProject(t *testing.T) {
+	// replaced new assertions helper
+
+	x, y := MapProjectionUnset.Project(10, 45)
+	testutil.AssertEqual(t, 10.0, x)
+	testutil.AssertEqual(t, 45.0, y)
+
+	x, y = MapProjectionMercator.Project(10, 0)
+	testutil.AssertEqual(t, 10.0, x)
+	testutil.AssertInDelta(t, 0, y, 1e-9)
+
+	// mercator stretches latitudes away from the equator, and clamps the poles.
+	_, y = MapProjectionMercator.Project(0, 60)
+	testutil.AssertTrue(t, y > 60)
+	_, pole := MapProjectionMercator.Project(0, 90)
+	_, clamped := MapProjectionMercator.Project(0, DefaultMercatorMaxLatitude)
+	testutil.AssertEqual(t, clamped, pole)
+}
+
+func TestChoroplethChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	features, err := ParseGeoJSON([]byte(testGeoJSON), "name")
+	testutil.AssertNil(t, err)
+
+	for _, projection := range []MapProjection{MapProjectionEquirectangular, MapProjectionMercator} {
+		cc := ChoroplethChart{
+			ChartFrame: ChartFrame{
+				Title: "Test",
+			},
+			Projection: projection,
+			Features:   features,
+			Values:     map[string]float64{"Alpha": 1},
+		}
+		for _, rp := range []RendererProvider{PNG, SVG} {
+			b := bytes.NewBuffer([]byte{})
+			testutil.AssertNil(t, cc.Render(rp, b))
+			testutil.AssertNotZero(t, b.Len())
+		}
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, ChoroplethChart{}.Render(PNG, b))
+}
+
+func TestChoroplethChartGetValueRange(t *testing.T) {
+	// replaced new assertions helper
+
+	cc := ChoroplethChart{
+		Features: []GeoFeature{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+		Values:   map[string]float64{"a": 3, "c": -1, "d": 100},
+	}
+	min, max, ok := cc.getValueRange()
+	testutil.AssertTrue(t, ok)
+	testutil.AssertEqual(t, -1.0, min)
+	testutil.AssertEqual(t, 3.0, max)
+
+	_, _, ok = ChoroplethChart{Features: cc.Features}.getValueRange()
+	testutil.AssertFalse(t, ok)
+}
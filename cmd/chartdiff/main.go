@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/dashboard"
+)
+
+var (
+	outputPath = flag.String("output", "", "The path to write the diff image to (optional)")
+	threshold  = flag.Float64("threshold", 0.0, "The fraction of changed pixels [0,1] above which the tool exits non-zero")
+	tolerance  = flag.Int("tolerance", 0, "The per-channel difference [0,255] up to which pixels are considered equal")
+
+	width  = flag.Int("width", chart.DefaultChartWidth, "The width of charts rendered from csv inputs")
+	height = flag.Int("height", chart.DefaultChartHeight, "The height of charts rendered from csv inputs")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %s [flags] <golden> <actual>\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "Each input is either a .png image, a .json dashboard spec rendered as a png,")
+	fmt.Fprintln(os.Stderr, "or a csv file of values rendered as a line chart.")
+	fmt.Fprintln(os.Stderr)
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	log := chart.NewLogger()
+
+	if len(flag.Args()) != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := validateFlags(*tolerance, *threshold); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	golden, err := loadImage(flag.Args()[0])
+	if err != nil {
+		log.FatalErr(err)
+	}
+	actual, err := loadImage(flag.Args()[1])
+	if err != nil {
+		log.FatalErr(err)
+	}
+
+	result := diffImages(golden, actual, uint8(*tolerance))
+
+	if *outputPath != "" {
+		if err := writeImage(*outputPath, result.Image); err != nil {
+			log.FatalErr(err)
+		}
+	}
+
+	fmt.Fprintf(os.Stdout, "changed pixels: %d / %d (%0.4f%%)\n", result.Changed, result.Total, result.Ratio()*100.0)
+	if result.Changed > 0 {
+		fmt.Fprintf(os.Stdout, "changed region: %v\n", result.Region)
+	}
+	os.Exit(exitCode(result, *threshold))
+}
+
+// validateFlags checks that the tolerance and threshold are within their ranges.
+func validateFlags(tolerance int, threshold float64) error {
+	if tolerance < 0 || tolerance > 255 {
+		return fmt.Errorf("invalid tolerance %d; must be within [0,255]", tolerance)
+	}
+	if threshold < 0 || threshold > 1 {
+		return fmt.Errorf("invalid threshold %v; must be within [0,1]", threshold)
+	}
+	return nil
+}
+
+// exitCode returns 1 if the fraction of changed pixels is above the threshold, and 0 otherwise.
+func exitCode(result diffResult, threshold float64) int {
+	if result.Ratio() > threshold {
+		return 1
+	}
+	return 0
+}
+
+// writeImage writes the diff image as a png; the file is closed before returning so that
+// errors flushing it are reported.
+func writeImage(path string, img image.Image) error {
+	output, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(output, img); err != nil {
+		output.Close()
+		return err
+	}
+	return output.Close()
+}
+
+// loadImage decodes a png, renders a json dashboard spec, or renders a csv of values as a basic line chart.
+func loadImage(path string) (image.Image, error) {
+	if strings.EqualFold(filepath.Ext(path), ".png") {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return png.Decode(f)
+	}
+
+	rawData, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		spec, err := dashboard.Parse(rawData)
+		if err != nil {
+			return nil, err
+		}
+		buffer := bytes.NewBuffer(nil)
+		if err := dashboard.Render(spec, dashboard.FormatPNG, buffer); err != nil {
+			return nil, err
+		}
+		return png.Decode(buffer)
+	}
+	yvalues, err := chart.ParseFloats(chart.SplitCSV(strings.Replace(string(rawData), "\n", ",", -1))...)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := chart.Chart{
+		Width:  *width,
+		Height: *height,
+		Series: []chart.Series{
+			chart.ContinuousSeries{
+				XValues: chart.LinearRange(1, float64(len(yvalues))),
+				YValues: yvalues,
+			},
+		},
+	}
+
+	collector := &chart.ImageWriter{}
+	if err := graph.Render(chart.PNG, collector); err != nil {
+		return nil, err
+	}
+	return collector.Image()
+}
+
+// diffResult is the outcome of comparing two images.
+type diffResult struct {
+	Image   *image.RGBA
+	Region  image.Rectangle
+	Changed int
+	Total   int
+}
+
+// Ratio returns the fraction of pixels that changed.
+func (dr diffResult) Ratio() float64 {
+	if dr.Total == 0 {
+		return 0
+	}
+	return float64(dr.Changed) / float64(dr.Total)
+}
+
+// diffImages compares two images pixel by pixel; changed pixels are drawn red over a
+// faded copy of the golden image. Pixels outside of either image's bounds count as changed.
+func diffImages(golden, actual image.Image, tolerance uint8) diffResult {
+	bounds := golden.Bounds().Union(actual.Bounds())
+	result := diffResult{
+		Image: image.NewRGBA(bounds),
+		Total: bounds.Dx() * bounds.Dy(),
+	}
+
+	highlight := color.RGBA{R: 255, A: 255}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			p := image.Pt(x, y)
+			if !p.In(golden.Bounds()) || !p.In(actual.Bounds()) || !pixelsEqual(golden.At(x, y), actual.At(x, y), tolerance) {
+				result.Image.Set(x, y, highlight)
+				if result.Changed == 0 {
+					result.Region = image.Rect(x, y, x+1, y+1)
+				} else {
+					result.Region = result.Region.Union(image.Rect(x, y, x+1, y+1))
+				}
+				result.Changed++
+				continue
+			}
+			gray := color.GrayModel.Convert(golden.At(x, y)).(color.Gray)
+			faded := 255 - ((255 - gray.Y) >> 2)
+			result.Image.Set(x, y, color.RGBA{R: faded, G: faded, B: faded, A: 255})
+		}
+	}
+	return result
+}
+
+func pixelsEqual(a, b color.Color, tolerance uint8) bool {
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+	return channelEqual(ar, br, tolerance) &&
+		channelEqual(ag, bg, tolerance) &&
+		channelEqual(ab, bb, tolerance) &&
+		channelEqual(aa, ba, tolerance)
+}
+
+func channelEqual(a, b uint32, tolerance uint8) bool {
+	a, b = a>>8, b>>8
+	if a > b {
+		return a-b <= uint32(tolerance)
+	}
+	return b-a <= uint32(tolerance)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testDiffImage(width, height int, c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestValidateFlags(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNil(t, validateFlags(0, 0))
+	testutil.AssertNil(t, validateFlags(255, 1))
+	testutil.AssertNotNil(t, validateFlags(-1, 0))
+	testutil.AssertNotNil(t, validateFlags(256, 0))
+	testutil.AssertNotNil(t, validateFlags(0, -0.1))
+	testutil.AssertNotNil(t, validateFlags(0, 1.5))
+}
+
+func TestPixelsEqual(t *testing.T) {
+	// replaced new assertions helper
+
+	a := color.RGBA{R: 100, G: 100, B: 100, A: 255}
+	b := color.RGBA{R: 104, G: 100, B: 100, A: 255}
+	testutil.AssertTrue(t, pixelsEqual(a, a, 0))
+	testutil.AssertFalse(t, pixelsEqual(a, b, 0))
+	testutil.AssertFalse(t, pixelsEqual(a, b, 3))
+	testutil.AssertTrue(t, pixelsEqual(a, b, 4))
+	testutil.AssertTrue(t, pixelsEqual(b, a, 4))
+}
+
+func TestDiffImages(t *testing.T) {
+	// replaced new assertions helper
+
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	golden := testDiffImage(10, 10, white)
+
+	result := diffImages(golden, testDiffImage(10, 10, white), 0)
+	testutil.AssertEqual(t, 0, result.Changed)
+	testutil.AssertEqual(t, 100, result.Total)
+	testutil.AssertEqual(t, 0, exitCode(result, 0))
+
+	actual := testDiffImage(10, 10, white)
+	actual.Set(2, 3, color.RGBA{A: 255})
+	actual.Set(5, 7, color.RGBA{A: 255})
+	result = diffImages(golden, actual, 0)
+	testutil.AssertEqual(t, 2, result.Changed)
+	testutil.AssertEqual(t, image.Rect(2, 3, 6, 8), result.Region)
+	testutil.AssertEqual(t, color.RGBA{R: 255, A: 255}, result.Image.At(2, 3))
+	testutil.AssertEqual(t, 1, exitCode(result, 0))
+	testutil.AssertEqual(t, 1, exitCode(result, 0.01))
+	testutil.AssertEqual(t, 0, exitCode(result, 0.02))
+
+	// pixels outside of either image count as changed.
+	result = diffImages(golden, testDiffImage(10, 5, white), 0)
+	testutil.AssertEqual(t, 50, result.Changed)
+	testutil.AssertEqual(t, image.Rect(0, 5, 10, 10), result.Region)
+}
+
+func TestWriteImage(t *testing.T) {
+	// replaced new assertions helper
+
+	img := testDiffImage(10, 10, color.RGBA{R: 255, A: 255})
+	outputPath := filepath.Join(t.TempDir(), "diff.png")
+	testutil.AssertNil(t, writeImage(outputPath, img))
+	written, err := loadImage(outputPath)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, 0, diffImages(img, written, 0).Changed)
+}
+
+func TestLoadImageSpec(t *testing.T) {
+	// replaced new assertions helper
+
+	specPath := filepath.Join(t.TempDir(), "spec.json")
+	testutil.AssertNil(t, ioutil.WriteFile(specPath, []byte(`{
+		"width": 400,
+		"height": 300,
+		"charts": [{"series": [{"source": {"adapter": "values", "params": {"y": [1, 3, 2, 5]}}}]}]
+	}`), 0644))
+
+	img, err := loadImage(specPath)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, image.Rect(0, 0, 400, 300), img.Bounds())
+}
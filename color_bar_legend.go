@@ -0,0 +1,39 @@
+package chart
+
+import "math"
+
+// colorBarLegendWidth returns the width of a color bar legend for a range of values, with its labels.
+func colorBarLegendWidth(r Renderer, min, max float64, vf ValueFormatter, style Style) int {
+	var labelWidth int
+	for _, value := range []float64{min, max} {
+		labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, vf(value), style).Width())
+	}
+	return DefaultColorBarWidth + DefaultColorBarGap + labelWidth
+}
+
+// colorBarLegendBox returns the box of a color bar legend to the right of a box, centered vertically
+// and half as tall.
+func colorBarLegendBox(b Box) Box {
+	height := b.Height() >> 1
+	return Box{
+		Top:    b.Top + (b.Height()-height)>>1,
+		Left:   b.Right + DefaultColorBarGap,
+		Right:  b.Right + DefaultColorBarGap + DefaultColorBarWidth,
+		Bottom: b.Top + (b.Height()+height)>>1,
+	}
+}
+
+// drawColorBarLegend draws a color bar with the min, middle and max values beside it.
+func drawColorBarLegend(r Renderer, b Box, colorMap ColorMap, min, max float64, vf ValueFormatter, style Style) {
+	Draw.ColorBar(r, b, colorMap, min, max, style)
+
+	for _, value := range []float64{min, (min + max) / 2, max} {
+		y := b.Bottom
+		if max > min {
+			y -= int(math.Round(float64(b.Height()) * (value - min) / (max - min)))
+		}
+		label := vf(value)
+		tb := Draw.MeasureText(r, label, style)
+		Draw.Text(r, label, b.Right+DefaultColorBarGap, y+tb.Height()>>1, style)
+	}
+}
@@ -33,7 +33,8 @@ var (
 	// ColorAlternateLightGray is a alternate theme color.
 	ColorAlternateLightGray = drawing.Color{R: 187, G: 190, B: 191, A: 255}
 
-	// ColorTransparent is a transparent (alpha zero) color.
+	// ColorTransparent is a transparent (alpha zero) color. It is not the zero color, so that styles
+	// set to it, e.g. backgrounds and canvases, are transparent rather than unset and inheriting their defaults.
 	ColorTransparent = drawing.Color{R: 1, G: 1, B: 1, A: 0}
 )
 
@@ -66,6 +67,21 @@ var (
 	DefaultAnnotationFillColor = ColorWhite
 	// DefaultGridLineColor is the default grid line color.
 	DefaultGridLineColor = ColorLightGray
+	// DefaultMinorGridLineColor is the default color of the grid lines at minor ticks, lighter than the major ones.
+	DefaultMinorGridLineColor = ColorLightGray.WithAlpha(128)
+	// DefaultCandlestickUpColor is the default color of candles that close above their open.
+	DefaultCandlestickUpColor = ColorAlternateGreen
+	// DefaultCandlestickDownColor is the default color of candles that close below their open.
+	DefaultCandlestickDownColor = ColorRed
+	// DefaultCalendarHeatMapEmptyColor is the default color of the days without a value in a calendar heat map.
+	// It is equivalent to #ebedf0.
+	DefaultCalendarHeatMapEmptyColor = drawing.Color{R: 0xeb, G: 0xed, B: 0xf0, A: 255}
+	// DefaultVariancePositiveColor is the default color of the variance labels of periods at or over target.
+	DefaultVariancePositiveColor = ColorAlternateGreen
+	// DefaultVarianceNegativeColor is the default color of the variance labels of periods under target.
+	DefaultVarianceNegativeColor = ColorRed
+	// DefaultComparisonBaselineColor is the default color of the baseline series of a comparison chart.
+	DefaultComparisonBaselineColor = ColorAlternateGray
 )
 
 var (
@@ -0,0 +1,172 @@
+package chart
+
+import (
+	"errors"
+	"io"
+	"sort"
+)
+
+// ComparisonChart overlays a baseline and a current version of the same series, e.g. for before and
+// after performance reports, with the baseline dashed and gray under the current series.
+//
+// With `ShowDifference` set, a panel of the current minus the baseline values is stacked below the overlay.
+type ComparisonChart struct {
+	Title string
+	// Width and Height are the size of the whole chart, including the difference panel.
+	Width  int
+	Height int
+	DPI    float64
+
+	XAxis XAxis
+	YAxis YAxis
+
+	BaselineName  string
+	BaselineStyle Style
+	CurrentName   string
+	CurrentStyle  Style
+
+	// ShowDifference adds a panel of the difference of the current from the baseline values, below the overlay.
+	ShowDifference  bool
+	DifferenceStyle Style
+
+	Baseline ValuesProvider
+	Current  ValuesProvider
+}
+
+// GetBaselineName returns the baseline name or the default.
+func (cc ComparisonChart) GetBaselineName() string {
+	if len(cc.BaselineName) == 0 {
+		return "Baseline"
+	}
+	return cc.BaselineName
+}
+
+// GetCurrentName returns the current name or the default.
+func (cc ComparisonChart) GetCurrentName() string {
+	if len(cc.CurrentName) == 0 {
+		return "Current"
+	}
+	return cc.CurrentName
+}
+
+// GetBaselineSeries returns the baseline values as a series, styled dashed and gray by default.
+func (cc ComparisonChart) GetBaselineSeries() Series {
+	return comparisonSeries(cc.Baseline, cc.GetBaselineName(), cc.BaselineStyle.InheritFrom(Style{
+		StrokeColor:     DefaultComparisonBaselineColor,
+		StrokeWidth:     DefaultStrokeWidth,
+		StrokeDashArray: []float64{5, 5},
+	}))
+}
+
+// GetCurrentSeries returns the current values as a series, styled with the first default series color.
+func (cc ComparisonChart) GetCurrentSeries() Series {
+	return comparisonSeries(cc.Current, cc.GetCurrentName(), cc.CurrentStyle.InheritFrom(Style{
+		StrokeColor: GetDefaultColor(0),
+		StrokeWidth: DefaultStrokeWidth,
+	}))
+}
+
+// comparisonSeries returns the values as a named and styled series; time series are kept as time
+// series so that the chart has time ticks.
+func comparisonSeries(values ValuesProvider, name string, style Style) Series {
+	if ts, isTimeSeries := values.(TimeSeries); isTimeSeries {
+		ts.Name, ts.Style = name, style
+		return ts
+	}
+	series := valuesToContinuousSeries(values)
+	series.Name, series.Style = name, style
+	return series
+}
+
+// GetDifferenceSeries returns the current minus the baseline values at the x values of the current series,
+// interpolating the baseline between its own x values; current values outside of the x range of the baseline
+// are left out.
+func (cc ComparisonChart) GetDifferenceSeries() ContinuousSeries {
+	baseline := valuesToContinuousSeries(cc.Baseline)
+	current := valuesToContinuousSeries(cc.Current)
+	difference := ContinuousSeries{
+		Name:            "Difference",
+		XValueFormatter: current.XValueFormatter,
+		YValueFormatter: current.YValueFormatter,
+	}
+	for index, x := range current.XValues {
+		if y, ok := interpolateContinuousSeries(baseline, x); ok {
+			difference.XValues = append(difference.XValues, x)
+			difference.YValues = append(difference.YValues, current.YValues[index]-y)
+		}
+	}
+	return difference
+}
+
+// interpolateContinuousSeries returns the y value of a series at an x value, interpolating linearly between
+// its values, which must be in x order, and if the x value is within the x range of the series.
+func interpolateContinuousSeries(cs ContinuousSeries, x float64) (float64, bool) {
+	index := sort.SearchFloat64s(cs.XValues, x)
+	if index == len(cs.XValues) {
+		return 0, false
+	}
+	if cs.XValues[index] == x {
+		return cs.YValues[index], true
+	}
+	if index == 0 {
+		return 0, false
+	}
+	x0, x1 := cs.XValues[index-1], cs.XValues[index]
+	y0, y1 := cs.YValues[index-1], cs.YValues[index]
+	return y0 + (y1-y0)*(x-x0)/(x1-x0), true
+}
+
+// GetChart returns the overlay of the baseline and current series, with a legend.
+func (cc ComparisonChart) GetChart() Chart {
+	c := Chart{
+		Title:  cc.Title,
+		Width:  cc.Width,
+		Height: cc.Height,
+		DPI:    cc.DPI,
+		XAxis:  cc.XAxis,
+		YAxis:  cc.YAxis,
+		YAxisSecondary: YAxis{
+			Style: Hidden(),
+		},
+		Series: []Series{
+			cc.GetBaselineSeries(),
+			cc.GetCurrentSeries(),
+		},
+	}
+	if len(cc.Title) > 0 {
+		c.Background.Padding = DefaultComparisonTitlePadding
+	}
+	c.Elements = []Renderable{Legend(&c)}
+	return c
+}
+
+// GetStack returns the overlay with the difference panel below it, as an indicator stack.
+func (cc ComparisonChart) GetStack() ChartStack {
+	stack := NewIndicatorStack(cc.GetChart(), IndicatorPanel{
+		Name: "Difference",
+		Series: []Series{
+			HistogramSeries{
+				Name: "Difference",
+				Style: cc.DifferenceStyle.InheritFrom(Style{
+					StrokeColor: GetDefaultColor(0),
+					FillColor:   GetDefaultColor(0).WithAlpha(128),
+					StrokeWidth: DefaultStrokeWidth,
+				}),
+				InnerSeries: cc.GetDifferenceSeries(),
+			},
+		},
+	})
+	stack.Width, stack.Height, stack.DPI = cc.Width, cc.Height, cc.DPI
+	return stack
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (cc ComparisonChart) Render(rp RendererProvider, w io.Writer) error {
+	if cc.Baseline == nil || cc.Current == nil {
+		return newRenderError(RenderStageValidate, errors.New("comparison chart requires a baseline and a current series"))
+	}
+	if cc.ShowDifference {
+		return cc.GetStack().Render(rp, w)
+	}
+	return cc.GetChart().Render(rp, w)
+}
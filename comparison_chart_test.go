@@ -0,0 +1,59 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestComparisonChartSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	cc := ComparisonChart{
+		Baseline: ContinuousSeries{XValues: []float64{0, 2, 4}, YValues: []float64{10, 20, 30}},
+		Current:  ContinuousSeries{XValues: []float64{1, 2, 3, 5}, YValues: []float64{20, 20, 20, 20}},
+	}
+
+	baseline := cc.GetBaselineSeries().(ContinuousSeries)
+	testutil.AssertEqual(t, "Baseline", baseline.Name)
+	testutil.AssertEqual(t, DefaultComparisonBaselineColor, baseline.Style.StrokeColor)
+	testutil.AssertNotEmpty(t, baseline.Style.StrokeDashArray)
+	testutil.AssertEqual(t, "Current", cc.GetCurrentSeries().GetName())
+
+	// the baseline is interpolated at the current x values, and the last value is past its end.
+	difference := cc.GetDifferenceSeries()
+	testutil.AssertEqual(t, []float64{1, 2, 3}, difference.XValues)
+	testutil.AssertEqual(t, []float64{5, 0, -5}, difference.YValues)
+
+	cc.BaselineStyle = Style{StrokeColor: ColorRed}
+	testutil.AssertEqual(t, ColorRed, cc.GetBaselineSeries().GetStyle().StrokeColor)
+}
+
+func TestComparisonChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	x := []time.Time{start, start.Add(time.Hour), start.Add(2 * time.Hour)}
+	cc := ComparisonChart{
+		Title:    "Latency",
+		Baseline: TimeSeries{XValues: x, YValues: []float64{10, 12, 11}},
+		Current:  TimeSeries{XValues: x, YValues: []float64{9, 10, 12}},
+	}
+	_, isTimeSeries := cc.GetCurrentSeries().(TimeSeries)
+	testutil.AssertTrue(t, isTimeSeries)
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, cc.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+
+	cc.ShowDifference = true
+	stack := cc.GetStack()
+	testutil.AssertLen(t, stack.Charts, 2)
+	testutil.AssertEqual(t, "Difference", stack.Charts[1].YAxis.Name)
+	buffer.Reset()
+	testutil.AssertNil(t, cc.Render(PNG, buffer))
+
+	testutil.AssertNotNil(t, ComparisonChart{}.Render(PNG, buffer))
+}
@@ -60,6 +60,11 @@ func (r *ContinuousRange) SetDomain(domain int) {
 	r.Domain = domain
 }
 
+// Clone returns a copy of the range.
+func (r ContinuousRange) Clone() Range {
+	return &r
+}
+
 // String returns a simple string for the ContinuousRange.
 func (r ContinuousRange) String() string {
 	if r.GetDelta() == 0 {
@@ -70,6 +75,9 @@ func (r ContinuousRange) String() string {
 
 // Translate maps a given value into the ContinuousRange space.
 func (r ContinuousRange) Translate(value float64) int {
+	if r.GetDelta() == 0 {
+		return 0
+	}
 	normalized := value - r.Min
 	ratio := normalized / r.GetDelta()
 
@@ -44,12 +44,18 @@ func (cs ContinuousSeries) GetValues(index int) (float64, float64) {
 }
 
 // GetFirstValues gets the first x,y values.
-func (cs ContinuousSeries) GetFirstValues() (float64, float64) {
+func (cs ContinuousSeries) GetFirstValues() (x, y float64) {
+	if len(cs.XValues) == 0 || len(cs.YValues) == 0 {
+		return
+	}
 	return cs.XValues[0], cs.YValues[0]
 }
 
 // GetLastValues gets the last x,y values.
-func (cs ContinuousSeries) GetLastValues() (float64, float64) {
+func (cs ContinuousSeries) GetLastValues() (x, y float64) {
+	if len(cs.XValues) == 0 || len(cs.YValues) == 0 {
+		return
+	}
 	return cs.XValues[len(cs.XValues)-1], cs.YValues[len(cs.YValues)-1]
 }
 
@@ -94,3 +100,10 @@ func (cs ContinuousSeries) Validate() error {
 	}
 	return nil
 }
+
+// CopySeries returns a copy of the series that does not share its values with the original.
+func (cs ContinuousSeries) CopySeries() Series {
+	cs.XValues = append([]float64(nil), cs.XValues...)
+	cs.YValues = append([]float64(nil), cs.YValues...)
+	return cs
+}
@@ -0,0 +1,497 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// ContourChart is a chart that draws a grid of values, `Z[row][column]`, as contour lines, the lines along
+// which the grid takes each of a set of levels, computed by marching squares. Filled, it also colors the
+// bands between the levels through a color map.
+//
+// The columns are spread along the x axis, at `XValues` if set, and the rows up the y axis, from the bottom,
+// at `YValues` if set; cells with a NaN corner are left out.
+type ContourChart struct {
+	ChartFrame
+
+	ContourStyle Style
+	LabelStyle   Style
+	LegendStyle  Style
+
+	// Levels are the values to draw contours at; if unset they are up to `LevelCount` round values within the grid.
+	Levels     []float64
+	LevelCount int
+	// Filled colors the bands between the levels, as well as drawing the contour lines.
+	Filled bool
+
+	// ColorMap maps the levels to colors, it defaults to `Viridis`.
+	ColorMap       ColorMap
+	ValueFormatter ValueFormatter
+
+	XValues  []float64
+	YValues  []float64
+	Z        [][]float64
+	Elements []Renderable
+}
+
+// ContourLine is a contour line, as points in grid coordinates, i.e. fractional columns and rows.
+type ContourLine struct {
+	Level  float64
+	Points [][2]float64
+}
+
+// GetLevelCount returns the most levels to choose or the default.
+func (cc ContourChart) GetLevelCount() int {
+	if cc.LevelCount == 0 {
+		return DefaultContourLevelCount
+	}
+	return cc.LevelCount
+}
+
+// GetColorMap returns the color map or the default.
+func (cc ContourChart) GetColorMap() ColorMap {
+	if cc.ColorMap == nil {
+		return Viridis
+	}
+	return cc.ColorMap
+}
+
+// GetValueFormatter returns the value formatter or the default.
+func (cc ContourChart) GetValueFormatter() ValueFormatter {
+	if cc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return cc.ValueFormatter
+}
+
+// GetLevels returns the levels, sorted, or round values strictly within the range of the grid.
+func (cc ContourChart) GetLevels() []float64 {
+	if len(cc.Levels) > 0 {
+		levels := append([]float64(nil), cc.Levels...)
+		sort.Float64s(levels)
+		return levels
+	}
+	min, max := cc.getZRange()
+	if max <= min {
+		return nil
+	}
+	var levels []float64
+	for _, t := range (NiceTickGenerator{Count: cc.GetLevelCount()}).GenerateTicks(nil, &ContinuousRange{Min: min, Max: max}, false, Style{}, nil) {
+		if t.Value > min && t.Value < max {
+			levels = append(levels, t.Value)
+		}
+	}
+	return levels
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (cc ContourChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := cc.validate(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := cc.GetWidth(), cc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if cc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		cc.defaultFont = defaultFont
+	}
+	r.SetDPI(cc.GetDPI(DefaultDPI))
+
+	canvasBox := cc.getDefaultCanvasBox(r, width, height)
+	plotBox, legendBox := cc.getLayout(r, canvasBox)
+
+	cc.drawBackground(r, width, height)
+	cc.drawCanvas(r, canvasBox)
+	if cc.Filled {
+		cc.drawBands(r, plotBox)
+	}
+	lines := cc.GetContourLines()
+	cc.drawContours(r, plotBox, lines)
+	cc.drawLabels(r, plotBox, lines)
+	if !cc.LegendStyle.Hidden {
+		min, max := cc.getZRange()
+		drawColorBarLegend(r, legendBox, cc.GetColorMap(), min, max, cc.GetValueFormatter(), cc.styleDefaultsLegend())
+	}
+	cc.drawTitle(r, width, height)
+	for _, a := range cc.Elements {
+		a(r, canvasBox, cc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (cc ContourChart) validate() error {
+	if len(cc.Z) < 2 || len(cc.Z[0]) < 2 {
+		return errors.New("contour chart must have at least (2) rows and (2) columns")
+	}
+	var hasValue bool
+	for _, row := range cc.Z {
+		if len(row) != len(cc.Z[0]) {
+			return errors.New("contour chart rows must have the same number of values")
+		}
+		for _, z := range row {
+			hasValue = hasValue || !math.IsNaN(z)
+		}
+	}
+	if !hasValue {
+		return errors.New("contour chart must contain at least (1) value that is not NaN")
+	}
+	if len(cc.XValues) > 0 && len(cc.XValues) != len(cc.Z[0]) {
+		return fmt.Errorf("contour chart has %d x values for %d columns", len(cc.XValues), len(cc.Z[0]))
+	}
+	if len(cc.YValues) > 0 && len(cc.YValues) != len(cc.Z) {
+		return fmt.Errorf("contour chart has %d y values for %d rows", len(cc.YValues), len(cc.Z))
+	}
+	for _, values := range [][]float64{cc.XValues, cc.YValues} {
+		for index := 1; index < len(values); index++ {
+			if values[index] <= values[index-1] {
+				return errors.New("contour chart x and y values must be increasing")
+			}
+		}
+	}
+	return nil
+}
+
+// getZRange returns the min and max values.
+func (cc ContourChart) getZRange() (min, max float64) {
+	min, max = math.MaxFloat64, -math.MaxFloat64
+	for _, row := range cc.Z {
+		for _, z := range row {
+			if !math.IsNaN(z) {
+				min, max = math.Min(min, z), math.Max(max, z)
+			}
+		}
+	}
+	return
+}
+
+// getLayout splits the canvas into the plot, and the color bar and its labels to the right of it.
+func (cc ContourChart) getLayout(r Renderer, canvasBox Box) (plotBox, legendBox Box) {
+	plotBox = canvasBox
+	if cc.LegendStyle.Hidden {
+		return
+	}
+	min, max := cc.getZRange()
+	plotBox.Right -= DefaultColorBarGap + colorBarLegendWidth(r, min, max, cc.GetValueFormatter(), cc.styleDefaultsLegend())
+	legendBox = colorBarLegendBox(plotBox)
+	return
+}
+
+// GetContourLines returns the contour lines of each level, traced by marching squares and joined
+// into lines across the cells of the grid.
+func (cc ContourChart) GetContourLines() []ContourLine {
+	var lines []ContourLine
+	for _, level := range cc.GetLevels() {
+		for _, points := range joinContourSegments(cc.getContourSegments(level)) {
+			lines = append(lines, ContourLine{Level: level, Points: points})
+		}
+	}
+	return lines
+}
+
+// contourEdgePoint returns where a level crosses an edge of the grid, from a row and column along the
+// columns, or up the rows if vertical. The point depends only on the edge, so that the cells either side
+// of it agree.
+func (cc ContourChart) contourEdgePoint(row, column int, vertical bool, level float64) [2]float64 {
+	z0 := cc.Z[row][column]
+	if vertical {
+		return [2]float64{float64(column), float64(row) + (level-z0)/(cc.Z[row+1][column]-z0)}
+	}
+	return [2]float64{float64(column) + (level-z0)/(cc.Z[row][column+1]-z0), float64(row)}
+}
+
+// getContourSegments returns the segments of the contour of a level within each cell of the grid.
+func (cc ContourChart) getContourSegments(level float64) (segments [][2][2]float64) {
+	for row := 0; row < len(cc.Z)-1; row++ {
+		for column := 0; column < len(cc.Z[row])-1; column++ {
+			// the corners, counterclockwise from the bottom left, and the edges after each corner.
+			corners := [4]float64{cc.Z[row][column], cc.Z[row][column+1], cc.Z[row+1][column+1], cc.Z[row+1][column]}
+			if math.IsNaN(corners[0]) || math.IsNaN(corners[1]) || math.IsNaN(corners[2]) || math.IsNaN(corners[3]) {
+				continue
+			}
+			edge := func(index int) [2]float64 {
+				switch index {
+				case 0:
+					return cc.contourEdgePoint(row, column, false, level)
+				case 1:
+					return cc.contourEdgePoint(row, column+1, true, level)
+				case 2:
+					return cc.contourEdgePoint(row+1, column, false, level)
+				default:
+					return cc.contourEdgePoint(row, column, true, level)
+				}
+			}
+
+			var crossed []int
+			for index := range corners {
+				if (corners[index] >= level) != (corners[(index+1)%4] >= level) {
+					crossed = append(crossed, index)
+				}
+			}
+			switch len(crossed) {
+			case 2:
+				segments = append(segments, [2][2]float64{edge(crossed[0]), edge(crossed[1])})
+			case 4:
+				// a saddle; the center decides whether the corners above the level, or those below, are joined.
+				center := (corners[0] + corners[1] + corners[2] + corners[3]) / 4
+				if (center >= level) == (corners[0] >= level) {
+					segments = append(segments, [2][2]float64{edge(0), edge(1)}, [2][2]float64{edge(2), edge(3)})
+				} else {
+					segments = append(segments, [2][2]float64{edge(3), edge(0)}, [2][2]float64{edge(1), edge(2)})
+				}
+			}
+		}
+	}
+	return
+}
+
+// joinContourSegments joins segments that share end points into lines.
+func joinContourSegments(segments [][2][2]float64) (lines [][][2]float64) {
+	ends := map[[2]float64][]int{}
+	for index, segment := range segments {
+		ends[segment[0]] = append(ends[segment[0]], index)
+		ends[segment[1]] = append(ends[segment[1]], index)
+	}
+	used := make([]bool, len(segments))
+	next := func(point [2]float64) ([2]float64, bool) {
+		for _, index := range ends[point] {
+			if used[index] {
+				continue
+			}
+			used[index] = true
+			if segments[index][0] == point {
+				return segments[index][1], true
+			}
+			return segments[index][0], true
+		}
+		return point, false
+	}
+
+	for index, segment := range segments {
+		if used[index] {
+			continue
+		}
+		used[index] = true
+		line := [][2]float64{segment[0], segment[1]}
+		for point, ok := next(segment[1]); ok; point, ok = next(point) {
+			line = append(line, point)
+		}
+		var head [][2]float64
+		for point, ok := next(segment[0]); ok; point, ok = next(point) {
+			head = append([][2]float64{point}, head...)
+		}
+		lines = append(lines, append(head, line...))
+	}
+	return
+}
+
+// getBandPolygons returns the parts of each cell of the grid with values between two levels,
+// where either level may be infinite.
+func (cc ContourChart) getBandPolygons(lower, upper float64) (polygons [][][3]float64) {
+	for row := 0; row < len(cc.Z)-1; row++ {
+		for column := 0; column < len(cc.Z[row])-1; column++ {
+			polygon := [][3]float64{
+				{float64(column), float64(row), cc.Z[row][column]},
+				{float64(column + 1), float64(row), cc.Z[row][column+1]},
+				{float64(column + 1), float64(row + 1), cc.Z[row+1][column+1]},
+				{float64(column), float64(row + 1), cc.Z[row+1][column]},
+			}
+			var missing bool
+			for _, p := range polygon {
+				missing = missing || math.IsNaN(p[2])
+			}
+			if missing {
+				continue
+			}
+			if !math.IsInf(lower, -1) {
+				polygon = clipContourPolygon(polygon, func(z float64) bool { return z >= lower }, lower)
+			}
+			if !math.IsInf(upper, 1) {
+				polygon = clipContourPolygon(polygon, func(z float64) bool { return z <= upper }, upper)
+			}
+			if len(polygon) >= 3 {
+				polygons = append(polygons, polygon)
+			}
+		}
+	}
+	return
+}
+
+// clipContourPolygon clips a polygon to where its values are inside a level, interpolating the values
+// linearly along its edges.
+func clipContourPolygon(polygon [][3]float64, inside func(float64) bool, level float64) (clipped [][3]float64) {
+	for index, current := range polygon {
+		previous := polygon[(index+len(polygon)-1)%len(polygon)]
+		if inside(current[2]) != inside(previous[2]) {
+			t := (level - previous[2]) / (current[2] - previous[2])
+			clipped = append(clipped, [3]float64{
+				previous[0] + t*(current[0]-previous[0]),
+				previous[1] + t*(current[1]-previous[1]),
+				level,
+			})
+		}
+		if inside(current[2]) {
+			clipped = append(clipped, current)
+		}
+	}
+	return
+}
+
+// translate returns the canvas point of a point in grid coordinates.
+func (cc ContourChart) translate(plotBox Box, point [2]float64) (x, y int) {
+	columns, rows := len(cc.Z[0]), len(cc.Z)
+	tx := contourAxisFraction(cc.XValues, point[0], columns)
+	ty := contourAxisFraction(cc.YValues, point[1], rows)
+	return plotBox.Left + int(math.Round(tx*float64(plotBox.Width()))),
+		plotBox.Bottom - int(math.Round(ty*float64(plotBox.Height())))
+}
+
+// contourAxisFraction returns how far along an axis, from 0 to 1, a fractional index falls, from the values if set.
+func contourAxisFraction(values []float64, index float64, count int) float64 {
+	if len(values) == 0 {
+		return index / float64(count-1)
+	}
+	lower := MinInt(int(index), count-2)
+	value := values[lower] + (index-float64(lower))*(values[lower+1]-values[lower])
+	return (value - values[0]) / (values[count-1] - values[0])
+}
+
+// drawBands fills the bands below, between and above the levels, each with the color of its middle value.
+func (cc ContourChart) drawBands(r Renderer, plotBox Box) {
+	min, max := cc.getZRange()
+	levels := cc.GetLevels()
+	bounds := append(append([]float64{math.Inf(-1)}, levels...), math.Inf(1))
+	for index := 0; index < len(bounds)-1; index++ {
+		lower, upper := bounds[index], bounds[index+1]
+		color := cc.GetColorMap()((math.Max(lower, min)+math.Min(upper, max))/2, min, max)
+		// a stroke of the fill color covers the seams between the cells.
+		style := Style{FillColor: color, StrokeColor: color, StrokeWidth: DefaultStrokeWidth}
+		style.GetFillAndStrokeOptions().WriteToRenderer(r)
+		for _, polygon := range cc.getBandPolygons(lower, upper) {
+			for pointIndex, p := range polygon {
+				x, y := cc.translate(plotBox, [2]float64{p[0], p[1]})
+				if pointIndex == 0 {
+					r.MoveTo(x, y)
+				} else {
+					r.LineTo(x, y)
+				}
+			}
+			r.Close()
+			r.FillStroke()
+		}
+	}
+}
+
+// drawContours draws the contour lines, colored by level unless filled.
+func (cc ContourChart) drawContours(r Renderer, plotBox Box, lines []ContourLine) {
+	if cc.ContourStyle.Hidden {
+		return
+	}
+	min, max := cc.getZRange()
+	for _, line := range lines {
+		style := cc.styleDefaultsContour(cc.GetColorMap()(line.Level, min, max))
+		style.GetStrokeOptions().WriteToRenderer(r)
+		for index, point := range line.Points {
+			x, y := cc.translate(plotBox, point)
+			if index == 0 {
+				r.MoveTo(x, y)
+			} else {
+				r.LineTo(x, y)
+			}
+		}
+		r.Stroke()
+	}
+}
+
+// drawLabels draws the level of each contour line long enough to hold it at the middle of the line,
+// over a patch of the canvas color.
+func (cc ContourChart) drawLabels(r Renderer, plotBox Box, lines []ContourLine) {
+	if cc.LabelStyle.Hidden || cc.ContourStyle.Hidden {
+		return
+	}
+	style := cc.styleDefaultsLabels()
+	for _, line := range lines {
+		label := cc.GetValueFormatter()(line.Level)
+		tb := Draw.MeasureText(r, label, style)
+
+		points := make([][2]float64, len(line.Points))
+		var length float64
+		for index, point := range line.Points {
+			x, y := cc.translate(plotBox, point)
+			points[index] = [2]float64{float64(x), float64(y)}
+			if index > 0 {
+				length += math.Hypot(points[index][0]-points[index-1][0], points[index][1]-points[index-1][1])
+			}
+		}
+		if length < float64(DefaultContourLabelSpan*tb.Width()) {
+			continue
+		}
+
+		x, y := contourLineMidpoint(points, length)
+		box := Box{
+			Top:    y - tb.Height()>>1 - DefaultContourLabelPadding,
+			Left:   x - tb.Width()>>1 - DefaultContourLabelPadding,
+			Right:  x + tb.Width() - tb.Width()>>1 + DefaultContourLabelPadding,
+			Bottom: y + tb.Height() - tb.Height()>>1 + DefaultContourLabelPadding,
+		}
+		Draw.Box(r, box, Style{FillColor: cc.GetColorPalette().CanvasColor(), StrokeColor: ColorTransparent, StrokeWidth: DefaultStrokeWidth})
+		Draw.Text(r, label, x-tb.Width()>>1, y+tb.Height()>>1, style)
+	}
+}
+
+// contourLineMidpoint returns the point half way along a line of a given length.
+func contourLineMidpoint(points [][2]float64, length float64) (x, y int) {
+	remaining := length / 2
+	for index := 1; index < len(points); index++ {
+		segment := math.Hypot(points[index][0]-points[index-1][0], points[index][1]-points[index-1][1])
+		if segment >= remaining && segment > 0 {
+			t := remaining / segment
+			return int(points[index-1][0] + t*(points[index][0]-points[index-1][0])),
+				int(points[index-1][1] + t*(points[index][1]-points[index-1][1]))
+		}
+		remaining -= segment
+	}
+	last := points[len(points)-1]
+	return int(last[0]), int(last[1])
+}
+
+func (cc ContourChart) styleDefaultsContour(levelColor drawing.Color) Style {
+	if cc.Filled {
+		levelColor = ColorBlack.WithAlpha(128)
+	}
+	return cc.ContourStyle.InheritFrom(Style{
+		StrokeColor: levelColor,
+		StrokeWidth: DefaultAxisLineWidth,
+	})
+}
+
+func (cc ContourChart) styleDefaultsLabels() Style {
+	return cc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: cc.GetColorPalette().TextColor(),
+		Font:      cc.GetFont(),
+	})
+}
+
+func (cc ContourChart) styleDefaultsLegend() Style {
+	return cc.LegendStyle.InheritFrom(Style{
+		FontSize:    DefaultFontSize,
+		FontColor:   cc.GetColorPalette().TextColor(),
+		Font:        cc.GetFont(),
+		StrokeColor: cc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
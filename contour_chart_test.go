@@ -0,0 +1,100 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestContourChartLevels(t *testing.T) {
+	// replaced new assertions helper
+
+	cc := ContourChart{Z: [][]float64{{0, 1}, {2, 10}}}
+	testutil.AssertEqual(t, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}, cc.GetLevels())
+	cc.LevelCount = 4
+	testutil.AssertEqual(t, []float64{5}, cc.GetLevels())
+
+	cc.Levels = []float64{5, 1}
+	testutil.AssertEqual(t, []float64{1, 5}, cc.GetLevels())
+}
+
+func TestContourChartContourLines(t *testing.T) {
+	// replaced new assertions helper
+
+	// a peak in the middle of the grid is circled by a closed contour.
+	cc := ContourChart{
+		Levels: []float64{1},
+		Z: [][]float64{
+			{0, 0, 0},
+			{0, 2, 0},
+			{0, 0, 0},
+		},
+	}
+	lines := cc.GetContourLines()
+	testutil.AssertLen(t, lines, 1)
+	testutil.AssertEqual(t, 1.0, lines[0].Level)
+	testutil.AssertLen(t, lines[0].Points, 5)
+	testutil.AssertEqual(t, lines[0].Points[0], lines[0].Points[4])
+	for _, point := range lines[0].Points {
+		testutil.AssertEqual(t, 0.5, math.Abs(point[0]-1)+math.Abs(point[1]-1))
+	}
+
+	// a saddle joins the corners below the level when its center is below.
+	cc = ContourChart{Z: [][]float64{{2, 0}, {0, 2}}}
+	segments := cc.getContourSegments(1.5)
+	testutil.AssertEqual(t, [][2][2]float64{{{0, 0.25}, {0.25, 0}}, {{1, 0.75}, {0.75, 1}}}, segments)
+	segments = cc.getContourSegments(0.5)
+	testutil.AssertEqual(t, [][2][2]float64{{{0.75, 0}, {1, 0.25}}, {{0.25, 1}, {0, 0.75}}}, segments)
+
+	cc.Z[0][0] = math.NaN()
+	testutil.AssertEmpty(t, cc.getContourSegments(1.5))
+}
+
+func TestContourChartBandPolygons(t *testing.T) {
+	// replaced new assertions helper
+
+	cc := ContourChart{Z: [][]float64{{0, 2}, {0, 2}}}
+	polygons := cc.getBandPolygons(math.Inf(-1), 1)
+	testutil.AssertLen(t, polygons, 1)
+	testutil.AssertEqual(t, [][3]float64{{0, 0, 0}, {0.5, 0, 1}, {0.5, 1, 1}, {0, 1, 0}}, polygons[0])
+
+	polygons = cc.getBandPolygons(1, math.Inf(1))
+	testutil.AssertEqual(t, [][3]float64{{0.5, 0, 1}, {1, 0, 2}, {1, 1, 2}, {0.5, 1, 1}}, polygons[0])
+
+	testutil.AssertEmpty(t, cc.getBandPolygons(3, 4))
+}
+
+func TestContourChartTranslate(t *testing.T) {
+	// replaced new assertions helper
+
+	cc := ContourChart{XValues: []float64{0, 1, 3}, Z: [][]float64{{0, 0, 0}, {0, 0, 0}}}
+	x, y := cc.translate(Box{Left: 0, Top: 0, Right: 300, Bottom: 100}, [2]float64{1.5, 0})
+	testutil.AssertEqual(t, 200, x)
+	testutil.AssertEqual(t, 100, y)
+	x, y = cc.translate(Box{Left: 0, Top: 0, Right: 300, Bottom: 100}, [2]float64{2, 1})
+	testutil.AssertEqual(t, 300, x)
+	testutil.AssertEqual(t, 0, y)
+}
+
+func TestContourChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, ContourChart{}.Render(PNG, bytes.NewBuffer(nil)))
+	testutil.AssertNotNil(t, ContourChart{Z: [][]float64{{0, 1}, {1, 0}}, XValues: []float64{1, 0}}.validate())
+
+	var z [][]float64
+	for row := 0; row < 10; row++ {
+		var values []float64
+		for column := 0; column < 10; column++ {
+			values = append(values, math.Sin(float64(row)/3)*math.Cos(float64(column)/3))
+		}
+		z = append(z, values)
+	}
+	for _, filled := range []bool{false, true} {
+		buffer := bytes.NewBuffer(nil)
+		testutil.AssertNil(t, ContourChart{ChartFrame: ChartFrame{Title: "Test"}, Z: z, Filled: filled}.Render(PNG, buffer))
+		testutil.AssertNotZero(t, buffer.Len())
+	}
+}
@@ -0,0 +1,38 @@
+package chart
+
+// SeriesCopier is a series that can return a copy of itself that does not share its values with the original.
+type SeriesCopier interface {
+	CopySeries() Series
+}
+
+// CopySeries returns a copy of a series that does not share its values with the original,
+// so that the original values can be modified while the copy is rendered.
+// Series that do not implement `SeriesCopier` are returned as is.
+func CopySeries(s Series) Series {
+	if typed, isTyped := s.(SeriesCopier); isTyped {
+		return typed.CopySeries()
+	}
+	return s
+}
+
+// copyValuesProvider returns a copy of an inner series if it implements `SeriesCopier`.
+func copyValuesProvider(vp ValuesProvider) ValuesProvider {
+	if typed, isTyped := vp.(SeriesCopier); isTyped {
+		if copied, isValuesProvider := typed.CopySeries().(ValuesProvider); isValuesProvider {
+			return copied
+		}
+	}
+	return vp
+}
+
+// copyFloat64Slices returns a copy of a slice of values that does not share any of them with the original.
+func copyFloat64Slices(values [][]float64) [][]float64 {
+	if values == nil {
+		return nil
+	}
+	copied := make([][]float64, len(values))
+	for index, v := range values {
+		copied[index] = append([]float64(nil), v...)
+	}
+	return copied
+}
@@ -0,0 +1,74 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	inner := ContinuousSeries{
+		XValues: []float64{1, 2, 3},
+		YValues: []float64{1, 2, 3},
+	}
+	copied := CopySeries(SMASeries{InnerSeries: inner}).(SMASeries)
+	inner.YValues[0] = 10
+
+	_, y := copied.InnerSeries.GetValues(0)
+	testutil.AssertEqual(t, 1.0, y)
+
+	ema := &EMASeries{InnerSeries: inner}
+	_, y = ema.GetValues(0)
+	testutil.AssertEqual(t, 10.0, y)
+	inner.YValues[0] = 20
+	_, y = CopySeries(ema).(*EMASeries).GetValues(0)
+	testutil.AssertEqual(t, 20.0, y)
+
+	macd := &MACDSeries{}
+	testutil.AssertEqual(t, macd, copyValuesProvider(macd))
+}
+
+func TestCopySeriesInnerSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	inner := ContinuousSeries{
+		XValues: []float64{1, 2, 3},
+		YValues: []float64{1, 2, 3},
+	}
+	pcs := CopySeries(PercentChangeSeries{InnerSeries: inner}).(PercentChangeSeries)
+	macds := CopySeries(&MACDSignalSeries{InnerSeries: inner}).(*MACDSignalSeries)
+	macdl := CopySeries(&MACDLineSeries{InnerSeries: inner}).(*MACDLineSeries)
+	inner.YValues[0] = 10
+	for _, vp := range []ValuesProvider{pcs.InnerSeries, macds.InnerSeries, macdl.InnerSeries} {
+		_, y := vp.GetValues(0)
+		testutil.AssertEqual(t, 1.0, y)
+	}
+
+	xvalues := []float64{1, 2, 3}
+	coefficients := &LinearRegressionSeries{InnerSeries: inner}
+	ls := CopySeries(&LinearSeries{XValues: xvalues, InnerSeries: coefficients}).(*LinearSeries)
+	xvalues[0] = 10
+	testutil.AssertEqual(t, 1.0, ls.XValues[0])
+	testutil.AssertTrue(t, ls.InnerSeries != LinearCoefficientProvider(coefficients))
+}
+
+func TestChartSnapshotSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	series := ContinuousSeries{
+		XValues: []float64{1, 2, 3},
+		YValues: []float64{1, 2, 3},
+	}
+	c := Chart{
+		SnapshotSeries: true,
+		Series:         []Series{series},
+	}
+	snapshot := c.snapshotSeries()
+	series.YValues[2] = 30
+	testutil.AssertEqual(t, 3.0, snapshot[0].(ContinuousSeries).YValues[2])
+	testutil.AssertEqual(t, series, c.Series[0])
+	testutil.AssertNil(t, c.Render(PNG, bytes.NewBuffer(nil)))
+}
@@ -0,0 +1,153 @@
+package dashboard
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// Data is the data of a series as loaded by an adapter.
+type Data struct {
+	// XValues may be left empty, in which case the values are numbered from zero.
+	XValues []float64
+	YValues []float64
+	// Labels optionally label the values, e.g. the bars of a bar chart.
+	Labels []string
+}
+
+// Adapter loads the data of a series from the parameters of its source.
+//
+// Adapters may be called concurrently, for the different series of a dashboard.
+type Adapter func(params json.RawMessage) (Data, error)
+
+var (
+	adaptersLock sync.RWMutex
+	adapters     = map[string]Adapter{
+		"values": ValuesAdapter,
+		"csv":    CSVAdapter,
+	}
+)
+
+// RegisterAdapter registers an adapter under a name for sources to refer to, replacing any
+// adapter already registered under the name.
+func RegisterAdapter(name string, adapter Adapter) {
+	adaptersLock.Lock()
+	defer adaptersLock.Unlock()
+	adapters[name] = adapter
+}
+
+func lookupAdapter(name string) (Adapter, bool) {
+	adaptersLock.RLock()
+	defer adaptersLock.RUnlock()
+	adapter, ok := adapters[name]
+	return adapter, ok
+}
+
+// ValuesAdapter is the "values" adapter, for values written in the spec itself, e.g.
+//
+//	{"x": [1, 2, 3], "y": [4, 5, 6], "labels": ["a", "b", "c"]}
+func ValuesAdapter(params json.RawMessage) (Data, error) {
+	var values struct {
+		X      []float64 `json:"x"`
+		Y      []float64 `json:"y"`
+		Labels []string  `json:"labels"`
+	}
+	if err := json.Unmarshal(params, &values); err != nil {
+		return Data{}, err
+	}
+	return Data{XValues: values.X, YValues: values.Y, Labels: values.Labels}, nil
+}
+
+// CSVAdapter is the "csv" adapter, for the columns of a csv file with a header row, named by their
+// headers, e.g.
+//
+//	{"path": "prices.csv", "x": "date", "y": "close", "timeFormat": "2006-01-02"}
+//
+// The x column is optional. If a time format is set the x values are parsed as times with it, and
+// the chart should set "time". The label column is optional.
+func CSVAdapter(params json.RawMessage) (Data, error) {
+	var options struct {
+		Path       string `json:"path"`
+		X          string `json:"x"`
+		Y          string `json:"y"`
+		Label      string `json:"label"`
+		TimeFormat string `json:"timeFormat"`
+	}
+	if err := json.Unmarshal(params, &options); err != nil {
+		return Data{}, err
+	}
+
+	f, err := os.Open(options.Path)
+	if err != nil {
+		return Data{}, err
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return Data{}, err
+	}
+	if len(records) == 0 {
+		return Data{}, fmt.Errorf("csv file %q is empty", options.Path)
+	}
+
+	column := func(name string) (int, error) {
+		if name == "" {
+			return -1, nil
+		}
+		for index, header := range records[0] {
+			if header == name {
+				return index, nil
+			}
+		}
+		return -1, fmt.Errorf("csv file %q has no column %q", options.Path, name)
+	}
+	xc, err := column(options.X)
+	if err != nil {
+		return Data{}, err
+	}
+	yc, err := column(options.Y)
+	if err != nil {
+		return Data{}, err
+	}
+	if yc < 0 {
+		return Data{}, fmt.Errorf("csv adapter requires a y column")
+	}
+	lc, err := column(options.Label)
+	if err != nil {
+		return Data{}, err
+	}
+
+	var data Data
+	for line, record := range records[1:] {
+		y, err := strconv.ParseFloat(record[yc], 64)
+		if err != nil {
+			return Data{}, fmt.Errorf("csv file %q line %d: %v", options.Path, line+2, err)
+		}
+		data.YValues = append(data.YValues, y)
+
+		if xc >= 0 {
+			var x float64
+			if options.TimeFormat != "" {
+				var t time.Time
+				t, err = time.Parse(options.TimeFormat, record[xc])
+				x = chart.TimeToFloat64(t)
+			} else {
+				x, err = strconv.ParseFloat(record[xc], 64)
+			}
+			if err != nil {
+				return Data{}, fmt.Errorf("csv file %q line %d: %v", options.Path, line+2, err)
+			}
+			data.XValues = append(data.XValues, x)
+		}
+		if lc >= 0 {
+			data.Labels = append(data.Labels, record[lc])
+		}
+	}
+	return data, nil
+}
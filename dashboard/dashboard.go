@@ -0,0 +1,201 @@
+package dashboard
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"io"
+	"sync"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// Format is an enum for the output formats of a dashboard.
+type Format int
+
+const (
+	// FormatUnset is the unset state for formats; it defaults to `FormatPNG`.
+	FormatUnset Format = 0
+	// FormatPNG renders the dashboard as a single png image.
+	FormatPNG Format = 1
+	// FormatSVG renders the dashboard as a single svg document, with each chart embedded as an svg image.
+	FormatSVG Format = 2
+)
+
+// DefaultChartPadding is the background padding of the charts of a dashboard, with room for their titles.
+var DefaultChartPadding = chart.Box{Top: 40, Left: 10, Right: 10, Bottom: 10}
+
+// renderable is a chart that can be rendered on its own.
+type renderable interface {
+	Render(rp chart.RendererProvider, w io.Writer) error
+}
+
+// Render renders the dashboard a spec describes to a writer: the data of each chart is loaded and the chart
+// is rendered concurrently, and the charts are then composited on a single page under the dashboard title.
+func Render(spec Spec, format Format, w io.Writer) error {
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+	rp := chart.PNG
+	if format == FormatSVG {
+		rp = chart.SVG
+	}
+
+	top := spec.GetTitleHeight()
+	grid := chart.ChartGrid{
+		Width:   spec.GetWidth(),
+		Height:  spec.GetHeight() - top,
+		Columns: spec.Columns,
+		Charts:  make([]chart.Chart, len(spec.Charts)),
+	}
+
+	cells := make([][]byte, len(spec.Charts))
+	errs := make([]error, len(spec.Charts))
+	var wg sync.WaitGroup
+	for index := range spec.Charts {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			cell := grid.GetCellBox(index)
+			cells[index], errs[index] = renderChart(spec.Charts[index], cell.Width(), cell.Height(), rp)
+		}(index)
+	}
+	wg.Wait()
+	for index, err := range errs {
+		if err != nil {
+			return fmt.Errorf("dashboard chart %d (%q): %v", index, spec.Charts[index].Title, err)
+		}
+	}
+
+	r, err := rp(spec.GetWidth(), spec.GetHeight())
+	if err != nil {
+		return err
+	}
+	chart.Draw.Box(r, chart.Box{Right: spec.GetWidth(), Bottom: spec.GetHeight()}, chart.Style{
+		FillColor:   chart.DefaultBackgroundColor,
+		StrokeColor: chart.DefaultBackgroundColor,
+		StrokeWidth: chart.DefaultStrokeWidth,
+	})
+	if spec.Title != "" {
+		font, err := chart.GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		chart.Draw.TextWithin(r, spec.Title, chart.Box{Right: spec.GetWidth(), Bottom: top}, chart.Style{
+			Font:                font,
+			FontSize:            chart.DefaultTitleFontSize,
+			FontColor:           chart.DefaultTextColor,
+			TextHorizontalAlign: chart.TextHorizontalAlignCenter,
+			TextVerticalAlign:   chart.TextVerticalAlignMiddle,
+		})
+	}
+
+	for index, cell := range cells {
+		box := grid.GetCellBox(index).Shift(0, top)
+		if format == FormatSVG {
+			r.(chart.SVGImageRenderer).DrawSVGImage(cell, box)
+			continue
+		}
+		img, err := png.Decode(bytes.NewReader(cell))
+		if err != nil {
+			return err
+		}
+		r.(chart.ImageRenderer).DrawImage(img, box)
+	}
+	return r.Save(w)
+}
+
+// renderChart loads the data of a chart and renders it at a given size.
+func renderChart(cs ChartSpec, width, height int, rp chart.RendererProvider) ([]byte, error) {
+	data := make([]Data, len(cs.Series))
+	for index, ss := range cs.Series {
+		adapter, _ := lookupAdapter(ss.Source.Adapter)
+		var err error
+		if data[index], err = adapter(ss.Source.Params); err != nil {
+			return nil, fmt.Errorf("series %q: %v", ss.Name, err)
+		}
+	}
+
+	c, err := buildChart(cs, data, width, height)
+	if err != nil {
+		return nil, err
+	}
+	buffer := bytes.NewBuffer(nil)
+	if err := c.Render(rp, buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// buildChart returns the chart of a chart spec, of the data of its series.
+func buildChart(cs ChartSpec, data []Data, width, height int) (renderable, error) {
+	xf := chart.ValueFormatter(chart.FloatValueFormatter)
+	if cs.Time {
+		xf = chart.TimeValueFormatter
+	}
+
+	if cs.GetType() == ChartTypeBar {
+		// bar charts don't make room for their labels below the bars.
+		padding := DefaultChartPadding
+		padding.Bottom += chart.DefaultXAxisMargin + int(chart.DefaultFontSize)
+		bc := chart.BarChart{
+			Title:      cs.Title,
+			TitleStyle: chart.Style{FontSize: chart.DefaultTitleFontSize},
+			Width:      width,
+			Height:     height,
+			Background: chart.Style{Padding: padding},
+		}
+		for index, y := range data[0].YValues {
+			label := xf(float64(index))
+			if index < len(data[0].Labels) {
+				label = data[0].Labels[index]
+			} else if index < len(data[0].XValues) {
+				label = xf(data[0].XValues[index])
+			}
+			bc.Bars = append(bc.Bars, chart.Value{Label: label, Value: y})
+		}
+		return bc, nil
+	}
+
+	c := chart.Chart{
+		Title:      cs.Title,
+		Width:      width,
+		Height:     height,
+		Background: chart.Style{Padding: DefaultChartPadding},
+	}
+	c.YAxisSecondary.Style = chart.Hidden()
+	if cs.Time {
+		c.XAxis.ValueFormatter = xf
+		c.XAxis.TickGenerator = chart.TimeTickGenerator{}
+	}
+	for index, ss := range cs.Series {
+		xvalues := data[index].XValues
+		if len(xvalues) == 0 {
+			xvalues = chart.LinearRange(0, float64(len(data[index].YValues)-1))
+		}
+		if len(xvalues) != len(data[index].YValues) {
+			return nil, fmt.Errorf("series %q has %d x values and %d y values", ss.Name, len(xvalues), len(data[index].YValues))
+		}
+		series := chart.ContinuousSeries{
+			Name:    ss.Name,
+			XValues: xvalues,
+			YValues: data[index].YValues,
+		}
+		if ss.YAxis == "secondary" {
+			series.YAxis = chart.YAxisSecondary
+			c.YAxisSecondary.Style = chart.Shown()
+		}
+		switch cs.GetType() {
+		case ChartTypeArea:
+			series.Style.FillColor = chart.GetDefaultColor(index).WithAlpha(64)
+		case ChartTypeScatter:
+			series.Style.StrokeWidth = chart.Disabled
+			series.Style.DotWidth = 3
+		}
+		c.Series = append(c.Series, series)
+	}
+	if len(c.Series) > 1 {
+		c.Elements = []chart.Renderable{chart.Legend(&c)}
+	}
+	return c, nil
+}
@@ -0,0 +1,110 @@
+package dashboard
+
+import (
+	"bytes"
+	"encoding/json"
+	"image/png"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+const testSpec = `{
+	"title": "Dashboard",
+	"width": 800,
+	"height": 600,
+	"charts": [
+		{"title": "Line", "series": [
+			{"name": "a", "source": {"adapter": "values", "params": {"y": [1, 3, 2, 5]}}},
+			{"name": "b", "yAxis": "secondary", "source": {"adapter": "values", "params": {"y": [40, 10, 30, 20]}}}
+		]},
+		{"title": "Bar", "type": "bar", "series": [
+			{"name": "c", "source": {"adapter": "values", "params": {"y": [4, 2, 6], "labels": ["x", "y", "z"]}}}
+		]},
+		{"title": "Scatter", "type": "scatter", "series": [
+			{"name": "d", "source": {"adapter": "values", "params": {"x": [1, 2, 3], "y": [3, 1, 2]}}}
+		]}
+	]
+}`
+
+func TestParse(t *testing.T) {
+	// replaced new assertions helper
+
+	spec, err := Parse([]byte(testSpec))
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, "Dashboard", spec.Title)
+	testutil.AssertLen(t, spec.Charts, 3)
+	testutil.AssertEqual(t, ChartTypeLine, spec.Charts[0].GetType())
+	testutil.AssertEqual(t, "secondary", spec.Charts[0].Series[1].YAxis)
+
+	testutil.AssertEqual(t, DefaultWidth, Spec{}.GetWidth())
+	testutil.AssertEqual(t, DefaultHeight, Spec{}.GetHeight())
+
+	_, err = Parse([]byte(`{"charts": []}`))
+	testutil.AssertNotNil(t, err)
+	_, err = Parse([]byte(`{"charts": [{"type": "pie", "series": [{"source": {"adapter": "values"}}]}]}`))
+	testutil.AssertNotNil(t, err)
+	_, err = Parse([]byte(`{"charts": [{"series": [{"source": {"adapter": "nope"}}]}]}`))
+	testutil.AssertNotNil(t, err)
+	_, err = Parse([]byte(`{"charts": [{"type": "bar", "series": [{"source": {"adapter": "values"}}, {"source": {"adapter": "values"}}]}]}`))
+	testutil.AssertNotNil(t, err)
+	_, err = Parse([]byte(`{"title": "Dashboard", "height": 40, "charts": [{"series": [{"source": {"adapter": "values"}}]}]}`))
+	testutil.AssertNotNil(t, err)
+	_, err = Parse([]byte(`{"height": 40, "charts": [{"series": [{"source": {"adapter": "values"}}]}]}`))
+	testutil.AssertNil(t, err)
+}
+
+func TestRegisterAdapter(t *testing.T) {
+	// replaced new assertions helper
+
+	RegisterAdapter("test", func(params json.RawMessage) (Data, error) {
+		return Data{YValues: []float64{1, 2, 3}}, nil
+	})
+	adapter, ok := lookupAdapter("test")
+	testutil.AssertTrue(t, ok)
+	data, err := adapter(nil)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []float64{1, 2, 3}, data.YValues)
+}
+
+func TestCSVAdapter(t *testing.T) {
+	// replaced new assertions helper
+
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	testutil.AssertNil(t, ioutil.WriteFile(path, []byte("date,close\n2024-01-01,10\n2024-01-02,12.5\n"), 0644))
+
+	data, err := CSVAdapter(json.RawMessage(`{"path": "` + path + `", "x": "date", "y": "close", "label": "date", "timeFormat": "2006-01-02"}`))
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []float64{10, 12.5}, data.YValues)
+	testutil.AssertEqual(t, chart.TimeToFloat64(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)), data.XValues[1])
+	testutil.AssertEqual(t, []string{"2024-01-01", "2024-01-02"}, data.Labels)
+
+	_, err = CSVAdapter(json.RawMessage(`{"path": "` + path + `", "y": "open"}`))
+	testutil.AssertNotNil(t, err)
+}
+
+func TestRender(t *testing.T) {
+	// replaced new assertions helper
+
+	spec, err := Parse([]byte(testSpec))
+	testutil.AssertNil(t, err)
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, Render(spec, FormatPNG, buffer))
+	img, err := png.Decode(buffer)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, 800, img.Bounds().Dx())
+	testutil.AssertEqual(t, 600, img.Bounds().Dy())
+
+	buffer.Reset()
+	testutil.AssertNil(t, Render(spec, FormatSVG, buffer))
+	testutil.AssertEqual(t, 3, strings.Count(buffer.String(), "<image"))
+
+	spec.Charts[2].Series[0].Source.Params = json.RawMessage(`{"x": [1, 2], "y": [3, 1, 2]}`)
+	testutil.AssertNotNil(t, Render(spec, FormatPNG, buffer))
+}
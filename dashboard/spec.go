@@ -0,0 +1,163 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// DefaultWidth is the default width of a dashboard.
+	DefaultWidth = 1600
+	// DefaultHeight is the default height of a dashboard.
+	DefaultHeight = 900
+	// DefaultTitleHeight is the height of the band at the top of a dashboard that its title is drawn in.
+	DefaultTitleHeight = 48
+)
+
+// Chart types.
+const (
+	// ChartTypeLine draws the series as lines; it is the default.
+	ChartTypeLine = "line"
+	// ChartTypeArea draws the series as lines filled down to the x-axis.
+	ChartTypeArea = "area"
+	// ChartTypeScatter draws the series as dots.
+	ChartTypeScatter = "scatter"
+	// ChartTypeBar draws a single series as a bar chart, labeled with the labels of its data.
+	ChartTypeBar = "bar"
+)
+
+// Spec describes a dashboard: a grid of charts, each with series loaded by data source adapters.
+//
+// A spec is usually read from json with `Parse`, e.g.
+//
+//	{
+//		"title": "Service health",
+//		"columns": 2,
+//		"charts": [
+//			{
+//				"title": "Requests",
+//				"time": true,
+//				"series": [
+//					{"name": "p99", "source": {"adapter": "csv", "params": {"path": "requests.csv", "x": "time", "y": "p99", "timeFormat": "2006-01-02T15:04:05Z07:00"}}}
+//				]
+//			}
+//		]
+//	}
+type Spec struct {
+	Title  string `json:"title"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	// Columns is the number of charts in each row; it defaults to the square root of the number of charts, rounded up.
+	Columns int         `json:"columns"`
+	Charts  []ChartSpec `json:"charts"`
+}
+
+// ChartSpec describes a chart of a dashboard.
+type ChartSpec struct {
+	Title string `json:"title"`
+	// Type is one of the chart types, e.g. "line" or "bar"; it defaults to "line".
+	Type string `json:"type"`
+	// Time formats the x values as times, i.e. nanoseconds since the epoch, with ticks on natural time steps.
+	Time   bool         `json:"time"`
+	Series []SeriesSpec `json:"series"`
+}
+
+// SeriesSpec describes a series of a chart.
+type SeriesSpec struct {
+	Name string `json:"name"`
+	// YAxis is "primary" or "secondary"; it defaults to "primary".
+	YAxis  string     `json:"yAxis"`
+	Source SourceSpec `json:"source"`
+}
+
+// SourceSpec names the adapter that loads the data of a series, and the parameters it is loaded with.
+type SourceSpec struct {
+	Adapter string          `json:"adapter"`
+	Params  json.RawMessage `json:"params"`
+}
+
+// Parse reads a spec from json, and validates it.
+func Parse(data []byte) (Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return Spec{}, err
+	}
+	return spec, spec.Validate()
+}
+
+// GetWidth returns the width or the default.
+func (s Spec) GetWidth() int {
+	if s.Width == 0 {
+		return DefaultWidth
+	}
+	return s.Width
+}
+
+// GetHeight returns the height or the default.
+func (s Spec) GetHeight() int {
+	if s.Height == 0 {
+		return DefaultHeight
+	}
+	return s.Height
+}
+
+// GetTitleHeight returns the height of the band the title is drawn in, or zero if there is no title.
+func (s Spec) GetTitleHeight() int {
+	if s.Title == "" {
+		return 0
+	}
+	return DefaultTitleHeight
+}
+
+// GetType returns the chart type or the default.
+func (cs ChartSpec) GetType() string {
+	if cs.Type == "" {
+		return ChartTypeLine
+	}
+	return cs.Type
+}
+
+// Validate validates the spec.
+func (s Spec) Validate() error {
+	if len(s.Charts) == 0 {
+		return fmt.Errorf("dashboard requires at least one chart")
+	}
+	if s.GetWidth() <= 0 {
+		return fmt.Errorf("dashboard has an invalid width: %d", s.Width)
+	}
+	if s.GetHeight() <= s.GetTitleHeight() {
+		return fmt.Errorf("dashboard height %d leaves no room for charts below the title", s.Height)
+	}
+	for index, cs := range s.Charts {
+		if err := cs.Validate(); err != nil {
+			return fmt.Errorf("dashboard chart %d: %v", index, err)
+		}
+	}
+	return nil
+}
+
+// Validate validates the chart spec.
+func (cs ChartSpec) Validate() error {
+	switch cs.GetType() {
+	case ChartTypeLine, ChartTypeArea, ChartTypeScatter, ChartTypeBar:
+	default:
+		return fmt.Errorf("invalid chart type: %q", cs.Type)
+	}
+	if len(cs.Series) == 0 {
+		return fmt.Errorf("chart requires at least one series")
+	}
+	if cs.GetType() == ChartTypeBar && len(cs.Series) > 1 {
+		return fmt.Errorf("bar chart has %d series, it draws a single series", len(cs.Series))
+	}
+	for _, ss := range cs.Series {
+		switch ss.YAxis {
+		case "", "primary", "secondary":
+		default:
+			return fmt.Errorf("series %q has an invalid y-axis: %q", ss.Name, ss.YAxis)
+		}
+		if _, ok := lookupAdapter(ss.Source.Adapter); !ok {
+			return fmt.Errorf("series %q has an unknown adapter: %q", ss.Name, ss.Source.Adapter)
+		}
+	}
+	return nil
+}
@@ -1,5 +1,7 @@
 package chart
 
+import "math"
+
 const (
 	// DefaultChartHeight is the default chart height.
 	DefaultChartHeight = 400
@@ -13,6 +15,8 @@ const (
 	DefaultSeriesLineWidth = 1.0
 	// DefaultAxisLineWidth is the line width of the axis lines.
 	DefaultAxisLineWidth = 1.0
+	// DefaultGridLineWidth is the default line width of the grid lines drawn at every tick of an axis.
+	DefaultGridLineWidth = 1.0
 	//DefaultDPI is the default dots per inch for the chart.
 	DefaultDPI = 92.0
 	// DefaultMinimumFontSize is the default minimum font size.
@@ -25,11 +29,44 @@ const (
 	DefaultAnnotationDeltaWidth = 10
 	// DefaultAnnotationFontSize is the font size of annotations.
 	DefaultAnnotationFontSize = 10.0
+	// DefaultTextBoxMaxWidth is the default pixel width the text of a text box wraps at.
+	DefaultTextBoxMaxWidth = 200
+	// DefaultCalloutOffsetX is the default horizontal pixel offset of a callout label from its point.
+	DefaultCalloutOffsetX = 60
+	// DefaultCalloutOffsetY is the default vertical pixel offset of a callout label from its point.
+	DefaultCalloutOffsetY = -40
+	// DefaultCalloutArrowSize is the default pixel length of callout arrowheads.
+	DefaultCalloutArrowSize = 8
+	// DefaultGanttBarHeight is the default height of the bars of a gantt series as a fraction of the height of a row.
+	DefaultGanttBarHeight = 0.6
+	// DefaultGanttArrowGap is the distance the dependency arrows of a gantt series keep from the bars they join.
+	DefaultGanttArrowGap = 8
+	// DefaultGanttArrowSize is the default pixel length of the dependency arrowheads of a gantt series.
+	DefaultGanttArrowSize = 6
+	// DefaultSmallMultiplesTitleFontSize is the default font size of the titles of small multiples.
+	DefaultSmallMultiplesTitleFontSize = 10.0
+	// DefaultIndicatorMainWeight is the height of the main chart of an indicator stack relative to its panels.
+	DefaultIndicatorMainWeight = 4.0
+	// DefaultIndicatorPanelWeight is the default height of a panel of an indicator stack relative to the others.
+	DefaultIndicatorPanelWeight = 1.0
+	// DefaultVarianceBarWidth is the default width of the bars of a variance series as a fraction of the space per period.
+	DefaultVarianceBarWidth = 0.6
+	// DefaultVarianceTargetWidth is the width of the target ticks of a variance series as a fraction of the width of the bars.
+	DefaultVarianceTargetWidth = 1.3
+	// DefaultVarianceTargetStrokeWidth is the default stroke width of the target ticks of a variance series.
+	DefaultVarianceTargetStrokeWidth = 3.0
 	// DefaultAxisFontSize is the font size of the axis labels.
 	DefaultAxisFontSize = 10.0
 	// DefaultTitleTop is the default distance from the top of the chart to put the title.
 	DefaultTitleTop = 10
 
+	// DefaultSparklineWidth is the default width of a sparkline chart.
+	DefaultSparklineWidth = 100
+	// DefaultSparklineHeight is the default height of a sparkline chart.
+	DefaultSparklineHeight = 30
+	// DefaultSparklinePadding is the default padding of a sparkline chart, enough to keep its lines from being clipped.
+	DefaultSparklinePadding = 2
+
 	// DefaultBackgroundStrokeWidth is the default stroke on the chart background.
 	DefaultBackgroundStrokeWidth = 0.0
 	// DefaultCanvasStrokeWidth is the default stroke on the chart canvas.
@@ -47,6 +84,17 @@ const (
 	DefaultVerticalTickHeight = DefaultXAxisMargin >> 1
 	//DefaultHorizontalTickWidth is half the margin.
 	DefaultHorizontalTickWidth = DefaultYAxisMargin >> 1
+	// DefaultMinorTickHeight is the length of the minor tick marks of an x-axis, half that of the major ones.
+	DefaultMinorTickHeight = DefaultVerticalTickHeight >> 1
+	// DefaultMinorTickWidth is the length of the minor tick marks of a y-axis, half that of the major ones.
+	DefaultMinorTickWidth = DefaultHorizontalTickWidth >> 1
+
+	// DefaultAxisBreakGap is the gap left in an axis for an axis break.
+	DefaultAxisBreakGap = 10
+	// DefaultAxisBreakWidth is the width of the zig-zag marking either side of an axis break.
+	DefaultAxisBreakWidth = 4 * DefaultHorizontalTickWidth
+	// DefaultAxisBreakAmplitude is the height of the zig-zag marking either side of an axis break.
+	DefaultAxisBreakAmplitude = 2
 
 	// DefaultTickCount is the default number of ticks to show
 	DefaultTickCount = 10
@@ -73,6 +121,160 @@ const (
 	DefaultBarSpacing = 100
 	// DefaultBarWidth is the default pixel width of bars in a bar chart.
 	DefaultBarWidth = 50
+	// DefaultGroupBarSpacing is the default pixel spacing between bars within a group.
+	DefaultGroupBarSpacing = 5
+
+	// DefaultWaffleGridSize is the default number of rows and columns of cells in a waffle chart.
+	DefaultWaffleGridSize = 10
+	// DefaultWaffleCellSpacing is the default pixel spacing between cells in a waffle chart.
+	DefaultWaffleCellSpacing = 2
+	// DefaultWaffleLegendGap is the default distance between the grid, swatches and labels of a waffle chart.
+	DefaultWaffleLegendGap = 10
+
+	// DefaultPictogramIconSpacing is the default pixel spacing between icons in a pictogram chart.
+	DefaultPictogramIconSpacing = 4
+	// DefaultPictogramLabelGap is the default distance between the labels and icons of a pictogram chart.
+	DefaultPictogramLabelGap = 10
+
+	// DefaultMarimekkoBarSpacing is the default pixel spacing between the bars of a marimekko chart.
+	DefaultMarimekkoBarSpacing = 2
+	// DefaultMarimekkoLabelPadding is the minimum distance between a label and the edges of its section in a marimekko chart.
+	DefaultMarimekkoLabelPadding = 3
+
+	// DefaultActivityRingSpacing is the default pixel spacing between the rings of an activity ring chart.
+	DefaultActivityRingSpacing = 4
+	// DefaultActivityRingStartAngle is the angle, in radians clockwise from three o'clock, that activity rings start at, the top of the circle.
+	DefaultActivityRingStartAngle = 3 * math.Pi / 2
+
+	// DefaultChordGroupPadding is the default angle, in radians, between the groups of a chord chart.
+	DefaultChordGroupPadding = 0.04
+	// DefaultChordLabelGap is the default distance between the groups of a chord chart and their labels.
+	DefaultChordLabelGap = 6
+	// DefaultChordStartAngle is the angle, in radians clockwise from three o'clock, that the first group of a chord chart starts at, the top of the circle.
+	DefaultChordStartAngle = 3 * math.Pi / 2
+
+	// DefaultNetworkNodeRadius is the default radius of the nodes of a network chart.
+	DefaultNetworkNodeRadius = 8.0
+	// DefaultNetworkMaxEdgeWidth is the default width of the heaviest edges of a network chart.
+	DefaultNetworkMaxEdgeWidth = 6.0
+	// DefaultNetworkIterations is the default number of steps of the force directed layout of a network chart.
+	DefaultNetworkIterations = 300
+	// DefaultNetworkLabelGap is the default distance between the nodes of a network chart and their labels.
+	DefaultNetworkLabelGap = 4
+
+	// DefaultWordFrequencyLimit is the default number of words a word frequency chart draws.
+	DefaultWordFrequencyLimit = 20
+	// DefaultWordFrequencyLabelGap is the default distance between the bars of a word frequency chart and their labels.
+	DefaultWordFrequencyLabelGap = 5
+	// DefaultWordCloudMinFontSize is the default font size of the least frequent words of a word cloud.
+	DefaultWordCloudMinFontSize = 10.0
+	// DefaultWordCloudMaxFontSize is the default font size of the most frequent words of a word cloud.
+	DefaultWordCloudMaxFontSize = 48.0
+	// DefaultWordCloudPadding is the minimum pixel distance between the words of a word cloud.
+	DefaultWordCloudPadding = 2
+
+	// DefaultColorBarWidth is the default thickness of color bar legends.
+	DefaultColorBarWidth = 12
+	// DefaultColorBarGap is the default distance between a color bar legend and what it is a legend for, and its labels.
+	DefaultColorBarGap = 10
+	// DefaultMercatorMaxLatitude is the latitude, in degrees, beyond which the mercator projection clamps points.
+	DefaultMercatorMaxLatitude = 85.0
+
+	// DefaultHeatMapCellSpacing is the default pixel spacing between the cells of a heat map chart.
+	DefaultHeatMapCellSpacing = 1
+	// DefaultHeatMapLabelGap is the default distance between the cells of a heat map chart and their row and column labels.
+	DefaultHeatMapLabelGap = 5
+	// DefaultCalendarHeatMapCellSpacing is the default pixel spacing between the cells of a calendar heat map chart.
+	DefaultCalendarHeatMapCellSpacing = 2
+
+	// DefaultBubbleMapMinRadius is the default radius of the smallest bubbles of a bubble map chart.
+	DefaultBubbleMapMinRadius = 3.0
+	// DefaultBubbleMapMaxRadius is the default radius of the largest bubbles of a bubble map chart.
+	DefaultBubbleMapMaxRadius = 20.0
+	// DefaultBubbleMapLabelGap is the default distance between the bubbles of a bubble map chart and their labels.
+	DefaultBubbleMapLabelGap = 4
+
+	// DefaultRadarGridLines is the default number of grid lines between the center and the edge of a radar chart.
+	DefaultRadarGridLines = 4
+	// DefaultRadarLabelGap is the default distance between the edge of a radar chart and its axis labels.
+	DefaultRadarLabelGap = 6
+	// DefaultRadarStartAngle is the angle, in radians clockwise from three o'clock, of the first axis of a radar chart, the top of the circle.
+	DefaultRadarStartAngle = 3 * math.Pi / 2
+	// DefaultRadarLegendGap is the default distance between a radar chart, its legend swatches and their labels.
+	DefaultRadarLegendGap = 10
+	// DefaultPolarGridLines is the default number of grid circles between the center and the edge of a polar chart.
+	DefaultPolarGridLines = 4
+	// DefaultPolarSpokes is the default number of angular grid lines of a polar chart, one every 30 degrees.
+	DefaultPolarSpokes = 12
+	// DefaultPolarLabelGap is the default distance between the edge of a polar chart and its angle labels.
+	DefaultPolarLabelGap = 6
+
+	// DefaultSurfaceAzimuth is the default angle, in degrees, that surface charts are turned about their vertical axis.
+	DefaultSurfaceAzimuth = 45.0
+	// DefaultSurfaceElevation is the default angle, in degrees, that surface charts are viewed from above, which with the default azimuth gives an isometric view.
+	DefaultSurfaceElevation = 35.264
+	// DefaultSurfaceZScale is the default height of surface charts relative to their width and depth.
+	DefaultSurfaceZScale = 0.5
+	// DefaultSurfaceCameraDistance is the distance of the camera from the center of perspective surface charts, relative to their half width.
+	DefaultSurfaceCameraDistance = 4.0
+	// DefaultContourLevelCount is the default most levels chosen for a contour chart.
+	DefaultContourLevelCount = 10
+	// DefaultContourLabelSpan is how many times longer than its label a contour line must be to be labeled.
+	DefaultContourLabelSpan = 3
+	// DefaultContourLabelPadding is the default padding around the labels of a contour chart.
+	DefaultContourLabelPadding = 2
+
+	// DefaultGaugeStartAngle is the angle, in radians clockwise from three o'clock, that the scale of a gauge chart starts at, down and to the left.
+	DefaultGaugeStartAngle = 5 * math.Pi / 6
+	// DefaultGaugeSweep is the angle, in radians, that the scale of a gauge chart sweeps clockwise.
+	DefaultGaugeSweep = 4 * math.Pi / 3
+	// DefaultGaugeThicknessRatio is the default thickness of the scale of a gauge chart relative to its radius.
+	DefaultGaugeThicknessRatio = 0.2
+	// DefaultGaugeLabelGap is the default distance between the scale of a gauge chart and its labels.
+	DefaultGaugeLabelGap = 5
+	// DefaultFunnelStageSpacing is the default pixel spacing between the stages of a funnel chart.
+	DefaultFunnelStageSpacing = 2
+	// DefaultFunnelLabelGap is the default distance between the stages of a funnel chart and their labels.
+	DefaultFunnelLabelGap = 10
+	// DefaultTreeMapPadding is the default pixel distance between the edges of a tree map node and its children.
+	DefaultTreeMapPadding = 3
+	// DefaultTreeMapLabelPadding is the default distance between the edges of a tree map node and its label.
+	DefaultTreeMapLabelPadding = 4
+	// DefaultTreeMapDepthShade is how much lighter, as a fraction of the way to white, each level of a tree map is than its parent.
+	DefaultTreeMapDepthShade = 0.25
+	// DefaultSankeyNodeWidth is the default pixel width of the nodes of a sankey chart.
+	DefaultSankeyNodeWidth = 16
+	// DefaultSankeyNodePadding is the default pixel spacing between the nodes of a column of a sankey chart.
+	DefaultSankeyNodePadding = 12
+	// DefaultSankeyLabelGap is the default distance between the nodes of a sankey chart and their labels.
+	DefaultSankeyLabelGap = 6
+	// DefaultSankeyFlowAlpha is the default alpha of the flows of a sankey chart, which take the color of their source node.
+	DefaultSankeyFlowAlpha = 96
+
+	// DefaultLegendGroupIndent is the default indent of the entries under the heading of a legend group.
+	DefaultLegendGroupIndent = 10
+
+	// DefaultMarkerSize is the default distance from the center of a marker to its edge.
+	DefaultMarkerSize = 5.0
+	// DefaultMarkerLabelGap is the default distance between a marker and its label.
+	DefaultMarkerLabelGap = 3
+	// DefaultBumpLineWidth is the default width of the lines in a bump chart.
+	DefaultBumpLineWidth = 3.0
+	// DefaultBumpDotWidth is the default radius of the dots marking each rank in a bump chart.
+	DefaultBumpDotWidth = 5.0
+	// DefaultEndLabelGap is the default distance between the end of a series and its end label.
+	DefaultEndLabelGap = 8
+	// DefaultLastValueMarkerSize is the default radius of last value markers.
+	DefaultLastValueMarkerSize = 5.0
+	// DefaultLastValueMarkerHaloScale is the size of the last value marker halo relative to the marker.
+	DefaultLastValueMarkerHaloScale = 2.5
+	// DefaultLastValueMarkerHaloAlpha is the opacity [0,255] of the last value marker halo.
+	DefaultLastValueMarkerHaloAlpha = 64
+
+	// DefaultFillPatternSpacing is the default pixel distance between the lines or dots of a fill pattern.
+	DefaultFillPatternSpacing = 6
+	// DefaultFillPatternStrokeWidth is the line width and dot radius of fill patterns.
+	DefaultFillPatternStrokeWidth = 1.0
 )
 
 var (
@@ -89,9 +291,15 @@ var (
 var (
 	// DefaultAnnotationPadding is the padding around an annotation.
 	DefaultAnnotationPadding = Box{Top: 5, Left: 5, Right: 5, Bottom: 5}
+	// DefaultTextBoxMargin is the default distance between a text box anchored to the canvas and the canvas edges.
+	DefaultTextBoxMargin = Box{Top: 10, Left: 10, Right: 10, Bottom: 10}
 
 	// DefaultBackgroundPadding is the default canvas padding config.
 	DefaultBackgroundPadding = Box{Top: 5, Left: 5, Right: 5, Bottom: 5}
+	// DefaultSmallMultiplesPadding is the default background padding of small multiples, with room for their titles.
+	DefaultSmallMultiplesPadding = Box{Top: 25, Left: 5, Right: 5, Bottom: 5}
+	// DefaultComparisonTitlePadding is the background padding of a comparison chart with a title, with room for it.
+	DefaultComparisonTitlePadding = Box{Top: 40, Left: 5, Right: 5, Bottom: 5}
 )
 
 const (
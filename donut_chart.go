@@ -68,18 +68,18 @@ func (pc DonutChart) GetHeight() int {
 // Render renders the chart with the given renderer to the given io.Writer.
 func (pc DonutChart) Render(rp RendererProvider, w io.Writer) error {
 	if len(pc.Values) == 0 {
-		return errors.New("please provide at least one value")
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one value"))
 	}
 
 	r, err := rp(pc.GetWidth(), pc.GetHeight())
 	if err != nil {
-		return err
+		return newRenderError(RenderStageRenderer, err)
 	}
 
 	if pc.Font == nil {
 		defaultFont, err := GetDefaultFont()
 		if err != nil {
-			return err
+			return newRenderError(RenderStageFonts, err)
 		}
 		pc.defaultFont = defaultFont
 	}
@@ -93,7 +93,7 @@ func (pc DonutChart) Render(rp RendererProvider, w io.Writer) error {
 
 	finalValues, err := pc.finalizeValues(pc.Values)
 	if err != nil {
-		return err
+		return newRenderError(RenderStageValidate, err)
 	}
 	pc.drawSlices(r, canvasBox, finalValues)
 	pc.drawTitle(r)
@@ -101,17 +101,20 @@ func (pc DonutChart) Render(rp RendererProvider, w io.Writer) error {
 		a(r, canvasBox, pc.styleDefaultsElements())
 	}
 
-	return r.Save(w)
+	return newRenderError(RenderStageEncode, r.Save(w))
 }
 
 func (pc DonutChart) drawBackground(r Renderer) {
-	Draw.Box(r, Box{
-		Right:  pc.GetWidth(),
-		Bottom: pc.GetHeight(),
-	}, pc.getBackgroundStyle())
+	if pc.Background.Hidden {
+		return
+	}
+	drawBackground(r, pc.GetWidth(), pc.GetHeight(), pc.getBackgroundStyle())
 }
 
 func (pc DonutChart) drawCanvas(r Renderer, canvasBox Box) {
+	if pc.Canvas.Hidden {
+		return
+	}
 	Draw.Box(r, canvasBox, pc.getCanvasStyle())
 }
 
@@ -303,12 +306,12 @@ func (pc DonutChart) GetColorPalette() ColorPalette {
 
 // Box returns the chart bounds as a box.
 func (pc DonutChart) Box() Box {
-	dpr := pc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
-	dpb := pc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+	dpr := pc.Background.Margin.GetRight() + pc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := pc.Background.Margin.GetBottom() + pc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
 
 	return Box{
-		Top:    pc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
-		Left:   pc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Top:    pc.Background.Margin.GetTop() + pc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   pc.Background.Margin.GetLeft() + pc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
 		Right:  pc.GetWidth() - dpr,
 		Bottom: pc.GetHeight() - dpb,
 	}
@@ -25,40 +25,55 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 	y0 := cb - yrange.Translate(v0y)
 
 	yv0 := yrange.Translate(0)
+	interpolation := style.GetLineInterpolation()
 
 	var vx, vy float64
 	var x, y int
 
 	if style.ShouldDrawStroke() && style.ShouldDrawFill() {
 		style.GetFillOptions().WriteDrawingOptionsToRenderer(r)
+		area := []Point{{X: x0, Y: y0}}
 		r.MoveTo(x0, y0)
 		for i := 1; i < vs.Len(); i++ {
 			vx, vy = vs.GetValues(i)
 			x = cl + xrange.Translate(vx)
 			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
+			for _, p := range interpolation.path(area[len(area)-1], Point{X: x, Y: y}) {
+				r.LineTo(p.X, p.Y)
+				area = append(area, p)
+			}
 		}
 		r.LineTo(x, MinInt(cb, cb-yv0))
 		r.LineTo(x0, MinInt(cb, cb-yv0))
 		r.LineTo(x0, y0)
 		r.Fill()
+
+		area = append(area, Point{X: x, Y: MinInt(cb, cb-yv0)}, Point{X: x0, Y: MinInt(cb, cb-yv0)})
+		d.FillPattern(r, area, style)
 	}
 
 	if style.ShouldDrawStroke() {
 		style.GetStrokeOptions().WriteDrawingOptionsToRenderer(r)
 
 		r.MoveTo(x0, y0)
+		previous := Point{X: x0, Y: y0}
 		for i := 1; i < vs.Len(); i++ {
 			vx, vy = vs.GetValues(i)
 			x = cl + xrange.Translate(vx)
 			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
+			for _, p := range interpolation.path(previous, Point{X: x, Y: y}) {
+				r.LineTo(p.X, p.Y)
+			}
+			previous = Point{X: x, Y: y}
 		}
 		r.Stroke()
 	}
 
 	if style.ShouldDrawDot() {
 		defaultDotWidth := style.GetDotWidth()
+		if style.DotIcon != nil && defaultDotWidth == 0 {
+			defaultDotWidth = DefaultMarkerSize
+		}
 
 		style.GetDotOptions().WriteDrawingOptionsToRenderer(r)
 		for i := 0; i < vs.Len(); i++ {
@@ -71,6 +86,13 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 				dotWidth = style.DotWidthProvider(xrange, yrange, i, vx, vy)
 			}
 
+			if style.DotIcon != nil {
+				iconSize := int(dotWidth)
+				if style.DotIcon.Render(r, Box{Top: y - iconSize, Left: x - iconSize, Right: x + iconSize, Bottom: y + iconSize}) {
+					continue
+				}
+			}
+
 			if style.DotColorProvider != nil {
 				dotColor := style.DotColorProvider(xrange, yrange, i, vx, vy)
 
@@ -86,6 +108,10 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 
 // BoundedSeries draws a series that implements BoundedValuesProvider.
 func (d draw) BoundedSeries(r Renderer, canvasBox Box, xrange, yrange Range, style Style, bbs BoundedValuesProvider, drawOffsetIndexes ...int) {
+	if bbs.Len() == 0 {
+		return
+	}
+
 	drawOffsetIndex := 0
 	if len(drawOffsetIndexes) > 0 {
 		drawOffsetIndex = drawOffsetIndexes[0]
@@ -239,17 +265,96 @@ func (d draw) Annotation(r Renderer, canvasBox Box, style Style, lx, ly int, lab
 	r.Text(label, textX, textY)
 }
 
-// Box draws a box with a given style.
+// Box draws a box with a given style, rounding its corners if the style has a corner radius.
 func (d draw) Box(r Renderer, b Box, s Style) {
 	s.GetFillAndStrokeOptions().WriteToRenderer(r)
 	defer r.ResetStyle()
 
+	radius := MinInt(int(s.CornerRadius), b.Width()>>1, b.Height()>>1)
+	if radius > 0 {
+		r.MoveTo(b.Left+radius, b.Top)
+		r.LineTo(b.Right-radius, b.Top)
+		r.QuadCurveTo(b.Right, b.Top, b.Right, b.Top+radius)
+		r.LineTo(b.Right, b.Bottom-radius)
+		r.QuadCurveTo(b.Right, b.Bottom, b.Right-radius, b.Bottom)
+		r.LineTo(b.Left+radius, b.Bottom)
+		r.QuadCurveTo(b.Left, b.Bottom, b.Left, b.Bottom-radius)
+		r.LineTo(b.Left, b.Top+radius)
+		r.QuadCurveTo(b.Left, b.Top, b.Left+radius, b.Top)
+		r.FillStroke()
+	} else {
+		r.MoveTo(b.Left, b.Top)
+		r.LineTo(b.Right, b.Top)
+		r.LineTo(b.Right, b.Bottom)
+		r.LineTo(b.Left, b.Bottom)
+		r.LineTo(b.Left, b.Top)
+		r.FillStroke()
+	}
+
+	if s.FillPattern != FillPatternNone {
+		d.FillPattern(r, roundedBoxPolygon(b, radius), s)
+	}
+}
+
+// roundedBoxCornerSteps is the number of segments each rounded corner is approximated with.
+const roundedBoxCornerSteps = 8
+
+// roundedBoxPolygon returns the outline of a box with its corners rounded the way `Draw.Box` draws them,
+// approximating each corner curve with segments.
+func roundedBoxPolygon(b Box, radius int) []Point {
+	corners := b.Corners()
+	if radius <= 0 {
+		return []Point{corners.TopLeft, corners.TopRight, corners.BottomRight, corners.BottomLeft}
+	}
+
+	// each corner is a quadratic curve from the edge before it to the edge after it, with the corner as its control point.
+	curves := [][3]Point{
+		{{X: b.Right - radius, Y: b.Top}, corners.TopRight, {X: b.Right, Y: b.Top + radius}},
+		{{X: b.Right, Y: b.Bottom - radius}, corners.BottomRight, {X: b.Right - radius, Y: b.Bottom}},
+		{{X: b.Left + radius, Y: b.Bottom}, corners.BottomLeft, {X: b.Left, Y: b.Bottom - radius}},
+		{{X: b.Left, Y: b.Top + radius}, corners.TopLeft, {X: b.Left + radius, Y: b.Top}},
+	}
+	polygon := make([]Point, 0, len(curves)*(roundedBoxCornerSteps+1))
+	for _, curve := range curves {
+		for step := 0; step <= roundedBoxCornerSteps; step++ {
+			t := float64(step) / roundedBoxCornerSteps
+			w0, w1, w2 := (1-t)*(1-t), 2*(1-t)*t, t*t
+			polygon = append(polygon, Point{
+				X: int(math.Round(w0*float64(curve[0].X) + w1*float64(curve[1].X) + w2*float64(curve[2].X))),
+				Y: int(math.Round(w0*float64(curve[0].Y) + w1*float64(curve[1].Y) + w2*float64(curve[2].Y))),
+			})
+		}
+	}
+	return polygon
+}
+
+// ColorBar draws a gradient of a color map through a box, from the min at the bottom to the max at the top,
+// or from the min at the left to the max at the right if the box is wider than it is tall, outlined with
+// the stroke of a given style.
+func (d draw) ColorBar(r Renderer, b Box, colorMap ColorMap, min, max float64, s Style) {
+	horizontal := b.Width() > b.Height()
+	length := b.Height()
+	if horizontal {
+		length = b.Width()
+	}
+	for step := 0; step < length; step++ {
+		value := min + (max-min)*(float64(step)+0.5)/float64(length)
+		color := colorMap(value, min, max)
+		band := Box{Top: b.Bottom - step - 1, Left: b.Left, Right: b.Right, Bottom: b.Bottom - step}
+		if horizontal {
+			band = Box{Top: b.Top, Left: b.Left + step, Right: b.Left + step + 1, Bottom: b.Bottom}
+		}
+		d.Box(r, band, Style{FillColor: color, StrokeColor: color, StrokeWidth: 0.5})
+	}
+
+	s.GetStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
 	r.MoveTo(b.Left, b.Top)
 	r.LineTo(b.Right, b.Top)
 	r.LineTo(b.Right, b.Bottom)
 	r.LineTo(b.Left, b.Bottom)
-	r.LineTo(b.Left, b.Top)
-	r.FillStroke()
+	r.Close()
+	r.Stroke()
 }
 
 func (d draw) BoxRotated(r Renderer, b Box, thetaDegrees float64, s Style) {
@@ -276,6 +381,109 @@ func (d draw) Text(r Renderer, text string, x, y int, style Style) {
 	r.Text(text, x, y)
 }
 
+// Marker draws a marker glyph centered on a given point, where size is the distance from the center to the edge.
+// If the style has a dot icon the icon is drawn instead.
+func (d draw) Marker(r Renderer, marker Marker, x, y int, size float64, style Style) {
+	s := int(size)
+	if style.DotIcon != nil && style.DotIcon.Render(r, Box{Top: y - s, Left: x - s, Right: x + s, Bottom: y + s}) {
+		return
+	}
+
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	switch marker {
+	case MarkerSquare:
+		r.MoveTo(x-s, y-s)
+		r.LineTo(x+s, y-s)
+		r.LineTo(x+s, y+s)
+		r.LineTo(x-s, y+s)
+		r.LineTo(x-s, y-s)
+	case MarkerDiamond:
+		r.MoveTo(x, y-s)
+		r.LineTo(x+s, y)
+		r.LineTo(x, y+s)
+		r.LineTo(x-s, y)
+		r.LineTo(x, y-s)
+	case MarkerTriangle:
+		r.MoveTo(x, y-s)
+		r.LineTo(x+s, y+s)
+		r.LineTo(x-s, y+s)
+		r.LineTo(x, y-s)
+	case MarkerTriangleDown:
+		r.MoveTo(x, y+s)
+		r.LineTo(x-s, y-s)
+		r.LineTo(x+s, y-s)
+		r.LineTo(x, y+s)
+	default:
+		r.Circle(size, x, y)
+	}
+	r.FillStroke()
+}
+
+// Arrow strokes a path through the points and fills an arrowhead of a given length at its last point,
+// pointing along its last segment, in the stroke color of the style.
+func (d draw) Arrow(r Renderer, path []Point, headSize int, style Style) {
+	if len(path) < 2 {
+		return
+	}
+	style.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(path[0].X, path[0].Y)
+	for _, p := range path[1:] {
+		r.LineTo(p.X, p.Y)
+	}
+	r.Stroke()
+	r.ResetStyle()
+
+	tip, from := path[len(path)-1], path[len(path)-2]
+	dx, dy := float64(tip.X-from.X), float64(tip.Y-from.Y)
+	length := math.Hypot(dx, dy)
+	if length == 0 || headSize <= 0 {
+		return
+	}
+	size := float64(headSize)
+	ux, uy := dx/length, dy/length
+	bx, by := float64(tip.X)-ux*size, float64(tip.Y)-uy*size
+	half := size / 2
+
+	Style{
+		FillColor:   style.GetStrokeColor(),
+		StrokeColor: style.GetStrokeColor(),
+		StrokeWidth: style.GetStrokeWidth(),
+	}.GetFillAndStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+	r.MoveTo(tip.X, tip.Y)
+	r.LineTo(int(math.Round(bx-uy*half)), int(math.Round(by+ux*half)))
+	r.LineTo(int(math.Round(bx+uy*half)), int(math.Round(by-ux*half)))
+	r.Close()
+	r.FillStroke()
+}
+
+// CubicCurveTo draws a cubic bezier curve from (x0,y0), the current point of the path, with the
+// renderer's `CubicCurveTo` if it is a `CubicCurveRenderer`, or otherwise flattened into lines.
+func (d draw) CubicCurveTo(r Renderer, x0, y0, cx1, cy1, cx2, cy2, x, y int) {
+	if typed, isTyped := r.(CubicCurveRenderer); isTyped {
+		typed.CubicCurveTo(cx1, cy1, cx2, cy2, x, y)
+		return
+	}
+	for index := 1; index <= cubicCurveSegments; index++ {
+		t := float64(index) / cubicCurveSegments
+		r.LineTo(
+			int(math.Round(cubicBezier(t, float64(x0), float64(cx1), float64(cx2), float64(x)))),
+			int(math.Round(cubicBezier(t, float64(y0), float64(cy1), float64(cy2), float64(y)))),
+		)
+	}
+}
+
+// cubicCurveSegments is the number of lines a cubic curve is flattened into.
+const cubicCurveSegments = 16
+
+// cubicBezier returns the position at t of a cubic bezier curve along one axis.
+func cubicBezier(t, p0, p1, p2, p3 float64) float64 {
+	u := 1 - t
+	return u*u*u*p0 + 3*u*u*t*p1 + 3*u*t*t*p2 + t*t*t*p3
+}
+
 func (d draw) MeasureText(r Renderer, text string, style Style) Box {
 	style.GetTextOptions().WriteToRenderer(r)
 	defer r.ResetStyle()
@@ -0,0 +1,33 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+// lineRenderer is a renderer that cannot draw cubic curves, recording the lines drawn to it.
+type lineRenderer struct {
+	Renderer
+	lines []Point
+}
+
+func (lr *lineRenderer) LineTo(x, y int) {
+	lr.lines = append(lr.lines, Point{X: x, Y: y})
+}
+
+func TestDrawCubicCurveTo(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+	lr := &lineRenderer{Renderer: r}
+	_, isCubicCurveRenderer := Renderer(lr).(CubicCurveRenderer)
+	testutil.AssertFalse(t, isCubicCurveRenderer)
+
+	// the curve is flattened into lines from its start.
+	Draw.CubicCurveTo(lr, 0, 0, 50, 0, 50, 100, 100, 100)
+	testutil.AssertLen(t, lr.lines, cubicCurveSegments)
+	testutil.AssertEqual(t, Point{X: 50, Y: 50}, lr.lines[cubicCurveSegments/2-1])
+	testutil.AssertEqual(t, Point{X: 100, Y: 100}, lr.lines[cubicCurveSegments-1])
+}
@@ -16,6 +16,32 @@ type Painter interface {
 	SetColor(color color.Color)
 }
 
+// clipPainter paints only the parts of spans within a rectangle with another painter.
+type clipPainter struct {
+	Painter
+	clip image.Rectangle
+}
+
+// Paint implements raster.Painter.
+func (cp clipPainter) Paint(ss []raster.Span, done bool) {
+	clipped := make([]raster.Span, 0, len(ss))
+	for _, s := range ss {
+		if s.Y < cp.clip.Min.Y || s.Y >= cp.clip.Max.Y {
+			continue
+		}
+		if s.X0 < cp.clip.Min.X {
+			s.X0 = cp.clip.Min.X
+		}
+		if s.X1 > cp.clip.Max.X {
+			s.X1 = cp.clip.Max.X
+		}
+		if s.X0 < s.X1 {
+			clipped = append(clipped, s)
+		}
+	}
+	cp.Painter.Paint(clipped, done)
+}
+
 // DrawImage draws an image into dest using an affine transformation matrix, an op and a filter
 func DrawImage(src image.Image, dest draw.Image, tr Matrix, op draw.Op, filter ImageFilter) {
 	var transformer draw.Transformer
@@ -36,6 +36,7 @@ func NewRasterGraphicContextWithPainter(img draw.Image, painter Painter) *Raster
 		raster.NewRasterizer(width, height),
 		&truetype.GlyphBuf{},
 		DefaultDPI,
+		nil,
 	}
 }
 
@@ -48,6 +49,7 @@ type RasterGraphicContext struct {
 	strokeRasterizer *raster.Rasterizer
 	glyphBuf         *truetype.GlyphBuf
 	DPI              float64
+	clip             *image.Rectangle
 }
 
 // SetDPI sets the screen resolution in dots per inch.
@@ -61,6 +63,31 @@ func (rgc *RasterGraphicContext) GetDPI() float64 {
 	return rgc.DPI
 }
 
+// SetClip restricts drawing to a rectangle of the image, until ClearClip is called.
+func (rgc *RasterGraphicContext) SetClip(rect image.Rectangle) {
+	rgc.clip = &rect
+}
+
+// ClearClip lifts the restriction set by SetClip.
+func (rgc *RasterGraphicContext) ClearClip() {
+	rgc.clip = nil
+}
+
+// clipped returns the image to draw to, restricted to the clip rectangle if there is one.
+func (rgc *RasterGraphicContext) clipped() draw.Image {
+	if rgc.clip == nil {
+		return rgc.img
+	}
+	if sub, ok := rgc.img.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); ok {
+		if img, ok := sub.SubImage(*rgc.clip).(draw.Image); ok {
+			return img
+		}
+	}
+	return rgc.img
+}
+
 // Clear fills the current canvas with a default transparent color
 func (rgc *RasterGraphicContext) Clear() {
 	width, height := rgc.img.Bounds().Dx(), rgc.img.Bounds().Dy()
@@ -70,12 +97,12 @@ func (rgc *RasterGraphicContext) Clear() {
 // ClearRect fills the current canvas with a default transparent color at the specified rectangle
 func (rgc *RasterGraphicContext) ClearRect(x1, y1, x2, y2 int) {
 	imageColor := image.NewUniform(rgc.current.FillColor)
-	draw.Draw(rgc.img, image.Rect(x1, y1, x2, y2), imageColor, image.ZP, draw.Over)
+	draw.Draw(rgc.clipped(), image.Rect(x1, y1, x2, y2), imageColor, image.ZP, draw.Over)
 }
 
 // DrawImage draws the raster image in the current canvas
 func (rgc *RasterGraphicContext) DrawImage(img image.Image) {
-	DrawImage(img, rgc.img, rgc.current.Tr, draw.Over, BilinearFilter)
+	DrawImage(img, rgc.clipped(), rgc.current.Tr, draw.Over, BilinearFilter)
 }
 
 // FillString draws the text at point (0, 0)
@@ -214,7 +241,11 @@ func (rgc *RasterGraphicContext) SetFontSize(fontSizePoints float64) {
 
 func (rgc *RasterGraphicContext) paint(rasterizer *raster.Rasterizer, color color.Color) {
 	rgc.painter.SetColor(color)
-	rasterizer.Rasterize(rgc.painter)
+	if rgc.clip != nil {
+		rasterizer.Rasterize(clipPainter{Painter: rgc.painter, clip: *rgc.clip})
+	} else {
+		rasterizer.Rasterize(rgc.painter)
+	}
 	rasterizer.Clear()
 	rgc.current.Path.Clear()
 }
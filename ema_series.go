@@ -75,7 +75,7 @@ func (ema *EMASeries) GetValues(index int) (x, y float64) {
 
 // GetFirstValues computes the first moving average value.
 func (ema *EMASeries) GetFirstValues() (x, y float64) {
-	if ema.InnerSeries == nil {
+	if ema.InnerSeries == nil || ema.InnerSeries.Len() == 0 {
 		return
 	}
 	if len(ema.cache) == 0 {
@@ -89,7 +89,7 @@ func (ema *EMASeries) GetFirstValues() (x, y float64) {
 // GetLastValues computes the last moving average value but walking back window size samples,
 // and recomputing the last moving average chunk.
 func (ema *EMASeries) GetLastValues() (x, y float64) {
-	if ema.InnerSeries == nil {
+	if ema.InnerSeries == nil || ema.InnerSeries.Len() == 0 {
 		return
 	}
 	if len(ema.cache) == 0 {
@@ -129,3 +129,10 @@ func (ema *EMASeries) Validate() error {
 	}
 	return nil
 }
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (ema EMASeries) CopySeries() Series {
+	ema.InnerSeries = copyValuesProvider(ema.InnerSeries)
+	ema.cache = nil
+	return &ema
+}
@@ -0,0 +1,157 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// EndLabelPosition is an enum for which ends of a series an end label is drawn at.
+type EndLabelPosition int
+
+const (
+	// EndLabelPositionUnset is the unset state for end label positions; it defaults to `EndLabelPositionEnd`.
+	EndLabelPositionUnset EndLabelPosition = 0
+	// EndLabelPositionEnd labels the last value of the series, to its right.
+	EndLabelPositionEnd EndLabelPosition = 1
+	// EndLabelPositionStart labels the first value of the series, to its left.
+	EndLabelPositionStart EndLabelPosition = 2
+	// EndLabelPositionBoth labels the first and last values of the series.
+	EndLabelPositionBoth EndLabelPosition = 3
+)
+
+// Interface Assertions.
+var (
+	_ Series         = (*EndLabelSeries)(nil)
+	_ MeasuredSeries = (*EndLabelSeries)(nil)
+)
+
+// EndLabelSeries labels a series inline, with text beside its first and/or last values,
+// as an alternative to a legend.
+type EndLabelSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// Label is the text of the label, it defaults to the name of the inner series.
+	Label    string
+	Position EndLabelPosition
+
+	InnerSeries ValuesProvider
+}
+
+// GetName returns the name of the time series.
+func (els EndLabelSeries) GetName() string {
+	return els.Name
+}
+
+// GetStyle returns the line style.
+func (els EndLabelSeries) GetStyle() Style {
+	return els.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (els EndLabelSeries) GetYAxis() YAxisType {
+	return els.YAxis
+}
+
+// GetLabel returns the label text or the name of the inner series.
+func (els EndLabelSeries) GetLabel() string {
+	if len(els.Label) == 0 {
+		if typed, isTyped := els.InnerSeries.(NameProvider); isTyped {
+			return typed.GetName()
+		}
+	}
+	return els.Label
+}
+
+// GetPosition returns the label position or a default.
+func (els EndLabelSeries) GetPosition() EndLabelPosition {
+	if els.Position == EndLabelPositionUnset {
+		return EndLabelPositionEnd
+	}
+	return els.Position
+}
+
+// Measure returns the bounds of the labels.
+func (els EndLabelSeries) Measure(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) Box {
+	box := Box{
+		Top:    math.MaxInt32,
+		Left:   math.MaxInt32,
+		Right:  0,
+		Bottom: 0,
+	}
+	style := els.getStyle(defaults)
+	for _, lb := range els.getLabelBoxes(r, canvasBox, xrange, yrange, style) {
+		box.Top = MinInt(box.Top, lb.Top)
+		box.Left = MinInt(box.Left, lb.Left)
+		box.Right = MaxInt(box.Right, lb.Right)
+		box.Bottom = MaxInt(box.Bottom, lb.Bottom)
+	}
+	return box
+}
+
+// Render draws the labels.
+func (els EndLabelSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := els.getStyle(defaults)
+	label := els.GetLabel()
+	for _, lb := range els.getLabelBoxes(r, canvasBox, xrange, yrange, style) {
+		Draw.Text(r, label, lb.Left, lb.Bottom, style)
+	}
+}
+
+// Validate validates the series.
+func (els EndLabelSeries) Validate() error {
+	if els.InnerSeries == nil {
+		return fmt.Errorf("end label series requires InnerSeries to be set")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (els EndLabelSeries) CopySeries() Series {
+	els.InnerSeries = copyValuesProvider(els.InnerSeries)
+	return els
+}
+
+func (els EndLabelSeries) getStyle(defaults Style) Style {
+	return els.Style.InheritFrom(Style{
+		Font:      defaults.Font,
+		FontSize:  defaults.GetFontSize(DefaultFontSize),
+		FontColor: defaults.StrokeColor,
+	})
+}
+
+// getLabelBoxes returns the boxes of the labels beside the first and last values.
+func (els EndLabelSeries) getLabelBoxes(r Renderer, canvasBox Box, xrange, yrange Range, style Style) (boxes []Box) {
+	length := els.InnerSeries.Len()
+	label := els.GetLabel()
+	if length == 0 || len(label) == 0 {
+		return
+	}
+
+	tb := Draw.MeasureText(r, label, style)
+	position := els.GetPosition()
+	labelBox := func(index int, toLeft bool) Box {
+		vx, vy := els.InnerSeries.GetValues(index)
+		x := canvasBox.Left + xrange.Translate(vx)
+		y := canvasBox.Bottom - yrange.Translate(vy)
+		left := x + DefaultEndLabelGap
+		if toLeft {
+			left = x - DefaultEndLabelGap - tb.Width()
+		}
+		return Box{
+			Top:    y - tb.Height()>>1,
+			Left:   left,
+			Right:  left + tb.Width(),
+			Bottom: y - tb.Height()>>1 + tb.Height(),
+		}
+	}
+
+	if position == EndLabelPositionStart || position == EndLabelPositionBoth {
+		boxes = append(boxes, labelBox(0, true))
+	}
+	if position == EndLabelPositionEnd || position == EndLabelPositionBoth {
+		boxes = append(boxes, labelBox(length-1, false))
+	}
+	return
+}
@@ -0,0 +1,49 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestEndLabelSeriesMeasure(t *testing.T) {
+	// replaced new assertions helper
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	r, err := PNG(200, 200)
+	testutil.AssertNil(t, err)
+
+	inner := ContinuousSeries{
+		Name:    "inner",
+		XValues: []float64{0, 10},
+		YValues: []float64{0, 10},
+	}
+	els := EndLabelSeries{InnerSeries: inner}
+	testutil.AssertNil(t, els.Validate())
+	testutil.AssertEqual(t, "inner", els.GetLabel())
+	testutil.AssertEqual(t, EndLabelPositionEnd, els.GetPosition())
+
+	canvasBox := Box{Top: 50, Left: 50, Right: 150, Bottom: 150}
+	xrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	defaults := Style{Font: f, FontSize: 10}
+
+	end := els.Measure(r, canvasBox, xrange, yrange, defaults)
+	testutil.AssertEqual(t, 150+DefaultEndLabelGap, end.Left)
+	testutil.AssertTrue(t, end.Top < 50 && end.Bottom > 50)
+
+	els.Position = EndLabelPositionStart
+	start := els.Measure(r, canvasBox, xrange, yrange, defaults)
+	testutil.AssertEqual(t, 50-DefaultEndLabelGap, start.Right)
+	testutil.AssertTrue(t, start.Top < 150 && start.Bottom > 150)
+
+	els.Position = EndLabelPositionBoth
+	both := els.Measure(r, canvasBox, xrange, yrange, defaults)
+	testutil.AssertEqual(t, start.Left, both.Left)
+	testutil.AssertEqual(t, end.Right, both.Right)
+
+	els.Label = "custom"
+	testutil.AssertEqual(t, "custom", els.GetLabel())
+	testutil.AssertNotNil(t, EndLabelSeries{}.Validate())
+}
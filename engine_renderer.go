@@ -0,0 +1,265 @@
+package chart
+
+import (
+	"io"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"golang.org/x/image/font"
+)
+
+// Engine is the minimal set of drawing operations an external 2d graphics library, e.g. cairo or skia
+// bindings, needs to provide to render charts through `EngineRenderer`.
+//
+// Coordinates are in pixels from the top left of the canvas. The path calls build up a current path,
+// which `DrawPath` paints and then clears; arcs and circles arrive already flattened into lines, and
+// text arrives already laid out, so an engine needs no notion of styles, angles or text measurement.
+type Engine interface {
+	MoveTo(x, y float64)
+	LineTo(x, y float64)
+	QuadCurveTo(cx, cy, x, y float64)
+	ClosePath()
+
+	// DrawPath fills the current path, then strokes it, skipping either paint if it is nil, and clears the path.
+	DrawPath(fill, stroke *EnginePaint)
+	// DrawText draws text in a font at a pixel size, with its baseline starting at a point and rotated
+	// clockwise about it by an angle in radians.
+	DrawText(text string, x, y float64, f *truetype.Font, size float64, color drawing.Color, radians float64)
+
+	// Save writes the drawing to a writer in the format of the engine.
+	Save(w io.Writer) error
+}
+
+// EnginePaint is a color, and for strokes a width and dash pattern, that an `Engine` paints a path with.
+type EnginePaint struct {
+	Color     drawing.Color
+	Width     float64
+	DashArray []float64
+}
+
+// EngineRenderer returns a renderer provider that draws charts with an `Engine`, made for each chart
+// by a given function from the chart width and height.
+func EngineRenderer(newEngine func(width, height int) (Engine, error)) RendererProvider {
+	return func(width, height int) (Renderer, error) {
+		engine, err := newEngine(width, height)
+		if err != nil {
+			return nil, err
+		}
+		return &engineRenderer{
+			e:   engine,
+			dpi: DefaultDPI,
+		}, nil
+	}
+}
+
+// engineRenderer adapts an `Engine` to the `Renderer` interface, keeping the style state and laying
+// out text with the truetype fonts, as the svg renderer does.
+type engineRenderer struct {
+	e   Engine
+	dpi float64
+	s   Style
+
+	// hasPath is whether the current path has a point, that arcs join with a line,
+	// and x and y are that point, where cubic curves start.
+	hasPath       bool
+	x, y          float64
+	rotateRadians *float64
+}
+
+// ResetStyle implements the interface method.
+func (er *engineRenderer) ResetStyle() {
+	er.s = Style{Font: er.s.Font}
+	er.rotateRadians = nil
+}
+
+// GetDPI returns the dpi.
+func (er *engineRenderer) GetDPI() float64 {
+	return er.dpi
+}
+
+// SetDPI implements the interface method.
+func (er *engineRenderer) SetDPI(dpi float64) {
+	er.dpi = dpi
+}
+
+// SetClassName implements the interface method. However, engines have no classes.
+func (er *engineRenderer) SetClassName(_ string) {}
+
+// SetStrokeColor implements the interface method.
+func (er *engineRenderer) SetStrokeColor(c drawing.Color) {
+	er.s.StrokeColor = c
+}
+
+// SetFillColor implements the interface method.
+func (er *engineRenderer) SetFillColor(c drawing.Color) {
+	er.s.FillColor = c
+}
+
+// SetStrokeWidth implements the interface method.
+func (er *engineRenderer) SetStrokeWidth(width float64) {
+	er.s.StrokeWidth = width
+}
+
+// SetStrokeDashArray implements the interface method.
+func (er *engineRenderer) SetStrokeDashArray(dashArray []float64) {
+	er.s.StrokeDashArray = dashArray
+}
+
+// MoveTo implements the interface method.
+func (er *engineRenderer) MoveTo(x, y int) {
+	er.e.MoveTo(float64(x), float64(y))
+	er.setPoint(float64(x), float64(y))
+}
+
+// LineTo implements the interface method.
+func (er *engineRenderer) LineTo(x, y int) {
+	er.e.LineTo(float64(x), float64(y))
+	er.setPoint(float64(x), float64(y))
+}
+
+// QuadCurveTo implements the interface method.
+func (er *engineRenderer) QuadCurveTo(cx, cy, x, y int) {
+	er.e.QuadCurveTo(float64(cx), float64(cy), float64(x), float64(y))
+	er.setPoint(float64(x), float64(y))
+}
+
+// CubicCurveTo implements CubicCurveRenderer, flattening the curve into lines from the current point.
+func (er *engineRenderer) CubicCurveTo(cx1, cy1, cx2, cy2, x, y int) {
+	x0, y0 := er.x, er.y
+	if !er.hasPath {
+		x0, y0 = float64(cx1), float64(cy1)
+		er.e.MoveTo(x0, y0)
+	}
+	for index := 1; index <= cubicCurveSegments; index++ {
+		t := float64(index) / cubicCurveSegments
+		er.e.LineTo(cubicBezier(t, x0, float64(cx1), float64(cx2), float64(x)), cubicBezier(t, y0, float64(cy1), float64(cy2), float64(y)))
+	}
+	er.setPoint(float64(x), float64(y))
+}
+
+func (er *engineRenderer) setPoint(x, y float64) {
+	er.x, er.y = x, y
+	er.hasPath = true
+}
+
+// ArcTo implements the interface method, flattening the arc into lines; like the other renderers it
+// joins the arc to the current path with a line, or starts a new path at the start of the arc.
+func (er *engineRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	segments := MaxInt(1, int(math.Ceil(math.Abs(delta)/(_2pi/64))))
+	for index := 0; index <= segments; index++ {
+		theta := startAngle + delta*float64(index)/float64(segments)
+		x, y := float64(cx)+rx*math.Cos(theta), float64(cy)+ry*math.Sin(theta)
+		if index == 0 && !er.hasPath {
+			er.e.MoveTo(x, y)
+		} else {
+			er.e.LineTo(x, y)
+		}
+		er.setPoint(x, y)
+	}
+}
+
+// Close implements the interface method.
+func (er *engineRenderer) Close() {
+	er.e.ClosePath()
+}
+
+// Stroke implements the interface method.
+func (er *engineRenderer) Stroke() {
+	er.drawPath(false, true)
+}
+
+// Fill implements the interface method.
+func (er *engineRenderer) Fill() {
+	er.drawPath(true, false)
+}
+
+// FillStroke implements the interface method.
+func (er *engineRenderer) FillStroke() {
+	er.drawPath(true, true)
+}
+
+// drawPath paints the current path with the fill and stroke of the style, leaving out any that are unset.
+func (er *engineRenderer) drawPath(fill, stroke bool) {
+	var fillPaint, strokePaint *EnginePaint
+	if fill && !er.s.FillColor.IsZero() {
+		fillPaint = &EnginePaint{Color: er.s.FillColor}
+	}
+	if stroke && !er.s.StrokeColor.IsZero() && er.s.StrokeWidth > 0 {
+		strokePaint = &EnginePaint{
+			Color:     er.s.StrokeColor,
+			Width:     er.s.StrokeWidth,
+			DashArray: er.s.StrokeDashArray,
+		}
+	}
+	er.e.DrawPath(fillPaint, strokePaint)
+	er.hasPath = false
+}
+
+// Circle implements the interface method, as a closed path that is not filled or stroked.
+func (er *engineRenderer) Circle(radius float64, x, y int) {
+	er.hasPath = false
+	er.ArcTo(x, y, radius, radius, 0, _2pi)
+	er.e.ClosePath()
+}
+
+// SetFont implements the interface method.
+func (er *engineRenderer) SetFont(f *truetype.Font) {
+	er.s.Font = f
+}
+
+// SetFontColor implements the interface method.
+func (er *engineRenderer) SetFontColor(c drawing.Color) {
+	er.s.FontColor = c
+}
+
+// SetFontSize implements the interface method.
+func (er *engineRenderer) SetFontSize(size float64) {
+	er.s.FontSize = size
+}
+
+// Text implements the interface method.
+func (er *engineRenderer) Text(body string, x, y int) {
+	if er.s.Font == nil {
+		return
+	}
+	var radians float64
+	if er.rotateRadians != nil {
+		radians = *er.rotateRadians
+	}
+	er.e.DrawText(body, float64(x), float64(y), er.s.Font, drawing.PointsToPixels(er.dpi, er.s.FontSize), er.s.FontColor, radians)
+}
+
+// MeasureText uses the truetype font drawer to measure the width of text.
+func (er *engineRenderer) MeasureText(body string) (box Box) {
+	if er.s.Font == nil {
+		return
+	}
+	fd := &font.Drawer{
+		Face: truetype.NewFace(er.s.Font, &truetype.Options{
+			DPI:  er.dpi,
+			Size: er.s.FontSize,
+		}),
+	}
+	box.Right = fd.MeasureString(body).Ceil()
+	box.Bottom = int(drawing.PointsToPixels(er.dpi, er.s.FontSize))
+	if er.rotateRadians == nil {
+		return
+	}
+	return box.Corners().Rotate(RadiansToDegrees(*er.rotateRadians)).Box()
+}
+
+// SetTextRotation implements the interface method.
+func (er *engineRenderer) SetTextRotation(radians float64) {
+	er.rotateRadians = &radians
+}
+
+// ClearTextRotation implements the interface method.
+func (er *engineRenderer) ClearTextRotation() {
+	er.rotateRadians = nil
+}
+
+// Save implements the interface method.
+func (er *engineRenderer) Save(w io.Writer) error {
+	return er.e.Save(w)
+}
@@ -0,0 +1,116 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+// recordingEngine is an engine that records the calls made to it.
+type recordingEngine struct {
+	calls []string
+	paths int
+	texts []string
+}
+
+func (re *recordingEngine) MoveTo(x, y float64) {
+	re.calls = append(re.calls, fmt.Sprintf("M %.0f %.0f", x, y))
+}
+
+func (re *recordingEngine) LineTo(x, y float64) {
+	re.calls = append(re.calls, fmt.Sprintf("L %.0f %.0f", x, y))
+}
+
+func (re *recordingEngine) QuadCurveTo(cx, cy, x, y float64) {
+	re.calls = append(re.calls, fmt.Sprintf("Q %.0f %.0f %.0f %.0f", cx, cy, x, y))
+}
+
+func (re *recordingEngine) ClosePath() {
+	re.calls = append(re.calls, "Z")
+}
+
+func (re *recordingEngine) DrawPath(fill, stroke *EnginePaint) {
+	re.paths++
+	re.calls = append(re.calls, fmt.Sprintf("draw %v %v", fill != nil, stroke != nil))
+}
+
+func (re *recordingEngine) DrawText(text string, x, y float64, f *truetype.Font, size float64, color drawing.Color, radians float64) {
+	re.texts = append(re.texts, text)
+}
+
+func (re *recordingEngine) Save(w io.Writer) error {
+	_, err := w.Write([]byte("saved"))
+	return err
+}
+
+func TestEngineRendererPaths(t *testing.T) {
+	// replaced new assertions helper
+
+	engine := &recordingEngine{}
+	r, err := EngineRenderer(func(_, _ int) (Engine, error) { return engine, nil })(100, 100)
+	testutil.AssertNil(t, err)
+
+	r.SetFillColor(ColorBlue)
+	r.MoveTo(0, 0)
+	r.LineTo(10, 0)
+	r.Close()
+	r.Stroke()
+	testutil.AssertEqual(t, []string{"M 0 0", "L 10 0", "Z", "draw false false"}, engine.calls)
+
+	// an arc starts a new path at its start, and is joined to an existing path with a line.
+	engine.calls = nil
+	r.ArcTo(0, 0, 10, 10, 0, _pi2)
+	testutil.AssertEqual(t, "M 10 0", engine.calls[0])
+	testutil.AssertEqual(t, "L 0 10", engine.calls[len(engine.calls)-1])
+	r.ArcTo(0, 0, 20, 20, 0, _pi2)
+	testutil.AssertEqual(t, "L 0 20", engine.calls[len(engine.calls)-1])
+
+	// a cubic curve is flattened into lines from the end of the arc.
+	engine.calls = nil
+	r.(CubicCurveRenderer).CubicCurveTo(0, 30, 30, 30, 30, 0)
+	testutil.AssertLen(t, engine.calls, 16)
+	testutil.AssertEqual(t, "L 30 0", engine.calls[15])
+
+	r.SetStrokeColor(ColorRed)
+	r.SetStrokeWidth(2)
+	r.FillStroke()
+	testutil.AssertEqual(t, "draw true true", engine.calls[len(engine.calls)-1])
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(b))
+	testutil.AssertEqual(t, "saved", b.String())
+}
+
+func TestEngineRendererChart(t *testing.T) {
+	// replaced new assertions helper
+
+	engine := &recordingEngine{}
+	c := Chart{
+		Title: "Test",
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 3, 2},
+			},
+		},
+	}
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(EngineRenderer(func(_, _ int) (Engine, error) { return engine, nil }), b))
+	testutil.AssertNotZero(t, engine.paths)
+	testutil.AssertNotEmpty(t, engine.texts)
+	testutil.AssertContains(t, strings.Join(engine.texts, "\n"), "Test")
+
+	r, _ := EngineRenderer(func(_, _ int) (Engine, error) { return engine, nil })(100, 100)
+	font, _ := GetDefaultFont()
+	r.SetFont(font)
+	r.SetFontSize(10)
+	tb := r.MeasureText("Test")
+	testutil.AssertNotZero(t, tb.Width())
+	testutil.AssertNotZero(t, tb.Height())
+}
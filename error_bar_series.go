@@ -0,0 +1,164 @@
+package chart
+
+import "fmt"
+
+const (
+	// DefaultErrorBarCapWidth is the default pixel distance from an error bar to the end of its caps.
+	DefaultErrorBarCapWidth = 4
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*ErrorBarSeries)(nil)
+	_ BoundedValuesProvider = (*ErrorBarSeries)(nil)
+)
+
+// ErrorBarSeries draws a vertical error bar, with caps at either end, through each value of an inner
+// series, e.g. the standard error of each measurement of a line. It is drawn separately from the inner
+// series, which should also be added to the chart to draw the line itself.
+//
+// Errors are symmetric, from `Errors`, unless `LowerErrors` or `UpperErrors` are set, which give the
+// distances below and above each value. Each error is a distance from the value and should not be negative.
+type ErrorBarSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries ValuesProvider
+
+	Errors      []float64
+	LowerErrors []float64
+	UpperErrors []float64
+
+	// CapWidth is the pixel distance from the bar to the end of each cap; set it to `Disabled` to leave the caps out.
+	CapWidth int
+}
+
+// GetName returns the name of the series.
+func (ebs ErrorBarSeries) GetName() string {
+	return ebs.Name
+}
+
+// GetStyle returns the series style.
+func (ebs ErrorBarSeries) GetStyle() Style {
+	return ebs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ebs ErrorBarSeries) GetYAxis() YAxisType {
+	return ebs.YAxis
+}
+
+// GetCapWidth returns the cap width or the default.
+func (ebs ErrorBarSeries) GetCapWidth() int {
+	if ebs.CapWidth == 0 {
+		return DefaultErrorBarCapWidth
+	}
+	if ebs.CapWidth == Disabled {
+		return 0
+	}
+	return ebs.CapWidth
+}
+
+// GetErrors returns the error below and above a value of the inner series.
+func (ebs ErrorBarSeries) GetErrors(index int) (lower, upper float64) {
+	if len(ebs.LowerErrors) > 0 || len(ebs.UpperErrors) > 0 {
+		if index < len(ebs.LowerErrors) {
+			lower = ebs.LowerErrors[index]
+		}
+		if index < len(ebs.UpperErrors) {
+			upper = ebs.UpperErrors[index]
+		}
+		return
+	}
+	if index < len(ebs.Errors) {
+		lower, upper = ebs.Errors[index], ebs.Errors[index]
+	}
+	return
+}
+
+// Len returns the number of values of the inner series.
+func (ebs ErrorBarSeries) Len() int {
+	if ebs.InnerSeries == nil {
+		return 0
+	}
+	return ebs.InnerSeries.Len()
+}
+
+// GetBoundedValues returns a value of the inner series, with the top and bottom of its error bar.
+func (ebs ErrorBarSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	x, y := ebs.InnerSeries.GetValues(index)
+	lower, upper := ebs.GetErrors(index)
+	return x, y + upper, y - lower
+}
+
+func (ebs ErrorBarSeries) styleDefaults(defaults Style) Style {
+	strokeColor := defaults.StrokeColor
+	if typed, isTyped := ebs.InnerSeries.(Series); isTyped && !typed.GetStyle().StrokeColor.IsZero() {
+		strokeColor = typed.GetStyle().StrokeColor
+	}
+	return Style{
+		StrokeColor: strokeColor,
+		StrokeWidth: DefaultSeriesLineWidth,
+	}
+}
+
+// Render renders the series.
+func (ebs ErrorBarSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if ebs.Len() == 0 {
+		return
+	}
+	style := ebs.Style.InheritFrom(ebs.styleDefaults(defaults))
+	capWidth := ebs.GetCapWidth()
+
+	style.GetStrokeOptions().WriteToRenderer(r)
+	for index := 0; index < ebs.Len(); index++ {
+		vx, vy1, vy2 := ebs.GetBoundedValues(index)
+		if vy1 == vy2 {
+			continue
+		}
+		x := canvasBox.Left + xrange.Translate(vx)
+		top := canvasBox.Bottom - yrange.Translate(vy1)
+		bottom := canvasBox.Bottom - yrange.Translate(vy2)
+
+		r.MoveTo(x, top)
+		r.LineTo(x, bottom)
+		if capWidth > 0 {
+			r.MoveTo(x-capWidth, top)
+			r.LineTo(x+capWidth, top)
+			r.MoveTo(x-capWidth, bottom)
+			r.LineTo(x+capWidth, bottom)
+		}
+	}
+	r.Stroke()
+}
+
+// Validate validates the series.
+func (ebs ErrorBarSeries) Validate() error {
+	if ebs.InnerSeries == nil {
+		return fmt.Errorf("error bar series requires InnerSeries to be set")
+	}
+	for _, errors := range [][]float64{ebs.Errors, ebs.LowerErrors, ebs.UpperErrors} {
+		for _, e := range errors {
+			if e < 0 {
+				return fmt.Errorf("error bar series errors cannot be negative")
+			}
+		}
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series whose inner series and errors are not shared with the original.
+func (ebs ErrorBarSeries) CopySeries() Series {
+	ebs.InnerSeries = copyValuesProvider(ebs.InnerSeries)
+	if ebs.Errors != nil {
+		ebs.Errors = append([]float64(nil), ebs.Errors...)
+	}
+	if ebs.LowerErrors != nil {
+		ebs.LowerErrors = append([]float64(nil), ebs.LowerErrors...)
+	}
+	if ebs.UpperErrors != nil {
+		ebs.UpperErrors = append([]float64(nil), ebs.UpperErrors...)
+	}
+	return ebs
+}
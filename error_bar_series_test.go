@@ -0,0 +1,81 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestErrorBarSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, ErrorBarSeries{}.Validate())
+
+	inner := ContinuousSeries{
+		Style:   Style{StrokeColor: drawing.ColorRed},
+		XValues: []float64{1.0, 2.0, 3.0},
+		YValues: []float64{3.0, 1.0, 2.0},
+	}
+	ebs := ErrorBarSeries{InnerSeries: inner, Errors: []float64{0.5, 1.0}}
+	testutil.AssertNil(t, ebs.Validate())
+	testutil.AssertEqual(t, 3, ebs.Len())
+	testutil.AssertEqual(t, DefaultErrorBarCapWidth, ebs.GetCapWidth())
+	testutil.AssertEqual(t, drawing.ColorRed, ebs.styleDefaults(Style{}).StrokeColor)
+
+	x, y1, y2 := ebs.GetBoundedValues(0)
+	testutil.AssertEqual(t, 1.0, x)
+	testutil.AssertEqual(t, 3.5, y1)
+	testutil.AssertEqual(t, 2.5, y2)
+	_, y1, y2 = ebs.GetBoundedValues(2)
+	testutil.AssertEqual(t, 2.0, y1)
+	testutil.AssertEqual(t, 2.0, y2)
+
+	ebs.UpperErrors = []float64{2.0}
+	lower, upper := ebs.GetErrors(0)
+	testutil.AssertEqual(t, 0.0, lower)
+	testutil.AssertEqual(t, 2.0, upper)
+
+	ebs.LowerErrors = []float64{-1}
+	testutil.AssertNotNil(t, ebs.Validate())
+
+	ebs.CapWidth = Disabled
+	testutil.AssertEqual(t, 0, ebs.GetCapWidth())
+}
+
+func TestErrorBarSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	inner := ContinuousSeries{
+		XValues: []float64{1.0, 2.0},
+		YValues: []float64{1.0, 2.0},
+	}
+	c := Chart{
+		Series: []Series{
+			inner,
+			ErrorBarSeries{InnerSeries: inner, LowerErrors: []float64{1.0, 1.0}, UpperErrors: []float64{2.0, 2.0}},
+		},
+	}
+	xrange, yrange, _ := c.getRanges()
+	testutil.AssertEqual(t, 1.0, xrange.GetMin())
+	testutil.AssertEqual(t, 0.0, yrange.GetMin())
+	testutil.AssertEqual(t, 4.0, yrange.GetMax())
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+}
+
+func TestErrorBarSeriesCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	inner := ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{3, 4}}
+	ebs := ErrorBarSeries{InnerSeries: inner, Errors: []float64{0.5, 1}}
+	copied := ebs.CopySeries().(ErrorBarSeries)
+	inner.YValues[0] = 10
+	copied.Errors[0] = 10
+	testutil.AssertEqual(t, 0.5, ebs.Errors[0])
+	_, y := copied.InnerSeries.GetValues(0)
+	testutil.AssertEqual(t, 3.0, y)
+	testutil.AssertNil(t, copied.LowerErrors)
+}
@@ -0,0 +1,160 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series = (*EventSeries)(nil)
+)
+
+// EventPosition is an enum for where event markers are pinned on the canvas.
+type EventPosition int
+
+const (
+	// EventPositionUnset is the unset state for event positions; events are pinned to the top.
+	EventPositionUnset EventPosition = 0
+	// EventPositionTop pins events to the top of the canvas.
+	EventPositionTop EventPosition = 1
+	// EventPositionBottom pins events to the bottom of the canvas.
+	EventPositionBottom EventPosition = 2
+)
+
+// Event is a marker at a given x value, such as a deploy or a news event.
+// For time series charts use `TimeToFloat64` for the x value.
+type Event struct {
+	Style  Style
+	XValue float64
+	Label  string
+	Marker Marker
+}
+
+// EventSeries draws markers at x values pinned to the top or bottom of the canvas, rather than at y values.
+type EventSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Position EventPosition
+	Marker   Marker
+	Events   []Event
+
+	// Lines draws a line across the canvas at each event.
+	Lines bool
+}
+
+// GetName returns the name of the time series.
+func (es EventSeries) GetName() string {
+	return es.Name
+}
+
+// GetStyle returns the line style.
+func (es EventSeries) GetStyle() Style {
+	return es.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (es EventSeries) GetYAxis() YAxisType {
+	return es.YAxis
+}
+
+// GetPosition returns the event position or a default.
+func (es EventSeries) GetPosition(defaults ...EventPosition) EventPosition {
+	if es.Position == EventPositionUnset {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return EventPositionTop
+	}
+	return es.Position
+}
+
+// GetMarker returns the marker for an event, falling back to the series marker, and then to a
+// triangle pointing into the canvas.
+func (es EventSeries) GetMarker(e Event) Marker {
+	if e.Marker != MarkerUnset {
+		return e.Marker
+	}
+	if es.Marker != MarkerUnset {
+		return es.Marker
+	}
+	if es.GetPosition() == EventPositionBottom {
+		return MarkerTriangle
+	}
+	return MarkerTriangleDown
+}
+
+func (es EventSeries) eventStyleDefaults(defaults Style) Style {
+	return Style{
+		StrokeColor: defaults.StrokeColor,
+		StrokeWidth: defaults.StrokeWidth,
+		FillColor:   defaults.StrokeColor,
+		DotWidth:    DefaultMarkerSize,
+		FontColor:   DefaultTextColor,
+		FontSize:    DefaultAxisFontSize,
+		Font:        defaults.Font,
+	}
+}
+
+// Render draws the series.
+func (es EventSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if es.Style.Hidden {
+		return
+	}
+
+	seriesStyle := es.Style.InheritFrom(es.eventStyleDefaults(defaults))
+	min, max := math.Min(xrange.GetMin(), xrange.GetMax()), math.Max(xrange.GetMin(), xrange.GetMax())
+	for _, e := range es.Events {
+		if e.XValue < min || e.XValue > max {
+			continue
+		}
+
+		style := e.Style.InheritFrom(seriesStyle)
+		size := style.GetDotWidth()
+		x := canvasBox.Left + xrange.Translate(e.XValue)
+
+		if es.Lines {
+			style.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(x, canvasBox.Top)
+			r.LineTo(x, canvasBox.Bottom)
+			r.Stroke()
+			r.ResetStyle()
+		}
+
+		var y int
+		if es.GetPosition() == EventPositionBottom {
+			y = canvasBox.Bottom - int(size)
+		} else {
+			y = canvasBox.Top + int(size)
+		}
+		Draw.Marker(r, es.GetMarker(e), x, y, size, style)
+
+		if len(e.Label) > 0 {
+			textBox := Draw.MeasureText(r, e.Label, style)
+			tx := x - (textBox.Width() >> 1)
+			var ty int
+			if es.GetPosition() == EventPositionBottom {
+				ty = y - int(size) - DefaultMarkerLabelGap
+			} else {
+				ty = y + int(size) + DefaultMarkerLabelGap + textBox.Height()
+			}
+			Draw.Text(r, e.Label, tx, ty, style)
+		}
+	}
+}
+
+// Validate validates the series.
+func (es EventSeries) Validate() error {
+	if len(es.Events) == 0 {
+		return fmt.Errorf("event series requires events to be set and not empty")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its events with the original.
+func (es EventSeries) CopySeries() Series {
+	es.Events = append([]Event(nil), es.Events...)
+	return es
+}
@@ -0,0 +1,51 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestEventSeriesGetMarker(t *testing.T) {
+	// replaced new assertions helper
+
+	es := EventSeries{}
+	testutil.AssertEqual(t, EventPositionTop, es.GetPosition())
+	testutil.AssertEqual(t, MarkerTriangleDown, es.GetMarker(Event{}))
+	testutil.AssertEqual(t, MarkerCircle, es.GetMarker(Event{Marker: MarkerCircle}))
+
+	es.Position = EventPositionBottom
+	testutil.AssertEqual(t, MarkerTriangle, es.GetMarker(Event{}))
+
+	es.Marker = MarkerSquare
+	testutil.AssertEqual(t, MarkerSquare, es.GetMarker(Event{}))
+	testutil.AssertEqual(t, MarkerDiamond, es.GetMarker(Event{Marker: MarkerDiamond}))
+}
+
+func TestEventSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, EventSeries{}.Validate())
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+			EventSeries{
+				Lines: true,
+				Events: []Event{
+					{XValue: 1.5, Label: "deploy"},
+					{XValue: 10.0, Label: "out of range"},
+				},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), "deploy")
+	testutil.AssertNotContains(t, buffer.String(), "out of range")
+}
@@ -0,0 +1,143 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"image"
+	"image/png"
+	"io"
+	"math"
+	"os"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+)
+
+// vectorEngine is an example `chart.Engine` that draws with the golang.org/x/image/vector rasterizer in
+// place of the built in one; an adapter for cairo or skia bindings follows the same shape.
+type vectorEngine struct {
+	img   *image.RGBA
+	paths [][][2]float64
+}
+
+func newVectorEngine(width, height int) (chart.Engine, error) {
+	return &vectorEngine{img: image.NewRGBA(image.Rect(0, 0, width, height))}, nil
+}
+
+func (ve *vectorEngine) MoveTo(x, y float64) {
+	ve.paths = append(ve.paths, [][2]float64{{x, y}})
+}
+
+func (ve *vectorEngine) LineTo(x, y float64) {
+	if len(ve.paths) == 0 {
+		ve.MoveTo(x, y)
+		return
+	}
+	ve.paths[len(ve.paths)-1] = append(ve.paths[len(ve.paths)-1], [2]float64{x, y})
+}
+
+// QuadCurveTo flattens the curve into lines, as strokes are drawn a line at a time.
+func (ve *vectorEngine) QuadCurveTo(cx, cy, x, y float64) {
+	if len(ve.paths) == 0 {
+		ve.MoveTo(x, y)
+		return
+	}
+	path := ve.paths[len(ve.paths)-1]
+	x0, y0 := path[len(path)-1][0], path[len(path)-1][1]
+	for step := 1; step <= 16; step++ {
+		t := float64(step) / 16
+		ve.LineTo(
+			(1-t)*(1-t)*x0+2*(1-t)*t*cx+t*t*x,
+			(1-t)*(1-t)*y0+2*(1-t)*t*cy+t*t*y,
+		)
+	}
+}
+
+func (ve *vectorEngine) ClosePath() {
+	if len(ve.paths) == 0 {
+		return
+	}
+	path := ve.paths[len(ve.paths)-1]
+	ve.LineTo(path[0][0], path[0][1])
+}
+
+func (ve *vectorEngine) DrawPath(fill, stroke *chart.EnginePaint) {
+	bounds := ve.img.Bounds()
+	if fill != nil {
+		z := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+		for _, path := range ve.paths {
+			z.MoveTo(float32(path[0][0]), float32(path[0][1]))
+			for _, p := range path[1:] {
+				z.LineTo(float32(p[0]), float32(p[1]))
+			}
+			z.ClosePath()
+		}
+		z.Draw(ve.img, bounds, image.NewUniform(fill.Color), image.Point{})
+	}
+	if stroke != nil {
+		// stroke each line as a thin quad; this example engine ignores dashes.
+		z := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+		half := stroke.Width / 2
+		for _, path := range ve.paths {
+			for index := 1; index < len(path); index++ {
+				x0, y0, x1, y1 := path[index-1][0], path[index-1][1], path[index][0], path[index][1]
+				length := math.Hypot(x1-x0, y1-y0)
+				if length == 0 {
+					continue
+				}
+				nx, ny := -(y1-y0)/length*half, (x1-x0)/length*half
+				z.MoveTo(float32(x0+nx), float32(y0+ny))
+				z.LineTo(float32(x1+nx), float32(y1+ny))
+				z.LineTo(float32(x1-nx), float32(y1-ny))
+				z.LineTo(float32(x0-nx), float32(y0-ny))
+				z.ClosePath()
+			}
+		}
+		z.Draw(ve.img, bounds, image.NewUniform(stroke.Color), image.Point{})
+	}
+	ve.paths = nil
+}
+
+// DrawText draws text with the freetype glyphs; this example engine draws rotated text unrotated.
+func (ve *vectorEngine) DrawText(text string, x, y float64, f *truetype.Font, size float64, color drawing.Color, _ float64) {
+	d := &font.Drawer{
+		Dst:  ve.img,
+		Src:  image.NewUniform(color),
+		Face: truetype.NewFace(f, &truetype.Options{Size: size, DPI: 72}),
+		Dot:  fixed.P(int(x), int(y)),
+	}
+	d.DrawString(text)
+}
+
+func (ve *vectorEngine) Save(w io.Writer) error {
+	return png.Encode(w, ve.img)
+}
+
+func main() {
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 20, Left: 20},
+		},
+		Series: []chart.Series{
+			chart.ContinuousSeries{
+				Name:    "A test series",
+				XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+				YValues: []float64{1.0, 3.0, 2.0, 5.0, 4.0},
+				Style: chart.Style{
+					DotWidth: 4,
+				},
+			},
+		},
+	}
+	graph.Elements = []chart.Renderable{chart.Legend(&graph)}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	if err := graph.Render(chart.EngineRenderer(newVectorEngine), f); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,120 @@
+package chart
+
+import (
+	"math"
+	"sort"
+)
+
+// FillPattern is an enum for the patterns that can be drawn over a fill.
+type FillPattern int
+
+const (
+	// FillPatternNone draws a plain fill.
+	FillPatternNone FillPattern = 0
+	// FillPatternDiagonal draws diagonal lines over the fill.
+	FillPatternDiagonal FillPattern = 1
+	// FillPatternCrossHatch draws crossed diagonal lines over the fill.
+	FillPatternCrossHatch FillPattern = 2
+	// FillPatternDots draws a grid of dots over the fill.
+	FillPatternDots FillPattern = 3
+)
+
+// FillPattern draws the style's fill pattern over the area enclosed by a polygon.
+// The pattern uses the style's fill pattern color, falling back to its stroke color.
+func (d draw) FillPattern(r Renderer, polygon []Point, s Style) {
+	pattern := s.GetFillPattern()
+	if pattern == FillPatternNone || len(polygon) < 3 {
+		return
+	}
+	color := s.GetFillPatternColor(s.GetStrokeColor())
+	if color.IsTransparent() {
+		return
+	}
+	defer r.ResetStyle()
+
+	switch pattern {
+	case FillPatternDiagonal, FillPatternCrossHatch:
+		r.SetStrokeColor(color)
+		r.SetStrokeWidth(DefaultFillPatternStrokeWidth)
+		r.SetStrokeDashArray(nil)
+		hatchPolygon(r, polygon, 1, 1)
+		if pattern == FillPatternCrossHatch {
+			hatchPolygon(r, polygon, 1, -1)
+		}
+		r.Stroke()
+	case FillPatternDots:
+		r.SetFillColor(color)
+		bounds := polygonBounds(polygon)
+		for row, y := 0, bounds.Top+(DefaultFillPatternSpacing>>1); y < bounds.Bottom; row, y = row+1, y+DefaultFillPatternSpacing {
+			// offset every other row so the dots form a staggered grid.
+			x := bounds.Left + (DefaultFillPatternSpacing >> 1) + (row%2)*(DefaultFillPatternSpacing>>1)
+			for ; x < bounds.Right; x += DefaultFillPatternSpacing {
+				if polygonContains(polygon, float64(x), float64(y)) {
+					r.Circle(DefaultFillPatternStrokeWidth, x, y)
+					r.Fill()
+				}
+			}
+		}
+	}
+}
+
+// hatchPolygon adds the segments of the lines `nx*x + ny*y = k` that fall within a polygon to the current path,
+// with k stepped such that the lines are `DefaultFillPatternSpacing` apart.
+func hatchPolygon(r Renderer, polygon []Point, nx, ny float64) {
+	side := func(p Point, k float64) float64 {
+		return nx*float64(p.X) + ny*float64(p.Y) - k
+	}
+
+	minK, maxK := math.MaxFloat64, -math.MaxFloat64
+	for _, p := range polygon {
+		minK = math.Min(minK, side(p, 0))
+		maxK = math.Max(maxK, side(p, 0))
+	}
+
+	step := DefaultFillPatternSpacing * math.Hypot(nx, ny)
+	for k := minK + step/2; k < maxK; k += step {
+		var xs []float64
+		for index := range polygon {
+			a, b := polygon[index], polygon[(index+1)%len(polygon)]
+			sa, sb := side(a, k), side(b, k)
+			if (sa > 0) == (sb > 0) {
+				continue
+			}
+			u := sa / (sa - sb)
+			xs = append(xs, float64(a.X)+u*float64(b.X-a.X))
+		}
+		sort.Float64s(xs)
+		for index := 0; index+1 < len(xs); index += 2 {
+			x0, x1 := xs[index], xs[index+1]
+			r.MoveTo(int(math.Round(x0)), int(math.Round((k-nx*x0)/ny)))
+			r.LineTo(int(math.Round(x1)), int(math.Round((k-nx*x1)/ny)))
+		}
+	}
+}
+
+// polygonBounds returns the bounding box of a polygon.
+func polygonBounds(polygon []Point) Box {
+	bounds := Box{Top: math.MaxInt32, Left: math.MaxInt32, Right: math.MinInt32, Bottom: math.MinInt32}
+	for _, p := range polygon {
+		bounds.Top = MinInt(bounds.Top, p.Y)
+		bounds.Left = MinInt(bounds.Left, p.X)
+		bounds.Right = MaxInt(bounds.Right, p.X)
+		bounds.Bottom = MaxInt(bounds.Bottom, p.Y)
+	}
+	return bounds
+}
+
+// polygonContains returns if a point falls within a polygon, using the even-odd rule.
+func polygonContains(polygon []Point, x, y float64) (inside bool) {
+	for index := range polygon {
+		a, b := polygon[index], polygon[(index+1)%len(polygon)]
+		ay, by := float64(a.Y), float64(b.Y)
+		if (ay > y) == (by > y) {
+			continue
+		}
+		if x < float64(a.X)+(y-ay)/(by-ay)*float64(b.X-a.X) {
+			inside = !inside
+		}
+	}
+	return
+}
@@ -0,0 +1,93 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestPolygonContains(t *testing.T) {
+	// replaced new assertions helper
+
+	triangle := []Point{{X: 0, Y: 0}, {X: 10, Y: 10}, {X: 0, Y: 10}}
+	testutil.AssertTrue(t, polygonContains(triangle, 2, 8))
+	testutil.AssertFalse(t, polygonContains(triangle, 8, 2))
+	testutil.AssertFalse(t, polygonContains(triangle, 20, 5))
+
+	testutil.AssertEqual(t, Box{Top: 0, Left: 0, Right: 10, Bottom: 10}, polygonBounds(triangle))
+}
+
+func TestDrawBoxFillPattern(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, pattern := range []FillPattern{FillPatternDiagonal, FillPatternCrossHatch, FillPatternDots} {
+		r, err := PNG(60, 60)
+		testutil.AssertNil(t, err)
+
+		Draw.Box(r, Box{Top: 10, Left: 10, Right: 50, Bottom: 50}, Style{
+			FillColor:        drawing.ColorWhite,
+			FillPattern:      pattern,
+			FillPatternColor: drawing.ColorBlack,
+		})
+
+		iw := &ImageWriter{}
+		testutil.AssertNil(t, r.Save(iw))
+		img, err := iw.Image()
+		testutil.AssertNil(t, err)
+
+		var inside, outside int
+		for y := 0; y < 60; y++ {
+			for x := 0; x < 60; x++ {
+				red, _, _, alpha := img.At(x, y).RGBA()
+				if alpha == 0 || red>>8 > 128 {
+					continue
+				}
+				if x >= 9 && x <= 51 && y >= 9 && y <= 51 {
+					inside++
+				} else {
+					outside++
+				}
+			}
+		}
+		testutil.AssertNotZero(t, inside)
+		testutil.AssertZero(t, outside)
+	}
+}
+
+func TestDrawBoxFillPatternRounded(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, pattern := range []FillPattern{FillPatternDiagonal, FillPatternCrossHatch, FillPatternDots} {
+		r, err := PNG(60, 60)
+		testutil.AssertNil(t, err)
+
+		Draw.Box(r, Box{Top: 10, Left: 10, Right: 50, Bottom: 50}, Style{
+			FillColor:        drawing.ColorWhite,
+			FillPattern:      pattern,
+			FillPatternColor: drawing.ColorBlack,
+			CornerRadius:     16,
+		})
+
+		iw := &ImageWriter{}
+		testutil.AssertNil(t, r.Save(iw))
+		img, err := iw.Image()
+		testutil.AssertNil(t, err)
+
+		// the corners cut off by the rounded outline are left clear of the pattern.
+		var cut int
+		for y := 0; y < 60; y++ {
+			for x := 0; x < 60; x++ {
+				red, _, _, alpha := img.At(x, y).RGBA()
+				if alpha == 0 || red>>8 > 128 {
+					continue
+				}
+				dx, dy := MinInt(x-10, 50-x), MinInt(y-10, 50-y)
+				if dx+dy < 3 {
+					cut++
+				}
+			}
+		}
+		testutil.AssertZero(t, cut, pattern)
+	}
+}
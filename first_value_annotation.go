@@ -17,7 +17,7 @@ func FirstValueAnnotation(innerSeries ValuesProvider, vfs ...ValueFormatter) Ann
 	if typed, isTyped := innerSeries.(FirstValuesProvider); isTyped {
 		firstValue.XValue, firstValue.YValue = typed.GetFirstValues()
 		firstValue.Label = vf(firstValue.YValue)
-	} else {
+	} else if innerSeries.Len() > 0 {
 		firstValue.XValue, firstValue.YValue = innerSeries.GetValues(0)
 		firstValue.Label = vf(firstValue.YValue)
 	}
@@ -0,0 +1,260 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// FunnelChart is a chart that draws the ordered stages of a process, e.g. the visitors, sign ups and
+// purchases of a shop, as stacked trapezoids, each as wide at its top as its value is a proportion of the
+// largest and narrowing to the width of the next stage. The stages are labeled to their left, with the
+// conversion from each stage to the next to their right.
+type FunnelChart struct {
+	ChartFrame
+
+	StageStyle      Style
+	LabelStyle      Style
+	ValueStyle      Style
+	ConversionStyle Style
+
+	// StageSpacing is the pixel spacing between stages.
+	StageSpacing int
+
+	// ValueFormatter formats the values drawn within the stages, and ConversionFormatter the
+	// conversions, the fraction of the previous stage's value that reaches each stage.
+	ValueFormatter      ValueFormatter
+	ConversionFormatter ValueFormatter
+
+	// Values are the stages of the funnel, from top to bottom.
+	Values   []Value
+	Elements []Renderable
+}
+
+// GetStageSpacing returns the spacing between stages or the default value.
+func (fc FunnelChart) GetStageSpacing() int {
+	if fc.StageSpacing == 0 {
+		return DefaultFunnelStageSpacing
+	}
+	return fc.StageSpacing
+}
+
+// GetValueFormatter returns the value formatter or the default.
+func (fc FunnelChart) GetValueFormatter() ValueFormatter {
+	if fc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return fc.ValueFormatter
+}
+
+// GetConversionFormatter returns the conversion formatter or the default, a percentage.
+func (fc FunnelChart) GetConversionFormatter() ValueFormatter {
+	if fc.ConversionFormatter == nil {
+		return PercentValueFormatter
+	}
+	return fc.ConversionFormatter
+}
+
+// GetConversions returns the fraction of the value of the previous stage that reaches each stage, with
+// the first stage, and any stage after a stage of zero, given a conversion of NaN.
+func (fc FunnelChart) GetConversions() []float64 {
+	conversions := make([]float64, len(fc.Values))
+	for index := range fc.Values {
+		if index == 0 || fc.Values[index-1].Value == 0 {
+			conversions[index] = math.NaN()
+			continue
+		}
+		conversions[index] = fc.Values[index].Value / fc.Values[index-1].Value
+	}
+	return conversions
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (fc FunnelChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(fc.Values) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one value"))
+	}
+	if err := fc.validateValues(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := fc.GetWidth(), fc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if fc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		fc.defaultFont = defaultFont
+	}
+	r.SetDPI(fc.GetDPI(DefaultDPI))
+
+	canvasBox := fc.getDefaultCanvasBox(r, width, height)
+	funnelBox := fc.getFunnelBox(r, canvasBox)
+
+	fc.drawBackground(r, width, height)
+	fc.drawCanvas(r, canvasBox)
+	fc.drawStages(r, funnelBox)
+	fc.drawLabels(r, funnelBox)
+	fc.drawTitle(r, width, height)
+	for _, a := range fc.Elements {
+		a(r, canvasBox, fc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (fc FunnelChart) validateValues() error {
+	var max float64
+	for _, v := range fc.Values {
+		if v.Value < 0 {
+			return fmt.Errorf("funnel chart values cannot be negative")
+		}
+		max = math.Max(max, v.Value)
+	}
+	if max == 0 {
+		return fmt.Errorf("funnel chart must contain at least (1) non-zero value")
+	}
+	return nil
+}
+
+// getMaxValue returns the largest value, that spans the width of the funnel.
+func (fc FunnelChart) getMaxValue() (max float64) {
+	for _, v := range fc.Values {
+		max = math.Max(max, v.Value)
+	}
+	return
+}
+
+// getStageBox returns the box of a stage, as wide as the funnel, and the widths of the top and
+// bottom of its trapezoid.
+func (fc FunnelChart) getStageBox(funnelBox Box, index int) (box Box, topWidth, bottomWidth int) {
+	count, spacing := len(fc.Values), fc.GetStageSpacing()
+	height := (funnelBox.Height() - (count-1)*spacing) / count
+	top := funnelBox.Top + index*(height+spacing)
+	box = Box{
+		Top:    top,
+		Left:   funnelBox.Left,
+		Right:  funnelBox.Right,
+		Bottom: top + height,
+	}
+
+	max := fc.getMaxValue()
+	topWidth = int(float64(funnelBox.Width()) * fc.Values[index].Value / max)
+	bottomWidth = topWidth
+	if index < count-1 {
+		bottomWidth = int(float64(funnelBox.Width()) * fc.Values[index+1].Value / max)
+	}
+	return
+}
+
+// getFunnelBox returns the canvas less the space for the stage labels to the left and the
+// conversions to the right.
+func (fc FunnelChart) getFunnelBox(r Renderer, canvasBox Box) Box {
+	funnelBox := canvasBox
+	if !fc.LabelStyle.Hidden {
+		labelStyle := fc.styleDefaultsLabels()
+		var labelWidth int
+		for _, v := range fc.Values {
+			labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, v.Label, labelStyle).Width())
+		}
+		if labelWidth > 0 {
+			funnelBox.Left += labelWidth + DefaultFunnelLabelGap
+		}
+	}
+	if !fc.ConversionStyle.Hidden {
+		conversionStyle := fc.styleDefaultsConversions()
+		var conversionWidth int
+		for _, conversion := range fc.GetConversions() {
+			if !math.IsNaN(conversion) {
+				conversionWidth = MaxInt(conversionWidth, Draw.MeasureText(r, fc.GetConversionFormatter()(conversion), conversionStyle).Width())
+			}
+		}
+		if conversionWidth > 0 {
+			funnelBox.Right -= conversionWidth + DefaultFunnelLabelGap
+		}
+	}
+	return funnelBox
+}
+
+// drawStages draws the trapezoid of each stage centered in the funnel, with its value within it.
+func (fc FunnelChart) drawStages(r Renderer, funnelBox Box) {
+	center := funnelBox.Left + funnelBox.Width()>>1
+	for index, v := range fc.Values {
+		box, topWidth, bottomWidth := fc.getStageBox(funnelBox, index)
+		style := v.Style.InheritFrom(fc.styleFunnelChartStage(index))
+
+		style.WriteToRenderer(r)
+		r.MoveTo(center-topWidth>>1, box.Top)
+		r.LineTo(center+topWidth-topWidth>>1, box.Top)
+		r.LineTo(center+bottomWidth-bottomWidth>>1, box.Bottom)
+		r.LineTo(center-bottomWidth>>1, box.Bottom)
+		r.Close()
+		r.FillStroke()
+
+		if fc.ValueStyle.Hidden {
+			continue
+		}
+		valueStyle := fc.ValueStyle.InheritFrom(Style{
+			FontSize:  DefaultFontSize,
+			FontColor: heatMapTextColor(style.GetFillColor()),
+			Font:      fc.GetFont(),
+		})
+		text := fc.GetValueFormatter()(v.Value)
+		tb := Draw.MeasureText(r, text, valueStyle)
+		if tb.Width() > MinInt(topWidth, bottomWidth) || tb.Height() > box.Height() {
+			continue
+		}
+		Draw.Text(r, text, center-tb.Width()>>1, box.Top+(box.Height()+tb.Height())>>1, valueStyle)
+	}
+}
+
+// drawLabels draws the label of each stage to the left of the funnel, and the conversion into each
+// stage from the previous one beside the right end of the boundary between the two stages.
+func (fc FunnelChart) drawLabels(r Renderer, funnelBox Box) {
+	center := funnelBox.Left + funnelBox.Width()>>1
+	labelStyle, conversionStyle := fc.styleDefaultsLabels(), fc.styleDefaultsConversions()
+	conversions := fc.GetConversions()
+	for index, v := range fc.Values {
+		box, topWidth, _ := fc.getStageBox(funnelBox, index)
+		if !fc.LabelStyle.Hidden && len(v.Label) > 0 {
+			tb := Draw.MeasureText(r, v.Label, labelStyle)
+			Draw.Text(r, v.Label, funnelBox.Left-DefaultFunnelLabelGap-tb.Width(), box.Top+(box.Height()+tb.Height())>>1, labelStyle)
+		}
+		if !fc.ConversionStyle.Hidden && !math.IsNaN(conversions[index]) {
+			text := fc.GetConversionFormatter()(conversions[index])
+			tb := Draw.MeasureText(r, text, conversionStyle)
+			y := box.Top - fc.GetStageSpacing()>>1
+			Draw.Text(r, text, center+topWidth-topWidth>>1+DefaultFunnelLabelGap, y+tb.Height()>>1, conversionStyle)
+		}
+	}
+}
+
+func (fc FunnelChart) styleFunnelChartStage(index int) Style {
+	return fc.StageStyle.InheritFrom(Style{
+		StrokeColor: fc.GetColorPalette().GetSeriesColor(index),
+		StrokeWidth: DefaultStrokeWidth,
+		FillColor:   fc.GetColorPalette().GetSeriesColor(index),
+	})
+}
+
+func (fc FunnelChart) styleDefaultsLabels() Style {
+	return fc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: fc.GetColorPalette().TextColor(),
+		Font:      fc.GetFont(),
+	})
+}
+
+func (fc FunnelChart) styleDefaultsConversions() Style {
+	return fc.ConversionStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: fc.GetColorPalette().TextColor(),
+		Font:      fc.GetFont(),
+	})
+}
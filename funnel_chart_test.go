@@ -0,0 +1,72 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestFunnelChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	fc := FunnelChart{
+		ChartFrame: ChartFrame{
+			Title: "Test",
+		},
+		Values: []Value{
+			{Label: "Visitors", Value: 1000},
+			{Label: "Sign ups", Value: 250},
+			{Label: "Purchases", Value: 50},
+		},
+	}
+	for _, rp := range []RendererProvider{PNG, SVG} {
+		b := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, fc.Render(rp, b))
+		testutil.AssertNotZero(t, b.Len())
+	}
+}
+
+func TestFunnelChartRenderInvalid(t *testing.T) {
+	// replaced new assertions helper
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, FunnelChart{}.Render(PNG, b))
+	testutil.AssertNotNil(t, FunnelChart{Values: []Value{{Value: 1}, {Value: -1}}}.Render(PNG, b))
+	testutil.AssertNotNil(t, FunnelChart{Values: []Value{{Value: 0}, {Value: 0}}}.Render(PNG, b))
+}
+
+func TestFunnelChartGetConversions(t *testing.T) {
+	// replaced new assertions helper
+
+	fc := FunnelChart{
+		Values: []Value{{Value: 100}, {Value: 40}, {Value: 0}, {Value: 0}},
+	}
+	conversions := fc.GetConversions()
+	testutil.AssertLen(t, conversions, 4)
+	testutil.AssertTrue(t, math.IsNaN(conversions[0]))
+	testutil.AssertEqual(t, 0.4, conversions[1])
+	testutil.AssertEqual(t, 0.0, conversions[2])
+	testutil.AssertTrue(t, math.IsNaN(conversions[3]))
+}
+
+func TestFunnelChartGetStageBox(t *testing.T) {
+	// replaced new assertions helper
+
+	fc := FunnelChart{
+		StageSpacing: 10,
+		Values:       []Value{{Value: 100}, {Value: 50}},
+	}
+	funnelBox := Box{Top: 0, Left: 0, Right: 200, Bottom: 110}
+
+	box, topWidth, bottomWidth := fc.getStageBox(funnelBox, 0)
+	testutil.AssertEqual(t, Box{Top: 0, Left: 0, Right: 200, Bottom: 50}, box)
+	testutil.AssertEqual(t, 200, topWidth)
+	testutil.AssertEqual(t, 100, bottomWidth)
+
+	box, topWidth, bottomWidth = fc.getStageBox(funnelBox, 1)
+	testutil.AssertEqual(t, Box{Top: 60, Left: 0, Right: 200, Bottom: 110}, box)
+	testutil.AssertEqual(t, 100, topWidth)
+	testutil.AssertEqual(t, 100, bottomWidth)
+}
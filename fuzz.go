@@ -0,0 +1,87 @@
+//go:build gofuzz
+// +build gofuzz
+
+package chart
+
+import (
+	"io/ioutil"
+	"math"
+)
+
+// Fuzz is a go-fuzz entry point that renders charts configured from arbitrary input.
+// Build it with `go-fuzz-build` and run it with `go-fuzz`; any panic is a bug.
+func Fuzz(data []byte) int {
+	c, rp := fuzzChart(data)
+	if err := c.Render(rp, ioutil.Discard); err != nil {
+		return 0
+	}
+	return 1
+}
+
+// fuzzChart builds a chart from input bytes:
+//   - byte 0 are option flags (axes visibility, annotations, secondary axis etc.).
+//   - byte 1 is the number of series (mod 4).
+//   - byte 2 and 3 are the width and height (scaled).
+//   - the remaining bytes are series values, split evenly between the series.
+func fuzzChart(data []byte) (Chart, RendererProvider) {
+	if len(data) < 4 {
+		return Chart{}, PNG
+	}
+	flags, seriesCount := data[0], int(data[1]%4)+1
+	width, height := int(data[2])*4+1, int(data[3])*2+1
+	values := data[4:]
+
+	c := Chart{
+		Width:  width,
+		Height: height,
+	}
+	if flags&(1<<0) != 0 {
+		c.XAxis.Style = Hidden()
+	}
+	if flags&(1<<1) != 0 {
+		c.YAxis.Style = Hidden()
+	}
+	if flags&(1<<2) != 0 {
+		c.YAxis.Range = &ContinuousRange{Descending: true}
+	}
+	if flags&(1<<3) != 0 {
+		c.Title = "fuzz"
+	}
+
+	chunk := len(values) / seriesCount
+	for index := 0; index < seriesCount; index++ {
+		series := ContinuousSeries{Name: "fuzz"}
+		for offset, b := range values[index*chunk : (index+1)*chunk] {
+			series.XValues = append(series.XValues, float64(offset))
+			series.YValues = append(series.YValues, fuzzValue(b))
+		}
+		if flags&(1<<4) != 0 && index > 0 {
+			series.YAxis = YAxisSecondary
+		}
+		c.Series = append(c.Series, series)
+		if flags&(1<<5) != 0 {
+			c.Series = append(c.Series, LastValueAnnotationSeries(series))
+		}
+		if flags&(1<<6) != 0 {
+			c.Series = append(c.Series, &SMASeries{InnerSeries: series}, &BollingerBandsSeries{InnerSeries: series})
+		}
+	}
+
+	if flags&(1<<7) != 0 {
+		return c, SVG
+	}
+	return c, PNG
+}
+
+// fuzzValue maps a byte onto a spread of magnitudes, including some degenerate values.
+func fuzzValue(b byte) float64 {
+	switch b {
+	case 0xff:
+		return math.NaN()
+	case 0xfe:
+		return math.Inf(1)
+	case 0xfd:
+		return math.Inf(-1)
+	}
+	return float64(int8(b)) * math.Pow(10, float64(b%7)-3)
+}
@@ -0,0 +1,249 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// GanttTask is a named task spanning from a start to an end time in a gantt chart.
+type GanttTask struct {
+	Name  string
+	Style Style
+	Start time.Time
+	End   time.Time
+
+	// DependsOn are the names of the tasks that must finish before this one starts; an arrow
+	// is drawn from the end of each of them to the start of this one.
+	DependsOn []string
+}
+
+// Interface Assertions.
+var (
+	_ Series                 = (*GanttSeries)(nil)
+	_ BoundedValuesProvider  = (*GanttSeries)(nil)
+	_ ValueFormatterProvider = (*GanttSeries)(nil)
+)
+
+// GanttSeries draws each task as a horizontal bar from its start to its end time, on the row of its
+// index, with arrows from tasks to the tasks that depend on them.
+//
+// The rows are the y values 0, 1, 2 and so on, which lines up with an `OrdinalRange` of the task
+// names; see `NewGanttChart`.
+type GanttSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// ArrowStyle is the style of the dependency arrows.
+	ArrowStyle Style
+	// BarHeight is the height of the bars as a fraction of the height of a row.
+	BarHeight float64
+
+	Tasks []GanttTask
+}
+
+// NewGanttChart returns a chart of the tasks as horizontal bars on a time x-axis, with a row for each
+// task labeled with its name on the left, the first task at the top, and arrows for their dependencies.
+func NewGanttChart(tasks []GanttTask) Chart {
+	names := make([]interface{}, len(tasks))
+	for index, task := range tasks {
+		names[index] = task.Name
+	}
+	// the primary y-axis, on the right, is hidden but needs a range of its own.
+	return Chart{
+		YAxis: YAxis{
+			Style: Hidden(),
+			Range: &OrdinalRange{Keys: names, Descending: true},
+		},
+		YAxisSecondary: YAxis{
+			Range: &OrdinalRange{Keys: names, Descending: true},
+		},
+		Series: []Series{
+			GanttSeries{
+				YAxis: YAxisSecondary,
+				Tasks: tasks,
+			},
+		},
+	}
+}
+
+// GetName returns the name of the series.
+func (gs GanttSeries) GetName() string {
+	return gs.Name
+}
+
+// GetStyle returns the series style.
+func (gs GanttSeries) GetStyle() Style {
+	return gs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (gs GanttSeries) GetYAxis() YAxisType {
+	return gs.YAxis
+}
+
+// GetBarHeight returns the bar height or the default.
+func (gs GanttSeries) GetBarHeight() float64 {
+	if gs.BarHeight == 0 {
+		return DefaultGanttBarHeight
+	}
+	return gs.BarHeight
+}
+
+// Len returns the number of values, a start and an end for each task.
+func (gs GanttSeries) Len() int {
+	return len(gs.Tasks) << 1
+}
+
+// GetValues gets the start or end time of a task, and its row.
+func (gs GanttSeries) GetValues(index int) (x, y float64) {
+	x, y, _ = gs.GetBoundedValues(index)
+	return
+}
+
+// GetBoundedValues gets the start or end time of a task, and its row as both bounds.
+func (gs GanttSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	task := gs.Tasks[index>>1]
+	if index%2 == 0 {
+		x = TimeToFloat64(task.Start)
+	} else {
+		x = TimeToFloat64(task.End)
+	}
+	y1 = float64(index >> 1)
+	y2 = y1
+	return
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (gs GanttSeries) GetValueFormatters() (x, y ValueFormatter) {
+	x = TimeValueFormatter
+	y = FloatValueFormatter
+	return
+}
+
+// getRowHeight returns the pixel height of a row.
+func (gs GanttSeries) getRowHeight(yrange Range) int {
+	if len(gs.Tasks) > 1 {
+		return AbsInt(yrange.Translate(1) - yrange.Translate(0))
+	}
+	return yrange.GetDomain()
+}
+
+// getBarBox returns the box of the bar of a task.
+func (gs GanttSeries) getBarBox(canvasBox Box, xrange, yrange Range, index int) Box {
+	task := gs.Tasks[index]
+	half := int(math.Round(float64(gs.getRowHeight(yrange))*gs.GetBarHeight())) >> 1
+	y := canvasBox.Bottom - yrange.Translate(float64(index))
+	return Box{
+		Top:    y - half,
+		Left:   canvasBox.Left + xrange.Translate(TimeToFloat64(task.Start)),
+		Right:  canvasBox.Left + xrange.Translate(TimeToFloat64(task.End)),
+		Bottom: y + half,
+	}
+}
+
+// getDependencyPath returns the points of the arrow from the end of a bar to the start of a bar that
+// depends on it. It goes out of the right of the first bar and down or up to the row of the second,
+// first backing up between the rows if the second bar starts before there.
+func (gs GanttSeries) getDependencyPath(from, to Box, rowHeight int) []Point {
+	_, fy := from.Center()
+	_, ty := to.Center()
+	gap := DefaultGanttArrowGap
+	ex := from.Right + gap
+	if to.Left-gap >= ex || fy == ty {
+		return []Point{{X: from.Right, Y: fy}, {X: ex, Y: fy}, {X: ex, Y: ty}, {X: to.Left, Y: ty}}
+	}
+	between := fy + rowHeight>>1
+	if ty < fy {
+		between = fy - rowHeight>>1
+	}
+	sx := to.Left - gap
+	return []Point{{X: from.Right, Y: fy}, {X: ex, Y: fy}, {X: ex, Y: between}, {X: sx, Y: between}, {X: sx, Y: ty}, {X: to.Left, Y: ty}}
+}
+
+func (gs GanttSeries) getTaskStyle(index int, defaults Style) Style {
+	return gs.Tasks[index].Style.InheritFrom(gs.Style.InheritFrom(Style{
+		FillColor:   defaults.FillColor,
+		StrokeColor: defaults.StrokeColor,
+		StrokeWidth: DefaultStrokeWidth,
+	}))
+}
+
+func (gs GanttSeries) getArrowStyle() Style {
+	return gs.ArrowStyle.InheritFrom(Style{
+		StrokeColor: DefaultAxisColor,
+		StrokeWidth: DefaultAxisLineWidth,
+	})
+}
+
+// Render renders the series.
+func (gs GanttSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if gs.Style.Hidden {
+		return
+	}
+	defaults.FillColor = defaults.StrokeColor
+	rows := make(map[string]int)
+	for index, task := range gs.Tasks {
+		rows[task.Name] = index
+	}
+
+	for index, task := range gs.Tasks {
+		if task.Style.Hidden {
+			continue
+		}
+		Draw.Box(r, gs.getBarBox(canvasBox, xrange, yrange, index), gs.getTaskStyle(index, defaults))
+	}
+
+	arrowStyle := gs.getArrowStyle()
+	if arrowStyle.Hidden {
+		return
+	}
+	rowHeight := gs.getRowHeight(yrange)
+	for index, task := range gs.Tasks {
+		to := gs.getBarBox(canvasBox, xrange, yrange, index)
+		for _, name := range task.DependsOn {
+			if dependency, ok := rows[name]; ok {
+				from := gs.getBarBox(canvasBox, xrange, yrange, dependency)
+				Draw.Arrow(r, gs.getDependencyPath(from, to, rowHeight), DefaultGanttArrowSize, arrowStyle)
+			}
+		}
+	}
+}
+
+// Validate validates the series.
+func (gs GanttSeries) Validate() error {
+	if len(gs.Tasks) == 0 {
+		return fmt.Errorf("gantt series requires tasks to be set")
+	}
+	names := make(map[string]bool)
+	for _, task := range gs.Tasks {
+		if task.End.Before(task.Start) {
+			return fmt.Errorf("gantt series task %q ends before it starts", task.Name)
+		}
+		names[task.Name] = true
+	}
+	for _, task := range gs.Tasks {
+		for _, name := range task.DependsOn {
+			if !names[name] {
+				return fmt.Errorf("gantt series task %q depends on an unknown task %q", task.Name, name)
+			}
+		}
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its tasks with the original.
+func (gs GanttSeries) CopySeries() Series {
+	if gs.Tasks != nil {
+		tasks := make([]GanttTask, len(gs.Tasks))
+		for index, task := range gs.Tasks {
+			if task.DependsOn != nil {
+				task.DependsOn = append([]string(nil), task.DependsOn...)
+			}
+			tasks[index] = task
+		}
+		gs.Tasks = tasks
+	}
+	return gs
+}
@@ -0,0 +1,79 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestGanttSeriesValues(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	gs := GanttSeries{Tasks: []GanttTask{
+		{Name: "design", Start: start, End: start.AddDate(0, 0, 2)},
+		{Name: "build", Start: start.AddDate(0, 0, 2), End: start.AddDate(0, 0, 5), DependsOn: []string{"design"}},
+	}}
+	testutil.AssertNil(t, gs.Validate())
+	testutil.AssertEqual(t, 4, gs.Len())
+
+	x, y1, y2 := gs.GetBoundedValues(3)
+	testutil.AssertEqual(t, TimeToFloat64(start.AddDate(0, 0, 5)), x)
+	testutil.AssertEqual(t, 1.0, y1)
+	testutil.AssertEqual(t, 1.0, y2)
+
+	gs.Tasks[1].DependsOn = []string{"plan"}
+	testutil.AssertNotNil(t, gs.Validate())
+	gs.Tasks[1].DependsOn = nil
+	gs.Tasks[1].End = start
+	testutil.AssertNotNil(t, gs.Validate())
+}
+
+func TestGanttSeriesDependencyPath(t *testing.T) {
+	// replaced new assertions helper
+
+	gs := GanttSeries{}
+	from := Box{Top: 10, Left: 0, Right: 50, Bottom: 30}
+
+	// the dependent task starts after the end; down and across.
+	to := Box{Top: 50, Left: 80, Right: 120, Bottom: 70}
+	testutil.AssertEqual(t, []Point{{X: 50, Y: 20}, {X: 58, Y: 20}, {X: 58, Y: 60}, {X: 80, Y: 60}}, gs.getDependencyPath(from, to, 40))
+
+	// it starts right away; back up between the rows first.
+	to = Box{Top: 50, Left: 50, Right: 120, Bottom: 70}
+	testutil.AssertEqual(t, []Point{{X: 50, Y: 20}, {X: 58, Y: 20}, {X: 58, Y: 40}, {X: 42, Y: 40}, {X: 42, Y: 60}, {X: 50, Y: 60}}, gs.getDependencyPath(from, to, 40))
+}
+
+func TestGanttChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	c := NewGanttChart([]GanttTask{
+		{Name: "design", Start: start, End: start.AddDate(0, 0, 2)},
+		{Name: "build", Start: start.AddDate(0, 0, 2), End: start.AddDate(0, 0, 5), DependsOn: []string{"design"}},
+		{Name: "launch", Start: start.AddDate(0, 0, 6), End: start.AddDate(0, 0, 7), DependsOn: []string{"build"}},
+	})
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), ">design</text>")
+	testutil.AssertContains(t, buffer.String(), ">launch</text>")
+}
+
+func TestGanttSeriesCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	gs := GanttSeries{Tasks: []GanttTask{
+		{Name: "design", Start: start, End: start.AddDate(0, 0, 2)},
+		{Name: "build", Start: start.AddDate(0, 0, 2), End: start.AddDate(0, 0, 5), DependsOn: []string{"design"}},
+	}}
+	copied := gs.CopySeries().(GanttSeries)
+	copied.Tasks[0].End = start
+	copied.Tasks[1].DependsOn[0] = "plan"
+	testutil.AssertEqual(t, start.AddDate(0, 0, 2), gs.Tasks[0].End)
+	testutil.AssertEqual(t, "design", gs.Tasks[1].DependsOn[0])
+	testutil.AssertNil(t, copied.Tasks[0].DependsOn)
+}
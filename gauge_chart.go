@@ -0,0 +1,252 @@
+package chart
+
+import (
+	"errors"
+	"io"
+	"math"
+)
+
+// GaugeBand is a colored range of the scale of a gauge chart, e.g. to mark a warning threshold.
+type GaugeBand struct {
+	Style    Style
+	Min, Max float64
+}
+
+// GaugeChart is a chart that shows a single value on a dial, with a needle pointing to the value on
+// an arc from the min to the max, and the value as text under its center.
+//
+// The arc is filled up to the value, or drawn in the colors of the bands if any are set.
+type GaugeChart struct {
+	ChartFrame
+
+	// ScaleStyle is the style of the arc, e.g. its fill color where no band or value covers it.
+	ScaleStyle  Style
+	NeedleStyle Style
+	ValueStyle  Style
+	LabelStyle  Style
+
+	// Thickness is the pixel thickness of the arc, it defaults to a fifth of the radius.
+	Thickness int
+
+	ValueFormatter ValueFormatter
+
+	Min   float64
+	Max   float64
+	Value float64
+	Bands []GaugeBand
+
+	Elements []Renderable
+}
+
+// GetWidth returns the chart width or the default value.
+func (gc GaugeChart) GetWidth() int {
+	if gc.Width == 0 {
+		return DefaultChartHeight
+	}
+	return gc.Width
+}
+
+// Box returns the chart bounds as a box, at the chart's own default size.
+func (gc GaugeChart) Box() Box {
+	return gc.box(gc.GetWidth(), gc.GetHeight())
+}
+
+// GetValueFormatter returns the value formatter or the default.
+func (gc GaugeChart) GetValueFormatter() ValueFormatter {
+	if gc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return gc.ValueFormatter
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (gc GaugeChart) Render(rp RendererProvider, w io.Writer) error {
+	if gc.Max <= gc.Min {
+		return newRenderError(RenderStageValidate, errors.New("gauge chart max must be greater than its min"))
+	}
+
+	width, height := gc.GetWidth(), gc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if gc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		gc.defaultFont = defaultFont
+	}
+	r.SetDPI(gc.GetDPI(DefaultDPI))
+
+	canvasBox := gc.getDefaultCanvasBox(r, width, height)
+	cx, cy, radius, thickness := gc.getLayout(r, canvasBox)
+
+	gc.drawBackground(r, width, height)
+	gc.drawCanvas(r, canvasBox)
+	if radius > 0 {
+		gc.drawScale(r, cx, cy, radius, thickness)
+		gc.drawLabels(r, cx, cy, radius, thickness)
+		gc.drawNeedle(r, cx, cy, radius, thickness)
+		gc.drawValue(r, cx, cy, radius, thickness)
+	}
+	gc.drawTitle(r, width, height)
+	for _, a := range gc.Elements {
+		a(r, canvasBox, gc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+// getAngle returns the angle of a value on the scale, with values outside the min and max clamped to them.
+func (gc GaugeChart) getAngle(value float64) float64 {
+	t := math.Max(0, math.Min(1, (value-gc.Min)/(gc.Max-gc.Min)))
+	return DefaultGaugeStartAngle + DefaultGaugeSweep*t
+}
+
+// getLayout returns the center, radius and thickness of the largest arc that fits the canvas with
+// the min and max labels under its ends.
+func (gc GaugeChart) getLayout(r Renderer, canvasBox Box) (cx, cy int, radius float64, thickness int) {
+	var labelHeight int
+	if !gc.LabelStyle.Hidden {
+		labelStyle := gc.styleDefaultsLabels()
+		for _, value := range []float64{gc.Min, gc.Max} {
+			labelHeight = MaxInt(labelHeight, Draw.MeasureText(r, gc.GetValueFormatter()(value), labelStyle).Height())
+		}
+		labelHeight += DefaultGaugeLabelGap
+	}
+
+	// the arc rises the radius above its center and falls below it to the height of its ends.
+	below := math.Max(math.Sin(DefaultGaugeStartAngle), math.Sin(DefaultGaugeStartAngle+DefaultGaugeSweep))
+	width, height := float64(canvasBox.Width()), float64(canvasBox.Height()-labelHeight)
+	if gc.Thickness > 0 {
+		thickness = gc.Thickness
+		radius = math.Min((width-float64(thickness))/2, (height-float64(thickness))/(1+below))
+	} else {
+		radius = math.Min(width/(2+DefaultGaugeThicknessRatio), height/(1+below+DefaultGaugeThicknessRatio))
+		thickness = int(radius * DefaultGaugeThicknessRatio)
+	}
+	radius = math.Max(0, radius)
+
+	cx = canvasBox.Left + canvasBox.Width()>>1
+	total := radius*(1+below) + float64(thickness) + float64(labelHeight)
+	cy = canvasBox.Top + int((float64(canvasBox.Height())-total)/2+float64(thickness)/2+radius)
+	return
+}
+
+// drawScale draws the arc, then the bands over it, or the arc filled up to the value without bands.
+func (gc GaugeChart) drawScale(r Renderer, cx, cy int, radius float64, thickness int) {
+	gc.drawArc(r, cx, cy, radius, gc.Min, gc.Max, gc.styleDefaultsScale(thickness))
+
+	if len(gc.Bands) == 0 {
+		gc.drawArc(r, cx, cy, radius, gc.Min, gc.Value, Style{
+			StrokeColor: gc.GetColorPalette().GetSeriesColor(0),
+			StrokeWidth: float64(thickness),
+		})
+		return
+	}
+	for index, band := range gc.Bands {
+		gc.drawArc(r, cx, cy, radius, band.Min, band.Max, band.Style.InheritFrom(Style{
+			StrokeColor: gc.GetColorPalette().GetSeriesColor(index),
+			StrokeWidth: float64(thickness),
+		}))
+	}
+}
+
+// drawArc strokes the arc of the scale between two values, in segments of at most half a circle so that
+// renderers can draw it; the stroke color of the style is the color of the arc.
+func (gc GaugeChart) drawArc(r Renderer, cx, cy int, radius, from, to float64, style Style) {
+	start, end := gc.getAngle(from), gc.getAngle(to)
+	if end <= start {
+		return
+	}
+	style.GetStrokeOptions().WriteToRenderer(r)
+	for delta := end - start; delta > 0; {
+		segment := math.Min(delta, _pi)
+		r.ArcTo(cx, cy, radius, radius, start, segment)
+		start += segment
+		delta -= segment
+	}
+	r.Stroke()
+}
+
+// drawLabels draws the min and max values under the ends of the arc.
+func (gc GaugeChart) drawLabels(r Renderer, cx, cy int, radius float64, thickness int) {
+	if gc.LabelStyle.Hidden {
+		return
+	}
+	labelStyle := gc.styleDefaultsLabels()
+	for _, value := range []float64{gc.Min, gc.Max} {
+		angle := gc.getAngle(value)
+		x := cx + int(math.Round(radius*math.Cos(angle)))
+		y := cy + int(math.Round(radius*math.Sin(angle)))
+		label := gc.GetValueFormatter()(value)
+		tb := Draw.MeasureText(r, label, labelStyle)
+		Draw.Text(r, label, x-tb.Width()>>1, y+thickness>>1+DefaultGaugeLabelGap+tb.Height(), labelStyle)
+	}
+}
+
+// drawNeedle draws a tapered needle from the center to the middle of the arc at the value, on a round hub.
+func (gc GaugeChart) drawNeedle(r Renderer, cx, cy int, radius float64, thickness int) {
+	if gc.NeedleStyle.Hidden {
+		return
+	}
+	style := gc.styleDefaultsNeedle()
+	angle := gc.getAngle(gc.Value)
+	base := math.Max(2, float64(thickness)/4)
+
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(cx+int(math.Round(radius*math.Cos(angle))), cy+int(math.Round(radius*math.Sin(angle))))
+	r.LineTo(cx+int(math.Round(base*math.Cos(angle+_pi2))), cy+int(math.Round(base*math.Sin(angle+_pi2))))
+	r.LineTo(cx+int(math.Round(base*math.Cos(angle-_pi2))), cy+int(math.Round(base*math.Sin(angle-_pi2))))
+	r.Close()
+	r.FillStroke()
+
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	r.Circle(base*1.5, cx, cy)
+	r.FillStroke()
+}
+
+// drawValue draws the value centered under the hub of the needle.
+func (gc GaugeChart) drawValue(r Renderer, cx, cy int, radius float64, thickness int) {
+	if gc.ValueStyle.Hidden {
+		return
+	}
+	style := gc.styleDefaultsValue(radius)
+	label := gc.GetValueFormatter()(gc.Value)
+	tb := Draw.MeasureText(r, label, style)
+	Draw.Text(r, label, cx-tb.Width()>>1, cy+thickness>>1+tb.Height(), style)
+}
+
+func (gc GaugeChart) styleDefaultsLabels() Style {
+	return gc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: gc.GetColorPalette().TextColor(),
+		Font:      gc.GetFont(),
+	})
+}
+
+func (gc GaugeChart) styleDefaultsScale(thickness int) Style {
+	return gc.ScaleStyle.InheritFrom(Style{
+		StrokeColor: gc.GetColorPalette().AxisStrokeColor().WithAlpha(48),
+		StrokeWidth: float64(thickness),
+	})
+}
+
+func (gc GaugeChart) styleDefaultsNeedle() Style {
+	return gc.NeedleStyle.InheritFrom(Style{
+		FillColor:   gc.GetColorPalette().TextColor(),
+		StrokeColor: gc.GetColorPalette().TextColor(),
+		StrokeWidth: 1,
+	})
+}
+
+// styleDefaultsValue scales the value text with the gauge, from a fifth of the radius.
+func (gc GaugeChart) styleDefaultsValue(radius float64) Style {
+	return gc.ValueStyle.InheritFrom(Style{
+		FontSize:  math.Max(DefaultFontSize, radius/5*72/gc.GetDPI(DefaultDPI)),
+		FontColor: gc.GetColorPalette().TextColor(),
+		Font:      gc.GetFont(),
+	})
+}
@@ -0,0 +1,72 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestGaugeChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, bands := range [][]GaugeBand{nil, {{Min: 0, Max: 60}, {Min: 60, Max: 100}}} {
+		gc := GaugeChart{
+			ChartFrame: ChartFrame{
+				Title: "Test",
+			},
+			Max:   100,
+			Value: 72,
+			Bands: bands,
+		}
+		for _, rp := range []RendererProvider{PNG, SVG} {
+			b := bytes.NewBuffer([]byte{})
+			testutil.AssertNil(t, gc.Render(rp, b))
+			testutil.AssertNotZero(t, b.Len())
+		}
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, GaugeChart{}.Render(PNG, b))
+	testutil.AssertNotNil(t, GaugeChart{Min: 10, Max: 5}.Render(PNG, b))
+}
+
+func TestGaugeChartDefaultSize(t *testing.T) {
+	// replaced new assertions helper
+
+	// the chart is square by default, and its frame is drawn at that size.
+	gc := GaugeChart{}
+	testutil.AssertEqual(t, DefaultChartHeight, gc.GetWidth())
+	testutil.AssertEqual(t, Box{Top: DefaultBackgroundPadding.Top, Left: DefaultBackgroundPadding.Left, Right: DefaultChartHeight - DefaultBackgroundPadding.Right, Bottom: DefaultChartHeight - DefaultBackgroundPadding.Bottom}, gc.Box())
+}
+
+func TestGaugeChartGetAngle(t *testing.T) {
+	// replaced new assertions helper
+
+	gc := GaugeChart{Min: 0, Max: 10}
+	testutil.AssertInDelta(t, DefaultGaugeStartAngle, gc.getAngle(0), 1e-9)
+	testutil.AssertInDelta(t, DefaultGaugeStartAngle+DefaultGaugeSweep/2, gc.getAngle(5), 1e-9)
+	testutil.AssertInDelta(t, DefaultGaugeStartAngle+DefaultGaugeSweep, gc.getAngle(10), 1e-9)
+
+	// values outside the scale are clamped to its ends.
+	testutil.AssertInDelta(t, DefaultGaugeStartAngle, gc.getAngle(-5), 1e-9)
+	testutil.AssertInDelta(t, DefaultGaugeStartAngle+DefaultGaugeSweep, gc.getAngle(50), 1e-9)
+
+	// the middle of the scale is at the top of the circle.
+	testutil.AssertInDelta(t, -1, math.Sin(gc.getAngle(5)), 1e-9)
+}
+
+func TestGaugeChartGetLayout(t *testing.T) {
+	// replaced new assertions helper
+
+	gc := GaugeChart{Max: 1, LabelStyle: Hidden(), Thickness: 10}
+	canvasBox := Box{Top: 0, Left: 0, Right: 200, Bottom: 200}
+	cx, cy, radius, thickness := gc.getLayout(nil, canvasBox)
+	testutil.AssertEqual(t, 10, thickness)
+	testutil.AssertEqual(t, 95.0, radius)
+	testutil.AssertEqual(t, 100, cx)
+	// the arc and its thickness are centered vertically.
+	testutil.AssertTrue(t, cy-int(radius)-thickness/2 >= 0)
+	testutil.AssertTrue(t, cy+int(radius/2)+thickness/2 <= 200)
+}
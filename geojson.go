@@ -0,0 +1,79 @@
+package chart
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GeoFeature is a named area of a map, made of one or more polygons.
+//
+// Each polygon is a list of rings of [longitude, latitude] points; the first ring is the outline of the
+// polygon, and any others are holes in it.
+type GeoFeature struct {
+	Name     string
+	Polygons [][][][2]float64
+}
+
+// geoJSONObject is the subset of a GeoJSON object that `ParseGeoJSON` reads.
+type geoJSONObject struct {
+	Type        string                 `json:"type"`
+	ID          interface{}            `json:"id"`
+	Properties  map[string]interface{} `json:"properties"`
+	Geometry    *geoJSONObject         `json:"geometry"`
+	Features    []geoJSONObject        `json:"features"`
+	Coordinates json.RawMessage        `json:"coordinates"`
+}
+
+// ParseGeoJSON returns the polygon and multi polygon features of a GeoJSON feature collection or feature,
+// named by a given property of each feature, or by the feature id if the property is empty or missing.
+// Features with other geometries are skipped.
+func ParseGeoJSON(data []byte, nameProperty string) ([]GeoFeature, error) {
+	var root geoJSONObject
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+
+	var features []geoJSONObject
+	switch root.Type {
+	case "FeatureCollection":
+		features = root.Features
+	case "Feature":
+		features = []geoJSONObject{root}
+	default:
+		return nil, fmt.Errorf("geojson must be a FeatureCollection or Feature, got %q", root.Type)
+	}
+
+	var output []GeoFeature
+	for _, f := range features {
+		if f.Geometry == nil {
+			continue
+		}
+		var polygons [][][][2]float64
+		switch f.Geometry.Type {
+		case "Polygon":
+			var polygon [][][2]float64
+			if err := json.Unmarshal(f.Geometry.Coordinates, &polygon); err != nil {
+				return nil, err
+			}
+			polygons = [][][][2]float64{polygon}
+		case "MultiPolygon":
+			if err := json.Unmarshal(f.Geometry.Coordinates, &polygons); err != nil {
+				return nil, err
+			}
+		default:
+			continue
+		}
+
+		var name string
+		if value, ok := f.Properties[nameProperty]; ok && len(nameProperty) > 0 && value != nil {
+			name = fmt.Sprint(value)
+		} else if f.ID != nil {
+			name = fmt.Sprint(f.ID)
+		}
+		output = append(output, GeoFeature{
+			Name:     name,
+			Polygons: polygons,
+		})
+	}
+	return output, nil
+}
@@ -0,0 +1,46 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+const testGeoJSON = `{
+	"type": "FeatureCollection",
+	"features": [
+		{"type": "Feature", "id": "A", "properties": {"name": "Alpha"}, "geometry": {"type": "Polygon", "coordinates": [[[0, 0], [10, 0], [10, 10], [0, 0]]]}},
+		{"type": "Feature", "id": 2, "properties": {}, "geometry": {"type": "MultiPolygon", "coordinates": [[[[20, 0], [30, 0], [30, 10, 100], [20, 0]]], [[[40, 0], [50, 0], [50, 10], [40, 0]]]]}},
+		{"type": "Feature", "id": "C", "properties": {"name": "Charlie"}, "geometry": {"type": "Point", "coordinates": [0, 0]}}
+	]
+}`
+
+func TestParseGeoJSON(t *testing.T) {
+	// replaced new assertions helper
+
+	features, err := ParseGeoJSON([]byte(testGeoJSON), "name")
+	testutil.AssertNil(t, err)
+	testutil.AssertLen(t, features, 2)
+
+	testutil.AssertEqual(t, "Alpha", features[0].Name)
+	testutil.AssertLen(t, features[0].Polygons, 1)
+	testutil.AssertEqual(t, [][2]float64{{0, 0}, {10, 0}, {10, 10}, {0, 0}}, features[0].Polygons[0][0])
+
+	testutil.AssertEqual(t, "2", features[1].Name)
+	testutil.AssertLen(t, features[1].Polygons, 2)
+	testutil.AssertEqual(t, [2]float64{30, 10}, features[1].Polygons[0][0][2])
+}
+
+func TestParseGeoJSONFeature(t *testing.T) {
+	// replaced new assertions helper
+
+	features, err := ParseGeoJSON([]byte(`{"type": "Feature", "id": "A", "geometry": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [0, 1], [0, 0]]]}}`), "")
+	testutil.AssertNil(t, err)
+	testutil.AssertLen(t, features, 1)
+	testutil.AssertEqual(t, "A", features[0].Name)
+
+	_, err = ParseGeoJSON([]byte(`{"type": "Point", "coordinates": [0, 0]}`), "")
+	testutil.AssertNotNil(t, err)
+	_, err = ParseGeoJSON([]byte(`not json`), "")
+	testutil.AssertNotNil(t, err)
+}
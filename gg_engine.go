@@ -0,0 +1,113 @@
+package chart
+
+import (
+	"image/color"
+	"io"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"golang.org/x/image/font"
+)
+
+// GGContext is the subset of the methods of a fogleman/gg `*gg.Context` that charts draw with, so that
+// a gg context can be passed to `GG` without this package depending on gg.
+type GGContext interface {
+	MoveTo(x, y float64)
+	LineTo(x, y float64)
+	QuadraticTo(x1, y1, x2, y2 float64)
+	ClosePath()
+	ClearPath()
+
+	SetColor(c color.Color)
+	SetLineWidth(lineWidth float64)
+	SetDash(dashes ...float64)
+	FillPreserve()
+	StrokePreserve()
+
+	SetFontFace(fontFace font.Face)
+	DrawString(s string, x, y float64)
+
+	Push()
+	Pop()
+	RotateAbout(angle, x, y float64)
+
+	EncodePNG(w io.Writer) error
+}
+
+// GG returns a renderer provider that draws charts into an existing fogleman/gg context, e.g. to compose
+// a chart with other graphics; translate or scale the context first to place the chart within it.
+// Rendering a chart writes the whole context as a png.
+func GG(dc GGContext) RendererProvider {
+	return EngineRenderer(func(_, _ int) (Engine, error) {
+		return &ggEngine{
+			dc:    dc,
+			faces: map[ggFaceKey]font.Face{},
+		}, nil
+	})
+}
+
+// ggFaceKey identifies a font face by font and pixel size.
+type ggFaceKey struct {
+	font *truetype.Font
+	size float64
+}
+
+// ggEngine is an `Engine` that draws into a gg context.
+type ggEngine struct {
+	dc    GGContext
+	faces map[ggFaceKey]font.Face
+}
+
+func (ge *ggEngine) MoveTo(x, y float64) {
+	ge.dc.MoveTo(x, y)
+}
+
+func (ge *ggEngine) LineTo(x, y float64) {
+	ge.dc.LineTo(x, y)
+}
+
+func (ge *ggEngine) QuadCurveTo(cx, cy, x, y float64) {
+	ge.dc.QuadraticTo(cx, cy, x, y)
+}
+
+func (ge *ggEngine) ClosePath() {
+	ge.dc.ClosePath()
+}
+
+func (ge *ggEngine) DrawPath(fill, stroke *EnginePaint) {
+	if fill != nil {
+		ge.dc.SetColor(fill.Color)
+		ge.dc.FillPreserve()
+	}
+	if stroke != nil {
+		ge.dc.SetColor(stroke.Color)
+		ge.dc.SetLineWidth(stroke.Width)
+		ge.dc.SetDash(stroke.DashArray...)
+		ge.dc.StrokePreserve()
+		ge.dc.SetDash()
+	}
+	ge.dc.ClearPath()
+}
+
+func (ge *ggEngine) DrawText(text string, x, y float64, f *truetype.Font, size float64, color drawing.Color, radians float64) {
+	key := ggFaceKey{font: f, size: size}
+	face, ok := ge.faces[key]
+	if !ok {
+		face = truetype.NewFace(f, &truetype.Options{Size: size, DPI: 72})
+		ge.faces[key] = face
+	}
+	ge.dc.SetFontFace(face)
+	ge.dc.SetColor(color)
+	if radians == 0 {
+		ge.dc.DrawString(text, x, y)
+		return
+	}
+	ge.dc.Push()
+	ge.dc.RotateAbout(radians, x, y)
+	ge.dc.DrawString(text, x, y)
+	ge.dc.Pop()
+}
+
+func (ge *ggEngine) Save(w io.Writer) error {
+	return ge.dc.EncodePNG(w)
+}
@@ -0,0 +1,99 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"io"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+	"golang.org/x/image/font"
+)
+
+// testGGContext records the calls a chart makes to a gg context.
+type testGGContext struct {
+	calls []string
+}
+
+func (tc *testGGContext) record(format string, args ...interface{}) {
+	tc.calls = append(tc.calls, fmt.Sprintf(format, args...))
+}
+
+func (tc *testGGContext) MoveTo(x, y float64)                { tc.record("MoveTo %.0f %.0f", x, y) }
+func (tc *testGGContext) LineTo(x, y float64)                { tc.record("LineTo %.0f %.0f", x, y) }
+func (tc *testGGContext) QuadraticTo(x1, y1, x2, y2 float64) { tc.record("QuadraticTo") }
+func (tc *testGGContext) ClosePath()                         { tc.record("ClosePath") }
+func (tc *testGGContext) ClearPath()                         { tc.record("ClearPath") }
+func (tc *testGGContext) SetColor(c color.Color)             { tc.record("SetColor") }
+func (tc *testGGContext) SetLineWidth(lineWidth float64)     { tc.record("SetLineWidth %v", lineWidth) }
+func (tc *testGGContext) SetDash(dashes ...float64)          { tc.record("SetDash %v", dashes) }
+func (tc *testGGContext) FillPreserve()                      { tc.record("FillPreserve") }
+func (tc *testGGContext) StrokePreserve()                    { tc.record("StrokePreserve") }
+func (tc *testGGContext) SetFontFace(fontFace font.Face)     { tc.record("SetFontFace") }
+func (tc *testGGContext) DrawString(s string, x, y float64)  { tc.record("DrawString %s", s) }
+func (tc *testGGContext) Push()                              { tc.record("Push") }
+func (tc *testGGContext) Pop()                               { tc.record("Pop") }
+func (tc *testGGContext) RotateAbout(angle, x, y float64)    { tc.record("RotateAbout") }
+func (tc *testGGContext) EncodePNG(w io.Writer) error {
+	_, err := w.Write([]byte("png"))
+	return err
+}
+
+func TestGGPaths(t *testing.T) {
+	// replaced new assertions helper
+
+	dc := &testGGContext{}
+	r, err := GG(dc)(100, 100)
+	testutil.AssertNil(t, err)
+
+	r.SetFillColor(ColorBlue)
+	r.SetStrokeColor(ColorRed)
+	r.SetStrokeWidth(2)
+	r.SetStrokeDashArray([]float64{1, 2})
+	r.MoveTo(0, 0)
+	r.LineTo(10, 5)
+	r.FillStroke()
+	testutil.AssertEqual(t, []string{
+		"MoveTo 0 0",
+		"LineTo 10 5",
+		"SetColor",
+		"FillPreserve",
+		"SetColor",
+		"SetLineWidth 2",
+		"SetDash [1 2]",
+		"StrokePreserve",
+		"SetDash []",
+		"ClearPath",
+	}, dc.calls)
+
+	dc.calls = nil
+	font, _ := GetDefaultFont()
+	r.SetFont(font)
+	r.SetFontSize(10)
+	r.SetTextRotation(_pi2)
+	r.Text("rotated", 10, 10)
+	testutil.AssertEqual(t, []string{"SetFontFace", "SetColor", "Push", "RotateAbout", "DrawString rotated", "Pop"}, dc.calls)
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(b))
+	testutil.AssertEqual(t, "png", b.String())
+}
+
+func TestGGChart(t *testing.T) {
+	// replaced new assertions helper
+
+	dc := &testGGContext{}
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 3, 2},
+			},
+		},
+	}
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(GG(dc), b))
+	testutil.AssertNotEmpty(t, dc.calls)
+	testutil.AssertEqual(t, "png", b.String())
+}
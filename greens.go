@@ -0,0 +1,31 @@
+package chart
+
+import (
+	"math"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+var greensColors = []drawing.Color{
+	drawing.ColorFromHex("9be9a8"),
+	drawing.ColorFromHex("40c463"),
+	drawing.ColorFromHex("30a14e"),
+	drawing.ColorFromHex("216e39"),
+}
+
+// Greens is a color map from a pale to a dark green, like the contribution graphs of GitHub.
+func Greens(v, vmin, vmax float64) drawing.Color {
+	normalized := 1.0
+	if vmax > vmin {
+		normalized = math.Max(0, math.Min(1, (v-vmin)/(vmax-vmin)))
+	}
+	position := normalized * float64(len(greensColors)-1)
+	index := MinInt(int(position), len(greensColors)-2)
+	t := position - float64(index)
+
+	from, to := greensColors[index], greensColors[index+1]
+	lerp := func(a, b uint8) uint8 {
+		return uint8(math.Round(float64(a) + (float64(b)-float64(a))*t))
+	}
+	return drawing.Color{R: lerp(from.R, to.R), G: lerp(from.G, to.G), B: lerp(from.B, to.B), A: 0xff}
+}
@@ -22,6 +22,12 @@ func (gl GridLine) Minor() bool {
 	return gl.IsMinor
 }
 
+// Clone returns a copy of the gridline.
+func (gl GridLine) Clone() GridLine {
+	gl.Style = gl.Style.Clone()
+	return gl
+}
+
 // Render renders the gridline
 func (gl GridLine) Render(r Renderer, canvasBox Box, ra Range, isVertical bool, defaults Style) {
 	r.SetStrokeColor(gl.Style.GetStrokeColor(defaults.GetStrokeColor()))
@@ -47,6 +53,18 @@ func (gl GridLine) Render(r Renderer, canvasBox Box, ra Range, isVertical bool,
 	}
 }
 
+// CloneGridLines returns a copy of a set of grid lines.
+func CloneGridLines(gridLines []GridLine) []GridLine {
+	if gridLines == nil {
+		return nil
+	}
+	output := make([]GridLine, len(gridLines))
+	for index, gl := range gridLines {
+		output[index] = gl.Clone()
+	}
+	return output
+}
+
 // GenerateGridLines generates grid lines.
 func GenerateGridLines(ticks []Tick, majorStyle, minorStyle Style) []GridLine {
 	var gl []GridLine
@@ -70,3 +88,47 @@ func GenerateGridLines(ticks []Tick, majorStyle, minorStyle Style) []GridLine {
 	}
 	return gl
 }
+
+// GenerateTickGridLines generates a major grid line in a given style at every tick within a range, leaving out
+// ticks at the ends of the range, where the grid lines would be drawn over the axis lines.
+func GenerateTickGridLines(ticks []Tick, ra Range, style Style) []GridLine {
+	min, max := ra.GetMin(), ra.GetMax()
+	if min > max {
+		min, max = max, min
+	}
+	var gl []GridLine
+	for _, t := range ticks {
+		if t.Value <= min || t.Value >= max {
+			continue
+		}
+		gl = append(gl, GridLine{
+			Style: style,
+			Value: t.Value,
+		})
+	}
+	return gl
+}
+
+// drawTickGridLines draws grid lines at the ticks of an axis in a style, if it is set, and at its minor ticks
+// in a minor style, if it is set and there are minor ticks, under the major grid lines.
+func drawTickGridLines(r Renderer, canvasBox Box, ra Range, isVertical bool, ticks []Tick, minorTicks int, style, minorStyle Style) {
+	if minorTicks > 0 && !minorStyle.IsZero() && !minorStyle.Hidden {
+		minorStyle = minorStyle.InheritFrom(Style{
+			StrokeColor: DefaultMinorGridLineColor,
+			StrokeWidth: DefaultGridLineWidth,
+		})
+		for _, gl := range GenerateTickGridLines(GenerateMinorTicks(ticks, ra, minorTicks), ra, minorStyle) {
+			gl.IsMinor = true
+			gl.Render(r, canvasBox, ra, isVertical, minorStyle)
+		}
+	}
+	if !style.IsZero() && !style.Hidden {
+		style = style.InheritFrom(Style{
+			StrokeColor: DefaultGridLineColor,
+			StrokeWidth: DefaultGridLineWidth,
+		})
+		for _, gl := range GenerateTickGridLines(ticks, ra, style) {
+			gl.Render(r, canvasBox, ra, isVertical, style)
+		}
+	}
+}
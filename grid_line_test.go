@@ -1,6 +1,8 @@
 package chart
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/wcharczuk/go-chart/v2/testutil"
@@ -22,3 +24,87 @@ func TestGenerateGridLines(t *testing.T) {
 	testutil.AssertEqual(t, 2.0, gl[0].Value)
 	testutil.AssertEqual(t, 3.0, gl[1].Value)
 }
+
+func TestGenerateTickGridLines(t *testing.T) {
+	// replaced new assertions helper
+
+	ticks := []Tick{
+		{Value: 0.0, Label: "0.0"},
+		{Value: 2.5, Label: "2.5"},
+		{Value: 5.0, Label: "5.0"},
+		{Value: 7.5, Label: "7.5"},
+		{Value: 10.0, Label: "10.0"},
+	}
+
+	style := Style{StrokeDashArray: []float64{1, 2}}
+	gl := GenerateTickGridLines(ticks, &ContinuousRange{Min: 0, Max: 10}, style)
+	testutil.AssertLen(t, gl, 3)
+	for index, line := range gl {
+		testutil.AssertTrue(t, line.Major())
+		testutil.AssertEqual(t, ticks[index+1].Value, line.Value)
+		testutil.AssertEqual(t, style.StrokeDashArray, line.Style.StrokeDashArray)
+	}
+
+	gl = GenerateTickGridLines(ticks, &ContinuousRange{Min: 1, Max: 9, Descending: true}, style)
+	testutil.AssertLen(t, gl, 3)
+}
+
+func TestAxisGridStyle(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Width:  200,
+		Height: 200,
+		XAxis: XAxis{
+			GridStyle: Style{StrokeColor: ColorRed, StrokeDashArray: []float64{1, 2}},
+		},
+		YAxis: YAxis{
+			GridStyle: Style{StrokeColor: ColorRed, StrokeDashArray: []float64{1, 2}},
+		},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), `stroke-dasharray="1.0, 2.0"`)
+}
+
+func TestAxisGridStyleDashArrayOnly(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Width:  200,
+		Height: 200,
+		YAxis: YAxis{
+			Ticks:     []Tick{{Value: 0, Label: "0"}, {Value: 5, Label: "5"}, {Value: 10, Label: "10"}},
+			GridStyle: Style{StrokeDashArray: []float64{4, 2}},
+		},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertEqual(t, 1, strings.Count(buffer.String(), `stroke-dasharray="4.0, 2.0"`))
+}
+
+func TestAxisMinorTicks(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Width:  200,
+		Height: 200,
+		YAxis: YAxis{
+			Ticks:          []Tick{{Value: 0, Label: "0"}, {Value: 10, Label: "10"}},
+			MinorTicks:     1,
+			GridMinorStyle: Style{StrokeColor: ColorRed, StrokeDashArray: []float64{1, 3}},
+		},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertEqual(t, 1, strings.Count(buffer.String(), `stroke-dasharray="1.0, 3.0"`))
+}
@@ -0,0 +1,279 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// HeatMapChart is a chart that draws a grid of values as cells colored through a color map, with
+// optional row and column labels, the value of each cell, and a color bar legend.
+//
+// `Values[row][column]` is drawn with the first row at the top, like a matrix; cells that are NaN
+// are left empty. To draw the counts of a `Histogram2D` with the lowest values at the bottom, reverse
+// its rows.
+type HeatMapChart struct {
+	ChartFrame
+
+	CellStyle   Style
+	LabelStyle  Style
+	ValueStyle  Style
+	LegendStyle Style
+
+	// CellSpacing is the pixel spacing between cells.
+	CellSpacing int
+	// ShowValues draws the value of each cell inside it.
+	ShowValues bool
+
+	// ColorMap maps the values to colors, it defaults to `Jet`.
+	ColorMap ColorMap
+	// ValueRange bounds the color map, it defaults to the extent of the values.
+	ValueRange     Range
+	ValueFormatter ValueFormatter
+
+	Values       [][]float64
+	RowLabels    []string
+	ColumnLabels []string
+	Elements     []Renderable
+}
+
+// GetCellSpacing returns the spacing between cells or the default value.
+func (hmc HeatMapChart) GetCellSpacing() int {
+	if hmc.CellSpacing == 0 {
+		return DefaultHeatMapCellSpacing
+	}
+	return hmc.CellSpacing
+}
+
+// GetColorMap returns the color map or the default.
+func (hmc HeatMapChart) GetColorMap() ColorMap {
+	if hmc.ColorMap == nil {
+		return Jet
+	}
+	return hmc.ColorMap
+}
+
+// GetValueFormatter returns the value formatter or the default.
+func (hmc HeatMapChart) GetValueFormatter() ValueFormatter {
+	if hmc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return hmc.ValueFormatter
+}
+
+// GetValueRange returns the min and max of the color map, either the value range or the extent of the values.
+func (hmc HeatMapChart) GetValueRange() (min, max float64) {
+	if hmc.ValueRange != nil && !hmc.ValueRange.IsZero() {
+		return hmc.ValueRange.GetMin(), hmc.ValueRange.GetMax()
+	}
+	min, max = math.MaxFloat64, -math.MaxFloat64
+	for _, row := range hmc.Values {
+		for _, value := range row {
+			if !math.IsNaN(value) {
+				min, max = math.Min(min, value), math.Max(max, value)
+			}
+		}
+	}
+	return
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (hmc HeatMapChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := hmc.validate(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := hmc.GetWidth(), hmc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if hmc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		hmc.defaultFont = defaultFont
+	}
+	r.SetDPI(hmc.GetDPI(DefaultDPI))
+
+	canvasBox := hmc.getDefaultCanvasBox(r, width, height)
+	gridBox, legendBox := hmc.getLayout(r, canvasBox)
+
+	hmc.drawBackground(r, width, height)
+	hmc.drawCanvas(r, canvasBox)
+	hmc.drawCells(r, gridBox)
+	hmc.drawLabels(r, gridBox)
+	if !hmc.LegendStyle.Hidden {
+		min, max := hmc.GetValueRange()
+		drawColorBarLegend(r, legendBox, hmc.GetColorMap(), min, max, hmc.GetValueFormatter(), hmc.styleDefaultsLegend())
+	}
+	hmc.drawTitle(r, width, height)
+	for _, a := range hmc.Elements {
+		a(r, canvasBox, hmc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (hmc HeatMapChart) validate() error {
+	if len(hmc.Values) == 0 || len(hmc.Values[0]) == 0 {
+		return errors.New("please provide at least one value")
+	}
+	var hasValue bool
+	for _, row := range hmc.Values {
+		if len(row) != len(hmc.Values[0]) {
+			return fmt.Errorf("heat map chart rows must have the same number of values")
+		}
+		for _, value := range row {
+			hasValue = hasValue || !math.IsNaN(value)
+		}
+	}
+	if !hasValue {
+		return fmt.Errorf("heat map chart must contain at least (1) value that is not NaN")
+	}
+	return nil
+}
+
+// getLayout splits the canvas into the grid, with the row labels to its left and the column labels
+// below it, and the color bar and its labels to the right of it.
+func (hmc HeatMapChart) getLayout(r Renderer, canvasBox Box) (gridBox, legendBox Box) {
+	gridBox = canvasBox
+	if !hmc.LabelStyle.Hidden {
+		labelStyle := hmc.styleDefaultsLabels()
+		var rowLabelWidth, columnLabelHeight int
+		for _, label := range hmc.RowLabels {
+			rowLabelWidth = MaxInt(rowLabelWidth, Draw.MeasureText(r, label, labelStyle).Width())
+		}
+		for _, label := range hmc.ColumnLabels {
+			columnLabelHeight = MaxInt(columnLabelHeight, Draw.MeasureText(r, label, labelStyle).Height())
+		}
+		if rowLabelWidth > 0 {
+			gridBox.Left += rowLabelWidth + DefaultHeatMapLabelGap
+		}
+		if columnLabelHeight > 0 {
+			gridBox.Bottom -= columnLabelHeight + DefaultHeatMapLabelGap
+		}
+	}
+	if !hmc.LegendStyle.Hidden {
+		min, max := hmc.GetValueRange()
+		gridBox.Right -= DefaultColorBarGap + colorBarLegendWidth(r, min, max, hmc.GetValueFormatter(), hmc.styleDefaultsLegend())
+		legendBox = colorBarLegendBox(gridBox)
+	}
+	return
+}
+
+// getCellBox returns the box of a cell of the grid, with the cell spacing around it.
+func (hmc HeatMapChart) getCellBox(gridBox Box, row, column int) Box {
+	rows, columns := len(hmc.Values), len(hmc.Values[0])
+	spacing := hmc.GetCellSpacing()
+	edge := func(start, length, index, count int) int {
+		return start + int(math.Round(float64(length)*float64(index)/float64(count)))
+	}
+	return Box{
+		Top:    edge(gridBox.Top, gridBox.Height(), row, rows),
+		Left:   edge(gridBox.Left, gridBox.Width(), column, columns),
+		Right:  edge(gridBox.Left, gridBox.Width(), column+1, columns) - spacing,
+		Bottom: edge(gridBox.Top, gridBox.Height(), row+1, rows) - spacing,
+	}
+}
+
+func (hmc HeatMapChart) drawCells(r Renderer, gridBox Box) {
+	if gridBox.Width() <= 0 || gridBox.Height() <= 0 {
+		return
+	}
+	min, max := hmc.GetValueRange()
+	valueStyle := hmc.styleDefaultsValues()
+
+	for row, values := range hmc.Values {
+		for column, value := range values {
+			if math.IsNaN(value) {
+				continue
+			}
+			color := hmc.GetColorMap()(value, min, max)
+			cellBox := hmc.getCellBox(gridBox, row, column)
+			Draw.Box(r, cellBox, hmc.CellStyle.InheritFrom(Style{
+				FillColor:   color,
+				StrokeColor: color,
+				StrokeWidth: DefaultStrokeWidth,
+			}))
+
+			if hmc.ShowValues {
+				label := hmc.GetValueFormatter()(value)
+				style := valueStyle
+				if style.FontColor.IsZero() {
+					style.FontColor = heatMapTextColor(color)
+				}
+				tb := Draw.MeasureText(r, label, style)
+				if tb.Width() < cellBox.Width() && tb.Height() < cellBox.Height() {
+					cx, cy := cellBox.Center()
+					Draw.Text(r, label, cx-tb.Width()>>1, cy+tb.Height()>>1, style)
+				}
+			}
+		}
+	}
+}
+
+// drawLabels draws the row labels to the left of the grid and the column labels below it.
+func (hmc HeatMapChart) drawLabels(r Renderer, gridBox Box) {
+	if hmc.LabelStyle.Hidden {
+		return
+	}
+	labelStyle := hmc.styleDefaultsLabels()
+	for row, label := range hmc.RowLabels {
+		if row >= len(hmc.Values) || len(label) == 0 {
+			continue
+		}
+		cellBox := hmc.getCellBox(gridBox, row, 0)
+		_, cy := cellBox.Center()
+		tb := Draw.MeasureText(r, label, labelStyle)
+		Draw.Text(r, label, gridBox.Left-DefaultHeatMapLabelGap-tb.Width(), cy+tb.Height()>>1, labelStyle)
+	}
+	for column, label := range hmc.ColumnLabels {
+		if column >= len(hmc.Values[0]) || len(label) == 0 {
+			continue
+		}
+		cellBox := hmc.getCellBox(gridBox, len(hmc.Values)-1, column)
+		cx, _ := cellBox.Center()
+		tb := Draw.MeasureText(r, label, labelStyle)
+		Draw.Text(r, label, cx-tb.Width()>>1, gridBox.Bottom+DefaultHeatMapLabelGap+tb.Height(), labelStyle)
+	}
+}
+
+// heatMapTextColor returns a text color that stands out on a cell of a given color.
+func heatMapTextColor(c drawing.Color) drawing.Color {
+	if 0.299*float64(c.R)+0.587*float64(c.G)+0.114*float64(c.B) > 150 {
+		return ColorBlack
+	}
+	return ColorWhite
+}
+
+func (hmc HeatMapChart) styleDefaultsLabels() Style {
+	return hmc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: hmc.GetColorPalette().TextColor(),
+		Font:      hmc.GetFont(),
+	})
+}
+
+// styleDefaultsValues leaves the font color unset, so that each cell can pick one that stands out on it.
+func (hmc HeatMapChart) styleDefaultsValues() Style {
+	return hmc.ValueStyle.InheritFrom(Style{
+		FontSize: DefaultFontSize,
+		Font:     hmc.GetFont(),
+	})
+}
+
+func (hmc HeatMapChart) styleDefaultsLegend() Style {
+	return hmc.LegendStyle.InheritFrom(Style{
+		FontSize:    DefaultFontSize,
+		FontColor:   hmc.GetColorPalette().TextColor(),
+		Font:        hmc.GetFont(),
+		StrokeColor: hmc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
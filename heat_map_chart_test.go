@@ -0,0 +1,79 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestHeatMapChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	hmc := HeatMapChart{
+		ChartFrame: ChartFrame{
+			Title: "Test",
+		},
+		ShowValues:   true,
+		RowLabels:    []string{"a", "b"},
+		ColumnLabels: []string{"x", "y", "z"},
+		Values: [][]float64{
+			{1, 2, 3},
+			{4, math.NaN(), 6},
+		},
+	}
+	for _, rp := range []RendererProvider{PNG, SVG} {
+		b := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, hmc.Render(rp, b))
+		testutil.AssertNotZero(t, b.Len())
+	}
+}
+
+func TestHeatMapChartValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, HeatMapChart{}.Render(PNG, b))
+	testutil.AssertNotNil(t, HeatMapChart{Values: [][]float64{{1, 2}, {3}}}.Render(PNG, b))
+	testutil.AssertNotNil(t, HeatMapChart{Values: [][]float64{{math.NaN()}}}.Render(PNG, b))
+}
+
+func TestHeatMapChartGetValueRange(t *testing.T) {
+	// replaced new assertions helper
+
+	hmc := HeatMapChart{
+		Values: [][]float64{
+			{3, math.NaN()},
+			{-1, 2},
+		},
+	}
+	min, max := hmc.GetValueRange()
+	testutil.AssertEqual(t, -1.0, min)
+	testutil.AssertEqual(t, 3.0, max)
+
+	hmc.ValueRange = &ContinuousRange{Min: 0, Max: 10}
+	min, max = hmc.GetValueRange()
+	testutil.AssertEqual(t, 0.0, min)
+	testutil.AssertEqual(t, 10.0, max)
+}
+
+func TestHeatMapChartGetCellBox(t *testing.T) {
+	// replaced new assertions helper
+
+	hmc := HeatMapChart{
+		CellSpacing: 2,
+		Values:      [][]float64{{1, 2}, {3, 4}},
+	}
+	gridBox := Box{Top: 0, Left: 0, Right: 100, Bottom: 50}
+	testutil.AssertEqual(t, Box{Top: 0, Left: 0, Right: 48, Bottom: 23}, hmc.getCellBox(gridBox, 0, 0))
+	testutil.AssertEqual(t, Box{Top: 25, Left: 50, Right: 98, Bottom: 48}, hmc.getCellBox(gridBox, 1, 1))
+}
+
+func TestHeatMapTextColor(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertEqual(t, ColorBlack, heatMapTextColor(drawing.ColorFromHex("ffff00")))
+	testutil.AssertEqual(t, ColorWhite, heatMapTextColor(drawing.ColorFromHex("0000ff")))
+}
@@ -0,0 +1,149 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultHistogramBins is the default number of bins of a histogram.
+	DefaultHistogramBins = 10
+)
+
+// Histogram bins a set of values into counts, either into a number of equal width bins across the
+// extent of the values, or into bins with explicit edges.
+//
+// Use `BinSeries` to draw the counts as bars, or `CumulativeCounts` for a cumulative distribution.
+type Histogram struct {
+	Bins int
+	// Edges are explicit, ascending bin edges, one more than the number of bins; they take precedence over `Bins` and `Range`.
+	Edges []float64
+	// Range bounds the bins, it defaults to the extent of the values.
+	// Values outside of the bounds are not counted.
+	Range Range
+
+	Values []float64
+}
+
+// GetBins returns the number of bins or a default.
+func (h Histogram) GetBins() int {
+	if len(h.Edges) > 1 {
+		return len(h.Edges) - 1
+	}
+	if h.Bins == 0 {
+		return DefaultHistogramBins
+	}
+	return h.Bins
+}
+
+// Validate validates the histogram.
+func (h Histogram) Validate() error {
+	if h.Bins < 0 {
+		return fmt.Errorf("histogram requires the bin count to be positive")
+	}
+	if len(h.Edges) == 1 {
+		return fmt.Errorf("histogram requires at least two edges")
+	}
+	for index := 1; index < len(h.Edges); index++ {
+		if h.Edges[index] <= h.Edges[index-1] {
+			return fmt.Errorf("histogram requires edges to be ascending")
+		}
+	}
+	return nil
+}
+
+// Counts returns the number of values in each bin, along with the edges of the bins.
+// There is one more edge than there are bins, and the last bin includes its upper edge.
+func (h Histogram) Counts() (counts, edges []float64) {
+	bins := h.GetBins()
+	counts = make([]float64, bins)
+
+	if len(h.Edges) > 1 {
+		edges = h.Edges
+		for _, value := range h.Values {
+			if index, ok := h.explicitBin(value); ok {
+				counts[index]++
+			}
+		}
+		return
+	}
+
+	min, max := h.getBounds()
+	edges = histogramEdges(min, max, bins)
+	for _, value := range h.Values {
+		if index, ok := histogramBin(value, min, max, bins); ok {
+			counts[index]++
+		}
+	}
+	return
+}
+
+// CumulativeCounts returns the number of values in each bin and all of the bins below it, along with
+// the edges of the bins; divided by the number of values, they are the cumulative distribution of the
+// values at the upper edge of each bin.
+func (h Histogram) CumulativeCounts() (counts, edges []float64) {
+	counts, edges = h.Counts()
+	for index := 1; index < len(counts); index++ {
+		counts[index] += counts[index-1]
+	}
+	return
+}
+
+// BinSeries returns a series that draws the counts of the histogram as bars spanning each bin.
+func (h Histogram) BinSeries() BinSeries {
+	counts, edges := h.Counts()
+	return BinSeries{
+		Edges:  edges,
+		Counts: counts,
+	}
+}
+
+func (h Histogram) getBounds() (min, max float64) {
+	min, max = math.MaxFloat64, -math.MaxFloat64
+	for _, value := range h.Values {
+		if math.IsNaN(value) {
+			continue
+		}
+		min, max = math.Min(min, value), math.Max(max, value)
+	}
+	if h.Range != nil && !h.Range.IsZero() {
+		min, max = h.Range.GetMin(), h.Range.GetMax()
+	}
+	return
+}
+
+func (h Histogram) explicitBin(value float64) (int, bool) {
+	last := len(h.Edges) - 1
+	if math.IsNaN(value) || value < h.Edges[0] || value > h.Edges[last] {
+		return 0, false
+	}
+	// the bin is the last edge at or below the value.
+	index := sort.Search(len(h.Edges), func(i int) bool {
+		return h.Edges[i] > value
+	}) - 1
+	return MinInt(index, last-1), true
+}
+
+// histogramEdges returns the edges of a number of equal width bins between a min and a max.
+func histogramEdges(min, max float64, bins int) []float64 {
+	if min > max {
+		return nil
+	}
+	edges := make([]float64, bins+1)
+	for index := range edges {
+		edges[index] = min + (max-min)*float64(index)/float64(bins)
+	}
+	return edges
+}
+
+// histogramBin returns the equal width bin between a min and a max that a value falls into, if any.
+func histogramBin(value, min, max float64, bins int) (int, bool) {
+	if math.IsNaN(value) || value < min || value > max {
+		return 0, false
+	}
+	if max == min {
+		return 0, true
+	}
+	return MinInt(int((value-min)/(max-min)*float64(bins)), bins-1), true
+}
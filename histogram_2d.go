@@ -0,0 +1,100 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultHistogram2DBins is the default number of bins along each axis of a 2d histogram.
+	DefaultHistogram2DBins = 20
+)
+
+// Histogram2D bins a cloud of (x, y) points into a grid of counts, e.g. to show the
+// density of a large scatter as a heat map.
+type Histogram2D struct {
+	XBins int
+	YBins int
+
+	// XRange and YRange bound the grid, they default to the extent of the values.
+	// Points outside of the bounds are not counted.
+	XRange Range
+	YRange Range
+
+	InnerSeries ValuesProvider
+}
+
+// GetXBins returns the number of bins along the x axis or a default.
+func (h Histogram2D) GetXBins() int {
+	if h.XBins == 0 {
+		return DefaultHistogram2DBins
+	}
+	return h.XBins
+}
+
+// GetYBins returns the number of bins along the y axis or a default.
+func (h Histogram2D) GetYBins() int {
+	if h.YBins == 0 {
+		return DefaultHistogram2DBins
+	}
+	return h.YBins
+}
+
+// Validate validates the histogram.
+func (h Histogram2D) Validate() error {
+	if h.InnerSeries == nil {
+		return fmt.Errorf("histogram 2d requires InnerSeries to be set")
+	}
+	if h.XBins < 0 || h.YBins < 0 {
+		return fmt.Errorf("histogram 2d requires bin counts to be positive")
+	}
+	return nil
+}
+
+// Counts returns the number of points in each bin, with a row for each y bin starting from the lowest
+// values and a column for each x bin, along with the edges of the bins along each axis.
+// There is one more edge than there are bins, and the last bin of each axis includes its upper edge.
+func (h Histogram2D) Counts() (counts [][]float64, xedges, yedges []float64) {
+	xbins, ybins := h.GetXBins(), h.GetYBins()
+	counts = make([][]float64, ybins)
+	for row := range counts {
+		counts[row] = make([]float64, xbins)
+	}
+	if h.InnerSeries == nil {
+		return
+	}
+
+	xmin, xmax, ymin, ymax := h.getBounds()
+	xedges = histogramEdges(xmin, xmax, xbins)
+	yedges = histogramEdges(ymin, ymax, ybins)
+
+	for index := 0; index < h.InnerSeries.Len(); index++ {
+		x, y := h.InnerSeries.GetValues(index)
+		col, colOk := histogramBin(x, xmin, xmax, xbins)
+		row, rowOk := histogramBin(y, ymin, ymax, ybins)
+		if colOk && rowOk {
+			counts[row][col]++
+		}
+	}
+	return
+}
+
+func (h Histogram2D) getBounds() (xmin, xmax, ymin, ymax float64) {
+	xmin, ymin = math.MaxFloat64, math.MaxFloat64
+	xmax, ymax = -math.MaxFloat64, -math.MaxFloat64
+	for index := 0; index < h.InnerSeries.Len(); index++ {
+		x, y := h.InnerSeries.GetValues(index)
+		if math.IsNaN(x) || math.IsNaN(y) {
+			continue
+		}
+		xmin, xmax = math.Min(xmin, x), math.Max(xmax, x)
+		ymin, ymax = math.Min(ymin, y), math.Max(ymax, y)
+	}
+	if h.XRange != nil && !h.XRange.IsZero() {
+		xmin, xmax = h.XRange.GetMin(), h.XRange.GetMax()
+	}
+	if h.YRange != nil && !h.YRange.IsZero() {
+		ymin, ymax = h.YRange.GetMin(), h.YRange.GetMax()
+	}
+	return
+}
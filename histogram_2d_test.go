@@ -0,0 +1,59 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestHistogram2DCounts(t *testing.T) {
+	// replaced new assertions helper
+
+	h := Histogram2D{
+		XBins: 2,
+		YBins: 3,
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{0, 1, 2, 2, 0.5},
+			YValues: []float64{0, 0, 3, 3, 1.5},
+		},
+	}
+	testutil.AssertNil(t, h.Validate())
+
+	counts, xedges, yedges := h.Counts()
+	testutil.AssertEqual(t, []float64{0, 1, 2}, xedges)
+	testutil.AssertEqual(t, []float64{0, 1, 2, 3}, yedges)
+	testutil.AssertEqual(t, [][]float64{
+		{1, 1},
+		{1, 0},
+		{0, 2},
+	}, counts)
+}
+
+func TestHistogram2DRanges(t *testing.T) {
+	// replaced new assertions helper
+
+	h := Histogram2D{
+		XRange: &ContinuousRange{Min: 0, Max: 10},
+		YRange: &ContinuousRange{Min: 0, Max: 10},
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{-1, 5, 5, 11},
+			YValues: []float64{5, 5, 5, 5},
+		},
+	}
+
+	counts, xedges, _ := h.Counts()
+	testutil.AssertLen(t, counts, DefaultHistogram2DBins)
+	testutil.AssertLen(t, xedges, DefaultHistogram2DBins+1)
+
+	var total float64
+	for _, row := range counts {
+		for _, count := range row {
+			total += count
+		}
+	}
+	testutil.AssertEqual(t, 2.0, total)
+	testutil.AssertEqual(t, 2.0, counts[10][10])
+
+	testutil.AssertNotNil(t, Histogram2D{}.Validate())
+	testutil.AssertNotNil(t, Histogram2D{XBins: -1, InnerSeries: h.InnerSeries}.Validate())
+}
@@ -5,11 +5,17 @@ import "fmt"
 // HistogramSeries is a special type of series that draws as a histogram.
 // Some peculiarities; it will always be lower bounded at 0 (at the very least).
 // This may alter ranges a bit and generally you want to put a histogram series on it's own y-axis.
+//
+// It can be mixed with line and area series in the same chart, which share its x range, e.g. volume bars
+// on the secondary y-axis under a price line on the primary.
 type HistogramSeries struct {
 	Name        string
 	Style       Style
 	YAxis       YAxisType
 	InnerSeries ValuesProvider
+
+	// BarWidth is the pixel width of the bars; if unset the bars divide the width of the canvas between them.
+	BarWidth int
 }
 
 // GetName implements Series.GetName.
@@ -55,6 +61,10 @@ func (hs HistogramSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
 // Render implements Series.Render.
 func (hs HistogramSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
 	style := hs.Style.InheritFrom(defaults)
+	if hs.BarWidth > 0 {
+		Draw.HistogramSeries(r, canvasBox, xrange, yrange, style, hs, hs.BarWidth)
+		return
+	}
 	Draw.HistogramSeries(r, canvasBox, xrange, yrange, style, hs)
 }
 
@@ -65,3 +75,9 @@ func (hs HistogramSeries) Validate() error {
 	}
 	return nil
 }
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (hs HistogramSeries) CopySeries() Series {
+	hs.InnerSeries = copyValuesProvider(hs.InnerSeries)
+	return hs
+}
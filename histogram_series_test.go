@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/wcharczuk/go-chart/v2/testutil"
@@ -29,3 +30,51 @@ func TestHistogramSeries(t *testing.T) {
 		testutil.AssertTrue(t, csy > 0 || (csy < 0 && csy == hsy2))
 	}
 }
+
+func TestHistogramSeriesWithLines(t *testing.T) {
+	// replaced new assertions helper
+
+	x := []float64{1, 2, 3, 4}
+	var rendered []string
+	c := Chart{
+		Width:  200,
+		Height: 100,
+		Series: []Series{
+			HistogramSeries{
+				Name:        "volume",
+				YAxis:       YAxisSecondary,
+				InnerSeries: ContinuousSeries{XValues: x, YValues: []float64{100, 300, 200, 400}},
+				BarWidth:    10,
+			},
+			ContinuousSeries{Name: "price", XValues: x, YValues: []float64{10, 11, 10.5, 12}},
+		},
+		Tracer: TracerFunc(func(ti TraceInfo) {
+			if ti.Stage == RenderStageSeries && ti.Err == nil {
+				rendered = append(rendered, ti.SeriesName)
+			}
+		}),
+	}
+	xrange, yrange, yrangeAlt := c.getRanges()
+	testutil.AssertEqual(t, 1.0, xrange.GetMin())
+	testutil.AssertEqual(t, 4.0, xrange.GetMax())
+	testutil.AssertEqual(t, 12.0, yrange.GetMax())
+	testutil.AssertEqual(t, 0.0, yrangeAlt.GetMin())
+	testutil.AssertEqual(t, 400.0, yrangeAlt.GetMax())
+
+	// the bars and the line render together in the one chart.
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertEqual(t, []string{"volume", "price"}, rendered)
+
+	// the bars are drawn at the given width, centered on their values.
+	r, err := SVG(200, 100)
+	testutil.AssertNil(t, err)
+	canvasBox := Box{Top: 0, Left: 10, Right: 110, Bottom: 100}
+	xrange.SetDomain(canvasBox.Width())
+	yrangeAlt.SetDomain(canvasBox.Height())
+	c.Series[0].Render(r, canvasBox, xrange, yrangeAlt, Style{})
+	buffer.Reset()
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), "M 5 100\nL 15 100")
+	testutil.AssertContains(t, buffer.String(), "M 105 100\nL 115 100")
+}
@@ -0,0 +1,89 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestHistogramCounts(t *testing.T) {
+	// replaced new assertions helper
+
+	h := Histogram{
+		Bins:   4,
+		Values: []float64{0, 1, 1.5, 3, 4, 4},
+	}
+	testutil.AssertNil(t, h.Validate())
+
+	counts, edges := h.Counts()
+	testutil.AssertEqual(t, []float64{0, 1, 2, 3, 4}, edges)
+	testutil.AssertEqual(t, []float64{1, 2, 0, 3}, counts)
+
+	cumulative, _ := h.CumulativeCounts()
+	testutil.AssertEqual(t, []float64{1, 3, 3, 6}, cumulative)
+}
+
+func TestHistogramEdges(t *testing.T) {
+	// replaced new assertions helper
+
+	h := Histogram{
+		Edges:  []float64{0, 1, 10, 100},
+		Values: []float64{-1, 0, 0.5, 1, 50, 100, 101},
+	}
+	testutil.AssertNil(t, h.Validate())
+	testutil.AssertEqual(t, 3, h.GetBins())
+
+	counts, edges := h.Counts()
+	testutil.AssertEqual(t, h.Edges, edges)
+	testutil.AssertEqual(t, []float64{2, 1, 2}, counts)
+
+	testutil.AssertNotNil(t, Histogram{Edges: []float64{1}}.Validate())
+	testutil.AssertNotNil(t, Histogram{Edges: []float64{1, 1}}.Validate())
+}
+
+func TestHistogramRange(t *testing.T) {
+	// replaced new assertions helper
+
+	h := Histogram{
+		Bins:   2,
+		Range:  &ContinuousRange{Min: 0, Max: 10},
+		Values: []float64{-1, 2, 5, 10, 11},
+	}
+	counts, edges := h.Counts()
+	testutil.AssertEqual(t, []float64{0, 5, 10}, edges)
+	testutil.AssertEqual(t, []float64{1, 2}, counts)
+}
+
+func TestHistogramBinSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	bs := Histogram{Bins: 2, Values: []float64{0, 1, 2, 2}}.BinSeries()
+	testutil.AssertNil(t, bs.Validate())
+	testutil.AssertEqual(t, 4, bs.Len())
+
+	x, y1, y2 := bs.GetBoundedValues(3)
+	testutil.AssertEqual(t, 2.0, x)
+	testutil.AssertEqual(t, 3.0, y1)
+	testutil.AssertEqual(t, 0.0, y2)
+
+	testutil.AssertNotNil(t, BinSeries{Counts: []float64{1}}.Validate())
+
+	graph := Chart{
+		Series: []Series{bs},
+	}
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
+
+func TestBinSeriesCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	bs := BinSeries{Edges: []float64{0, 1, 2}, Counts: []float64{3, 4}}
+	copied := bs.CopySeries().(BinSeries)
+	copied.Edges[1] = 10
+	copied.Counts[0] = 10
+	testutil.AssertEqual(t, 1.0, bs.Edges[1])
+	testutil.AssertEqual(t, 3.0, bs.Counts[0])
+}
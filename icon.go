@@ -0,0 +1,90 @@
+package chart
+
+import (
+	"encoding/base64"
+	"fmt"
+	"image"
+	imagedraw "image/draw"
+	"math"
+)
+
+// Icon is a small image that can be drawn in place of a dot or marker, such as a weather symbol or a flag.
+//
+// Raster renderers draw `Image`. Vector renderers embed `SVG` if it is set, and `Image` as a png otherwise.
+type Icon struct {
+	Image image.Image
+	SVG   []byte
+}
+
+// IsZero returns if the icon has neither an image nor an svg document.
+func (i Icon) IsZero() bool {
+	return i.Image == nil && len(i.SVG) == 0
+}
+
+// Render draws the icon scaled to fill a given box, returning if the renderer was able to draw it.
+func (i Icon) Render(r Renderer, box Box) bool {
+	if len(i.SVG) > 0 {
+		if typed, isTyped := r.(SVGImageRenderer); isTyped {
+			typed.DrawSVGImage(i.SVG, box)
+			return true
+		}
+	}
+	if i.Image != nil {
+		if typed, isTyped := r.(ImageRenderer); isTyped {
+			typed.DrawImage(i.Image, box)
+			return true
+		}
+	}
+	return false
+}
+
+// RenderPartial draws the left `fraction` of the icon, in the left `fraction` of a given box, e.g. for the
+// remainder of a value in a pictogram chart, returning if the renderer was able to draw it.
+func (i Icon) RenderPartial(r Renderer, box Box, fraction float64) bool {
+	if fraction >= 1 {
+		return i.Render(r, box)
+	}
+	if fraction <= 0 {
+		return true
+	}
+
+	partial := box
+	partial.Right = box.Left + int(math.Round(float64(box.Width())*fraction))
+	if partial.Width() == 0 {
+		return true
+	}
+
+	if len(i.SVG) > 0 {
+		if typed, isTyped := r.(SVGImageRenderer); isTyped {
+			typed.DrawSVGImage(cropSVG(i.SVG, box.Width(), box.Height(), partial.Width()), partial)
+			return true
+		}
+	}
+	if i.Image != nil {
+		if typed, isTyped := r.(ImageRenderer); isTyped {
+			typed.DrawImage(cropImage(i.Image, fraction), partial)
+			return true
+		}
+	}
+	return false
+}
+
+// cropSVG wraps an svg document of a given size in one that shows only its left `width` pixels.
+func cropSVG(svg []byte, width, height, cropWidth int) []byte {
+	return []byte(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="%d" height="%d" viewBox="0 0 %d %d"><image x="0" y="0" width="%d" height="%d" xlink:href="data:image/svg+xml;base64,%s"/></svg>`,
+		cropWidth, height, cropWidth, height, width, height, base64.StdEncoding.EncodeToString(svg)))
+}
+
+// cropImage returns the left `fraction` of an image.
+func cropImage(img image.Image, fraction float64) image.Image {
+	bounds := img.Bounds()
+	bounds.Max.X = bounds.Min.X + MaxInt(1, int(math.Round(float64(bounds.Dx())*fraction)))
+	if typed, isTyped := img.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); isTyped {
+		return typed.SubImage(bounds)
+	}
+	cropped := image.NewRGBA(bounds)
+	imagedraw.Draw(cropped, bounds, img, bounds.Min, imagedraw.Src)
+	return cropped
+}
@@ -0,0 +1,123 @@
+package chart
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testIconImage() image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+	return img
+}
+
+func TestIconRenderRaster(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(20, 20)
+	testutil.AssertNil(t, err)
+
+	testutil.AssertFalse(t, Icon{SVG: []byte("<svg/>")}.Render(r, Box{Top: 5, Left: 5, Right: 15, Bottom: 15}))
+	testutil.AssertTrue(t, Icon{Image: testIconImage()}.Render(r, Box{Top: 5, Left: 5, Right: 15, Bottom: 15}))
+
+	iw := &ImageWriter{}
+	testutil.AssertNil(t, r.Save(iw))
+	img, err := iw.Image()
+	testutil.AssertNil(t, err)
+
+	red, _, _, _ := img.At(10, 10).RGBA()
+	testutil.AssertEqual(t, uint32(0xffff), red)
+	_, _, _, alpha := img.At(2, 2).RGBA()
+	testutil.AssertZero(t, alpha)
+}
+
+func TestIconRenderVector(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := SVG(20, 20)
+	testutil.AssertNil(t, err)
+
+	testutil.AssertTrue(t, Icon{Image: testIconImage()}.Render(r, Box{Top: 5, Left: 5, Right: 15, Bottom: 15}))
+	testutil.AssertTrue(t, Icon{Image: testIconImage(), SVG: []byte("<svg/>")}.Render(r, Box{Top: 5, Left: 5, Right: 15, Bottom: 15}))
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), `<image x="5" y="5" width="10" height="10" xlink:href="data:image/png;base64,`)
+	testutil.AssertContains(t, buffer.String(), `xlink:href="data:image/svg+xml;base64,PHN2Zy8+"`)
+}
+
+func TestChartRenderDotIcons(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				Style: Style{
+					DotIcon: &Icon{SVG: []byte("<svg/>")},
+				},
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertEqual(t, 3, strings.Count(buffer.String(), "<image "))
+}
+
+func TestIconRenderPartialRaster(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(20, 20)
+	testutil.AssertNil(t, err)
+
+	testutil.AssertTrue(t, Icon{Image: testIconImage()}.RenderPartial(r, Box{Top: 0, Left: 0, Right: 20, Bottom: 20}, 0.5))
+
+	iw := &ImageWriter{}
+	testutil.AssertNil(t, r.Save(iw))
+	img, err := iw.Image()
+	testutil.AssertNil(t, err)
+
+	red, _, _, _ := img.At(5, 10).RGBA()
+	testutil.AssertEqual(t, uint32(0xffff), red)
+	_, _, _, alpha := img.At(15, 10).RGBA()
+	testutil.AssertZero(t, alpha)
+}
+
+func TestIconRenderPartialVector(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := SVG(20, 20)
+	testutil.AssertNil(t, err)
+
+	testutil.AssertTrue(t, Icon{SVG: []byte("<svg/>")}.RenderPartial(r, Box{Top: 0, Left: 0, Right: 20, Bottom: 20}, 0.25))
+	testutil.AssertTrue(t, Icon{}.RenderPartial(r, Box{Top: 0, Left: 0, Right: 20, Bottom: 20}, 0))
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, r.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), `<image x="0" y="0" width="5" height="20"`)
+
+	cropped := string(cropSVG([]byte("<svg/>"), 20, 20, 5))
+	testutil.AssertContains(t, cropped, `viewBox="0 0 5 20"`)
+	testutil.AssertContains(t, cropped, `width="20" height="20" xlink:href="data:image/svg+xml;base64,PHN2Zy8+"`)
+}
+
+func TestCropImage(t *testing.T) {
+	// replaced new assertions helper
+
+	cropped := cropImage(testIconImage(), 0.5)
+	testutil.AssertEqual(t, 2, cropped.Bounds().Dx())
+	testutil.AssertEqual(t, 4, cropped.Bounds().Dy())
+
+	testutil.AssertEqual(t, 1, cropImage(testIconImage(), 0.01).Bounds().Dx())
+}
@@ -2,6 +2,9 @@ package chart
 
 import "github.com/wcharczuk/go-chart/v2/drawing"
 
+// ColorMap maps a value between a min and a max to a color, e.g. `Jet`.
+type ColorMap func(v, vmin, vmax float64) drawing.Color
+
 // Jet is a color map provider based on matlab's jet color map.
 func Jet(v, vmin, vmax float64) drawing.Color {
 	c := drawing.Color{R: 0xff, G: 0xff, B: 0xff, A: 0xff} // white
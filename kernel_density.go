@@ -0,0 +1,41 @@
+package chart
+
+import (
+	"math"
+	"sort"
+)
+
+// KernelDensity returns a gaussian kernel density estimate of the distribution of the values, a smooth
+// alternative to a histogram; a bandwidth of zero is replaced by `SilvermanBandwidth` of the values.
+func KernelDensity(values []float64, bandwidth float64) func(x float64) float64 {
+	if bandwidth <= 0 {
+		bandwidth = SilvermanBandwidth(values...)
+	}
+	norm := 1.0 / (float64(len(values)) * bandwidth * math.Sqrt(2*math.Pi))
+	return func(x float64) float64 {
+		var density float64
+		for _, v := range values {
+			u := (x - v) / bandwidth
+			density += math.Exp(-0.5 * u * u)
+		}
+		return density * norm
+	}
+}
+
+// SilvermanBandwidth returns Silverman's rule of thumb kernel density bandwidth for the values.
+func SilvermanBandwidth(values ...float64) float64 {
+	if len(values) < 2 {
+		return 1
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	spread := Seq{Array(values)}.StdDev()
+	if iqr := (quantile(sorted, 0.75) - quantile(sorted, 0.25)) / 1.34; iqr > 0 {
+		spread = math.Min(spread, iqr)
+	}
+	if spread == 0 {
+		return 1
+	}
+	return 0.9 * spread * math.Pow(float64(len(values)), -0.2)
+}
@@ -0,0 +1,44 @@
+package chart
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestKernelDensity(t *testing.T) {
+	// replaced new assertions helper
+
+	values := []float64{1, 2, 2, 3, 3, 3, 4, 4, 5}
+	density := KernelDensity(values, 0.5)
+
+	// the density integrates to one.
+	var area float64
+	for x := -5.0; x < 11; x += 0.01 {
+		area += density(x) * 0.01
+	}
+	testutil.AssertInDelta(t, 1.0, area, 1e-6)
+
+	// and is symmetric about the center of symmetric values.
+	testutil.AssertInDelta(t, density(2), density(4), 1e-12)
+	testutil.AssertTrue(t, density(3) > density(2))
+}
+
+func TestKernelDensitySingleValue(t *testing.T) {
+	// replaced new assertions helper
+
+	density := KernelDensity([]float64{2}, 0)
+	testutil.AssertInDelta(t, 1.0/math.Sqrt(2*math.Pi), density(2), 1e-12)
+}
+
+func TestSilvermanBandwidth(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertEqual(t, 1.0, SilvermanBandwidth(1))
+	testutil.AssertEqual(t, 1.0, SilvermanBandwidth(2, 2, 2))
+
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	sd := Seq{Array(values)}.StdDev()
+	testutil.AssertInDelta(t, 0.9*sd*math.Pow(10, -0.2), SilvermanBandwidth(values...), 1e-12)
+}
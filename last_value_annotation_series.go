@@ -17,7 +17,7 @@ func LastValueAnnotationSeries(innerSeries ValuesProvider, vfs ...ValueFormatter
 	if typed, isTyped := innerSeries.(LastValuesProvider); isTyped {
 		lastValue.XValue, lastValue.YValue = typed.GetLastValues()
 		lastValue.Label = vf(lastValue.YValue)
-	} else {
+	} else if innerSeries.Len() > 0 {
 		lastValue.XValue, lastValue.YValue = innerSeries.GetValues(innerSeries.Len() - 1)
 		lastValue.Label = vf(lastValue.YValue)
 	}
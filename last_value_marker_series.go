@@ -0,0 +1,106 @@
+package chart
+
+import "fmt"
+
+// Interface Assertions.
+var (
+	_ Series = (*LastValueMarkerSeries)(nil)
+)
+
+// LastValueMarkerSeries draws an emphasized marker on the last value of an inner series,
+// such as the current value of a live metric.
+//
+// The marker is filled with the dot color, which defaults to the inner series stroke color,
+// and outlined with the stroke color.
+type LastValueMarkerSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries ValuesProvider
+	Marker      Marker
+
+	// Halo draws a translucent halo around the marker.
+	Halo bool
+}
+
+// GetName returns the name of the series.
+func (lvms LastValueMarkerSeries) GetName() string {
+	return lvms.Name
+}
+
+// GetStyle returns the series style.
+func (lvms LastValueMarkerSeries) GetStyle() Style {
+	return lvms.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (lvms LastValueMarkerSeries) GetYAxis() YAxisType {
+	return lvms.YAxis
+}
+
+// GetLastValues returns the last value of the inner series.
+func (lvms LastValueMarkerSeries) GetLastValues() (x, y float64) {
+	if typed, isTyped := lvms.InnerSeries.(LastValuesProvider); isTyped {
+		return typed.GetLastValues()
+	}
+	if lvms.InnerSeries.Len() > 0 {
+		return lvms.InnerSeries.GetValues(lvms.InnerSeries.Len() - 1)
+	}
+	return
+}
+
+func (lvms LastValueMarkerSeries) markerStyleDefaults(defaults Style) Style {
+	dotColor := defaults.StrokeColor
+	if typed, isTyped := lvms.InnerSeries.(Series); isTyped && !typed.GetStyle().StrokeColor.IsZero() {
+		dotColor = typed.GetStyle().StrokeColor
+	}
+	return Style{
+		DotColor:    dotColor,
+		DotWidth:    DefaultLastValueMarkerSize,
+		StrokeColor: DefaultBackgroundColor,
+		StrokeWidth: DefaultSeriesLineWidth * 2,
+	}
+}
+
+// Render renders the series.
+func (lvms LastValueMarkerSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if lvms.Style.Hidden || lvms.InnerSeries == nil || lvms.InnerSeries.Len() == 0 {
+		return
+	}
+
+	style := lvms.Style.InheritFrom(lvms.markerStyleDefaults(defaults))
+	vx, vy := lvms.GetLastValues()
+	x := canvasBox.Left + xrange.Translate(vx)
+	y := canvasBox.Bottom - yrange.Translate(vy)
+	size := style.GetDotWidth()
+	color := style.GetDotColor()
+
+	if lvms.Halo {
+		r.SetFillColor(color.WithAlpha(DefaultLastValueMarkerHaloAlpha))
+		r.Circle(size*DefaultLastValueMarkerHaloScale, x, y)
+		r.Fill()
+		r.ResetStyle()
+	}
+
+	Draw.Marker(r, lvms.Marker, x, y, size, Style{
+		FillColor:   color,
+		StrokeColor: style.GetStrokeColor(),
+		StrokeWidth: style.GetStrokeWidth(),
+		DotIcon:     style.DotIcon,
+	})
+}
+
+// Validate validates the series.
+func (lvms LastValueMarkerSeries) Validate() error {
+	if lvms.InnerSeries == nil {
+		return fmt.Errorf("last value marker series requires InnerSeries to be set")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (lvms LastValueMarkerSeries) CopySeries() Series {
+	lvms.InnerSeries = copyValuesProvider(lvms.InnerSeries)
+	return lvms
+}
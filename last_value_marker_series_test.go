@@ -0,0 +1,35 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestLastValueMarkerSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, LastValueMarkerSeries{}.Validate())
+
+	inner := ContinuousSeries{
+		Style:   Style{StrokeColor: drawing.ColorRed},
+		XValues: []float64{1.0, 2.0, 3.0},
+		YValues: []float64{3.0, 1.0, 2.0},
+	}
+	lvms := LastValueMarkerSeries{InnerSeries: inner, Halo: true}
+	x, y := lvms.GetLastValues()
+	testutil.AssertEqual(t, 3.0, x)
+	testutil.AssertEqual(t, 2.0, y)
+	testutil.AssertEqual(t, drawing.ColorRed, lvms.markerStyleDefaults(Style{}).DotColor)
+
+	c := Chart{
+		Series: []Series{inner, lvms},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertEqual(t, 2, strings.Count(buffer.String(), "<circle "))
+	testutil.AssertContains(t, buffer.String(), "fill:rgba(255,0,0,0.3)")
+}
@@ -1,6 +1,8 @@
 package chart
 
 import (
+	"fmt"
+
 	"github.com/wcharczuk/go-chart/v2/drawing"
 )
 
@@ -23,12 +25,13 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 		}
 
 		// DEFAULTS
-		legendPadding := Box{
+		legendPadding := legendStyle.GetPadding(Box{
 			Top:    5,
 			Left:   5,
 			Right:  5,
 			Bottom: 5,
-		}
+		})
+		legendMargin := legendStyle.GetMargin()
 		lineTextGap := 5
 		lineLengthMinimum := 25
 
@@ -44,8 +47,8 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 		}
 
 		legend := Box{
-			Top:  cb.Top,
-			Left: cb.Left,
+			Top:  cb.Top + legendMargin.GetTop(),
+			Left: cb.Left + legendMargin.GetLeft(),
 			// bottom and right will be sized by the legend content + relevant padding.
 		}
 
@@ -235,12 +238,16 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 		}
 
 		// DEFAULTS
-		legendPadding := Box{
+		legendPadding := legendStyle.GetPadding(Box{
 			Top:    5,
 			Left:   5,
 			Right:  5,
 			Bottom: 5,
-		}
+		})
+		legendMargin := legendStyle.GetMargin(Box{
+			Top:  5,
+			Left: 5,
+		})
 		lineTextGap := 5
 		lineLengthMinimum := 25
 
@@ -256,8 +263,8 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 		}
 
 		legend := Box{
-			Top:  5,
-			Left: 5,
+			Top:  legendMargin.GetTop(),
+			Left: legendMargin.GetLeft(),
 			// bottom and right will be sized by the legend content + relevant padding.
 		}
 
@@ -329,3 +336,188 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 		}
 	}
 }
+
+// LegendGroup is a group of series in a legend, listed under a heading.
+type LegendGroup struct {
+	Name string
+	// Series are the names of the series in the group.
+	Series []string
+	// Collapsed lists the group as a single entry, its name with a count of its series, in the style of its first series.
+	Collapsed bool
+}
+
+// LegendGroupsBy returns the legend groups of the series of a chart by a key, e.g. the host a series is
+// for, in order of first appearance.
+func LegendGroupsBy(c *Chart, key func(Series) string) []LegendGroup {
+	var groups []LegendGroup
+	indexes := map[string]int{}
+	for _, s := range c.Series {
+		name := key(s)
+		index, ok := indexes[name]
+		if !ok {
+			index = len(groups)
+			indexes[name] = index
+			groups = append(groups, LegendGroup{Name: name})
+		}
+		groups[index].Series = append(groups[index].Series, s.GetName())
+	}
+	return groups
+}
+
+// legendRow is a row of a grouped legend, a group heading or the entry of a series.
+type legendRow struct {
+	label  string
+	line   *Style
+	indent int
+}
+
+// LegendGrouped is a legend that lists the series under the headings of their groups, with the series that
+// are in no group listed first.
+func LegendGrouped(c *Chart, groups []LegendGroup, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		legendDefaults := Style{
+			FillColor:   drawing.ColorWhite,
+			FontColor:   DefaultTextColor,
+			FontSize:    8.0,
+			StrokeColor: DefaultAxisColor,
+			StrokeWidth: DefaultAxisLineWidth,
+		}
+
+		var legendStyle Style
+		if len(userDefaults) > 0 {
+			legendStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
+		} else {
+			legendStyle = chartDefaults.InheritFrom(legendDefaults)
+		}
+
+		// DEFAULTS
+		legendPadding := legendStyle.GetPadding(Box{
+			Top:    5,
+			Left:   5,
+			Right:  5,
+			Bottom: 5,
+		})
+		legendMargin := legendStyle.GetMargin()
+		lineTextGap := 5
+		lineLengthMinimum := 25
+
+		rows := legendGroupedRows(c, groups)
+
+		legend := Box{
+			Top:  cb.Top + legendMargin.GetTop(),
+			Left: cb.Left + legendMargin.GetLeft(),
+			// bottom and right will be sized by the legend content + relevant padding.
+		}
+
+		legendContent := Box{
+			Top:    legend.Top + legendPadding.Top,
+			Left:   legend.Left + legendPadding.Left,
+			Right:  legend.Left + legendPadding.Left,
+			Bottom: legend.Top + legendPadding.Top,
+		}
+
+		legendStyle.GetTextOptions().WriteToRenderer(r)
+
+		// measure
+		for index, row := range rows {
+			tb := r.MeasureText(row.label)
+			if index > 0 {
+				legendContent.Bottom += DefaultMinimumTickVerticalSpacing
+			}
+			legendContent.Bottom += tb.Height()
+			right := legendContent.Left + row.indent + tb.Width()
+			if row.line != nil {
+				right += lineTextGap + lineLengthMinimum
+			}
+			legendContent.Right = MaxInt(legendContent.Right, right)
+		}
+
+		legend = legend.Grow(legendContent)
+		legend.Right = legendContent.Right + legendPadding.Right
+		legend.Bottom = legendContent.Bottom + legendPadding.Bottom
+
+		Draw.Box(r, legend, legendStyle)
+
+		legendStyle.GetTextOptions().WriteToRenderer(r)
+
+		ycursor := legendContent.Top
+		for index, row := range rows {
+			if index > 0 {
+				ycursor += DefaultMinimumTickVerticalSpacing
+			}
+
+			tb := r.MeasureText(row.label)
+			tx := legendContent.Left + row.indent
+			ty := ycursor + tb.Height()
+			r.Text(row.label, tx, ty)
+
+			if row.line != nil {
+				lx := tx + tb.Width() + lineTextGap
+				ly := ty - tb.Height()>>1
+				lx2 := legendContent.Right - legendPadding.Right
+
+				r.SetStrokeColor(row.line.GetStrokeColor())
+				r.SetStrokeWidth(row.line.GetStrokeWidth())
+				r.SetStrokeDashArray(row.line.GetStrokeDashArray())
+
+				r.MoveTo(lx, ly)
+				r.LineTo(lx2, ly)
+				r.Stroke()
+			}
+
+			ycursor += tb.Height()
+		}
+	}
+}
+
+// legendGroupedRows returns the rows of a grouped legend; the series in no group first, then each group,
+// as a heading followed by the entries of its series, indented, or as a single entry if collapsed.
+func legendGroupedRows(c *Chart, groups []LegendGroup) []legendRow {
+	lines := map[string]Style{}
+	var names []string
+	for index, s := range c.Series {
+		if s.GetStyle().Hidden || len(s.GetName()) == 0 {
+			continue
+		}
+		if _, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
+			continue
+		}
+		if _, ok := lines[s.GetName()]; !ok {
+			names = append(names, s.GetName())
+		}
+		lines[s.GetName()] = s.GetStyle().InheritFrom(c.styleDefaultsSeries(index))
+	}
+
+	grouped := map[string]bool{}
+	for _, group := range groups {
+		for _, name := range group.Series {
+			grouped[name] = true
+		}
+	}
+
+	var rows []legendRow
+	for _, name := range names {
+		if !grouped[name] {
+			line := lines[name]
+			rows = append(rows, legendRow{label: name, line: &line})
+		}
+	}
+	for _, group := range groups {
+		var entries []legendRow
+		for _, name := range group.Series {
+			if line, ok := lines[name]; ok {
+				entries = append(entries, legendRow{label: name, line: &line, indent: DefaultLegendGroupIndent})
+			}
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		if group.Collapsed {
+			rows = append(rows, legendRow{label: fmt.Sprintf("%s (%d)", group.Name, len(entries)), line: entries[0].line})
+			continue
+		}
+		rows = append(rows, legendRow{label: group.Name})
+		rows = append(rows, entries...)
+	}
+	return rows
+}
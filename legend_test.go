@@ -2,6 +2,7 @@ package chart
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/wcharczuk/go-chart/v2/testutil"
@@ -29,3 +30,38 @@ func TestLegend(t *testing.T) {
 	testutil.AssertNil(t, err)
 	testutil.AssertNotZero(t, buf.Len())
 }
+
+func TestLegendGroupedRows(t *testing.T) {
+	// replaced new assertions helper
+
+	series := func(name string) Series {
+		return ContinuousSeries{Name: name, XValues: []float64{1, 2}, YValues: []float64{1, 2}}
+	}
+	c := Chart{
+		Series: []Series{series("web-1 actual"), series("web-1 forecast"), series("db-1 actual"), series("total")},
+	}
+	groups := LegendGroupsBy(&c, func(s Series) string {
+		return strings.Fields(s.GetName())[0]
+	})
+	testutil.AssertLen(t, groups, 3)
+	testutil.AssertEqual(t, "web-1", groups[0].Name)
+	testutil.AssertEqual(t, []string{"web-1 actual", "web-1 forecast"}, groups[0].Series)
+
+	groups = groups[:2]
+	groups[1].Collapsed = true
+	rows := legendGroupedRows(&c, groups)
+	var labels []string
+	for _, row := range rows {
+		labels = append(labels, row.label)
+	}
+	testutil.AssertEqual(t, []string{"total", "web-1", "web-1 actual", "web-1 forecast", "db-1 (1)"}, labels)
+	testutil.AssertNil(t, rows[1].line)
+	testutil.AssertEqual(t, DefaultLegendGroupIndent, rows[2].indent)
+	testutil.AssertEqual(t, 0, rows[4].indent)
+	testutil.AssertEqual(t, c.styleDefaultsSeries(2).StrokeColor, rows[4].line.StrokeColor)
+
+	c.Elements = []Renderable{LegendGrouped(&c, groups)}
+	buf := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(PNG, buf))
+	testutil.AssertNotZero(t, buf.Len())
+}
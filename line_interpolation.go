@@ -0,0 +1,29 @@
+package chart
+
+// LineInterpolation is an enum for how a line series joins its points.
+type LineInterpolation int
+
+const (
+	// LineInterpolationUnset is the unset state for line interpolation, which draws straight lines.
+	LineInterpolationUnset LineInterpolation = 0
+	// LineInterpolationLinear draws a straight line from each point to the next.
+	LineInterpolationLinear LineInterpolation = 1
+	// LineInterpolationStepAfter holds each value until the next point, drawing a horizontal line
+	// then a vertical one, as for a counter or flag that changes at each point.
+	LineInterpolationStepAfter LineInterpolation = 2
+	// LineInterpolationStepBefore takes each value from the previous point, drawing a vertical line
+	// then a horizontal one, as for a value measured over the interval ending at each point.
+	LineInterpolationStepBefore LineInterpolation = 3
+)
+
+// path returns the points to draw lines through from one point to the next, ending at the next point.
+func (li LineInterpolation) path(from, to Point) []Point {
+	switch li {
+	case LineInterpolationStepAfter:
+		return []Point{{X: to.X, Y: from.Y}, to}
+	case LineInterpolationStepBefore:
+		return []Point{{X: from.X, Y: to.Y}, to}
+	default:
+		return []Point{to}
+	}
+}
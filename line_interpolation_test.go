@@ -0,0 +1,47 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestLineInterpolationPath(t *testing.T) {
+	// replaced new assertions helper
+
+	from, to := Point{X: 0, Y: 10}, Point{X: 5, Y: 20}
+	testutil.AssertEqual(t, []Point{to}, LineInterpolationUnset.path(from, to))
+	testutil.AssertEqual(t, []Point{to}, LineInterpolationLinear.path(from, to))
+	testutil.AssertEqual(t, []Point{{X: 5, Y: 10}, to}, LineInterpolationStepAfter.path(from, to))
+	testutil.AssertEqual(t, []Point{{X: 0, Y: 20}, to}, LineInterpolationStepBefore.path(from, to))
+}
+
+func TestLineInterpolationInheritFrom(t *testing.T) {
+	// replaced new assertions helper
+
+	style := Style{}.InheritFrom(Style{LineInterpolation: LineInterpolationStepAfter})
+	testutil.AssertEqual(t, LineInterpolationStepAfter, style.GetLineInterpolation())
+	style = Style{LineInterpolation: LineInterpolationLinear}.InheritFrom(style)
+	testutil.AssertEqual(t, LineInterpolationLinear, style.GetLineInterpolation())
+}
+
+func TestLineInterpolationLineSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	engine := &recordingEngine{}
+	r, err := EngineRenderer(func(_, _ int) (Engine, error) { return engine, nil })(100, 100)
+	testutil.AssertNil(t, err)
+
+	style := Style{
+		LineInterpolation: LineInterpolationStepAfter,
+		StrokeColor:       ColorBlue,
+		StrokeWidth:       1,
+	}
+	xrange := &ContinuousRange{Min: 0, Max: 2, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 1, Domain: 100}
+	Draw.LineSeries(r, Box{Right: 100, Bottom: 100}, xrange, yrange, style, ContinuousSeries{
+		XValues: []float64{0, 1, 2},
+		YValues: []float64{0, 1, 0},
+	})
+	testutil.AssertEqual(t, []string{"M 0 100", "L 50 100", "L 50 0", "L 100 0", "L 100 100", "draw false true"}, engine.calls)
+}
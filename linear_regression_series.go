@@ -10,6 +10,7 @@ var (
 	_ FirstValuesProvider       = (*LinearRegressionSeries)(nil)
 	_ LastValuesProvider        = (*LinearRegressionSeries)(nil)
 	_ LinearCoefficientProvider = (*LinearRegressionSeries)(nil)
+	_ RegressionProvider        = (*LinearRegressionSeries)(nil)
 )
 
 // LinearRegressionSeries is a series that plots the n-nearest neighbors
@@ -127,6 +128,14 @@ func (lrs *LinearRegressionSeries) GetLastValues() (x, y float64) {
 	return
 }
 
+// Predict returns the fitted value for a given x value.
+func (lrs *LinearRegressionSeries) Predict(x float64) float64 {
+	if lrs.IsZero() {
+		lrs.computeCoefficients()
+	}
+	return (lrs.m * lrs.normalize(x)) + lrs.b
+}
+
 // Render renders the series.
 func (lrs *LinearRegressionSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
 	style := lrs.Style.InheritFrom(defaults)
@@ -185,3 +194,10 @@ func (lrs *LinearRegressionSeries) computeCoefficients() {
 	lrs.m = (p*sumxy - sumx*sumy) / (p*sumxx - sumx*sumx)
 	lrs.b = (sumy / p) - (lrs.m * sumx / p)
 }
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (lrs LinearRegressionSeries) CopySeries() Series {
+	lrs.InnerSeries = copyValuesProvider(lrs.InnerSeries)
+	lrs.m, lrs.b, lrs.avgx, lrs.stddevx = 0, 0, 0, 0
+	return &lrs
+}
@@ -117,3 +117,15 @@ func (ls *LinearSeries) normalize(xvalue float64) float64 {
 	}
 	return xvalue
 }
+
+// CopySeries returns a copy of the series whose x values and inner series are not shared with the original.
+func (ls LinearSeries) CopySeries() Series {
+	ls.XValues = append([]float64(nil), ls.XValues...)
+	if typed, isTyped := ls.InnerSeries.(SeriesCopier); isTyped {
+		if copied, isProvider := typed.CopySeries().(LinearCoefficientProvider); isProvider {
+			ls.InnerSeries = copied
+		}
+	}
+	ls.m, ls.b, ls.stdev, ls.avg = 0, 0, 0, 0
+	return &ls
+}
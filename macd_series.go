@@ -13,6 +13,8 @@ const (
 
 // MACDSeries computes the difference between the MACD line and the MACD Signal line.
 // It is used in technical analysis and gives a lagging indicator of momentum.
+//
+// It only provides values, so it is not a `SeriesCopier`; a series that wraps it shares it when copied.
 type MACDSeries struct {
 	Name        string
 	Style       Style
@@ -224,6 +226,13 @@ func (macds *MACDSignalSeries) Render(r Renderer, canvasBox Box, xrange, yrange
 	Draw.LineSeries(r, canvasBox, xrange, yrange, style, macds)
 }
 
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (macds MACDSignalSeries) CopySeries() Series {
+	macds.InnerSeries = copyValuesProvider(macds.InnerSeries)
+	macds.signal = nil
+	return &macds
+}
+
 // MACDLineSeries is a series that computes the inner ema1-ema2 value as a series.
 type MACDLineSeries struct {
 	Name        string
@@ -336,3 +345,10 @@ func (macdl *MACDLineSeries) Render(r Renderer, canvasBox Box, xrange, yrange Ra
 	style := macdl.Style.InheritFrom(defaults)
 	Draw.LineSeries(r, canvasBox, xrange, yrange, style, macdl)
 }
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (macdl MACDLineSeries) CopySeries() Series {
+	macdl.InnerSeries = copyValuesProvider(macdl.InnerSeries)
+	macdl.ema1, macdl.ema2 = nil, nil
+	return &macdl
+}
@@ -0,0 +1,306 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// MarimekkoChart is a chart of stacked bars whose widths are proportional to the total of each bar, and
+// whose sections are proportional to each value within its bar, showing parts of a whole in two dimensions,
+// e.g. market share by segment across regions of different sizes.
+//
+// The labels of values are drawn inside their sections where they fit; the `Width` of the bars is ignored.
+type MarimekkoChart struct {
+	ChartFrame
+
+	LabelStyle Style
+
+	XAxis Style
+	YAxis Style
+
+	BarSpacing int
+
+	Bars     []StackedBar
+	Elements []Renderable
+}
+
+// GetBarSpacing returns the spacing between bars.
+func (mc MarimekkoChart) GetBarSpacing() int {
+	if mc.BarSpacing == 0 {
+		return DefaultMarimekkoBarSpacing
+	}
+	return mc.BarSpacing
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (mc MarimekkoChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(mc.Bars) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one bar"))
+	}
+	if err := mc.validateBars(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	r, err := rp(mc.GetWidth(), mc.GetHeight())
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if mc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		mc.defaultFont = defaultFont
+	}
+	r.SetDPI(mc.GetDPI(DefaultDPI))
+
+	canvasBox := mc.getAdjustedCanvasBox(r, mc.getDefaultCanvasBox(r))
+	barBoxes := mc.getBarBoxes(canvasBox)
+
+	mc.drawCanvas(r, canvasBox)
+	mc.drawBars(r, barBoxes)
+	mc.drawXAxis(r, canvasBox, barBoxes)
+	mc.drawYAxis(r, canvasBox)
+	mc.drawTitle(r)
+	for _, a := range mc.Elements {
+		a(r, canvasBox, mc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (mc MarimekkoChart) validateBars() error {
+	var total float64
+	for _, bar := range mc.Bars {
+		for _, v := range bar.Values {
+			if v.Value < 0 {
+				return fmt.Errorf("marimekko chart values cannot be negative")
+			}
+			total += v.Value
+		}
+	}
+	if total == 0 {
+		return fmt.Errorf("marimekko chart must contain at least (1) non-zero value")
+	}
+	return nil
+}
+
+func (mc MarimekkoChart) drawCanvas(r Renderer, canvasBox Box) {
+	if mc.Canvas.Hidden {
+		return
+	}
+	Draw.Box(r, canvasBox, mc.getCanvasStyle())
+}
+
+func (mc MarimekkoChart) drawBars(r Renderer, barBoxes []Box) {
+	labelStyle := mc.styleDefaultsLabels()
+	for barIndex, bar := range mc.Bars {
+		sectionBoxes := mc.getSectionBoxes(barBoxes[barIndex], bar)
+		for index, v := range bar.Values {
+			Draw.Box(r, sectionBoxes[index], v.Style.InheritFrom(mc.styleDefaultsMarimekkoValue(index)))
+		}
+
+		// label sections where the label fits inside it.
+		for index, v := range bar.Values {
+			if len(v.Label) == 0 || mc.LabelStyle.Hidden {
+				continue
+			}
+			sb := sectionBoxes[index]
+			tb := Draw.MeasureText(r, v.Label, labelStyle)
+			if tb.Width()+2*DefaultMarimekkoLabelPadding > sb.Width() || tb.Height()+2*DefaultMarimekkoLabelPadding > sb.Height() {
+				continue
+			}
+			cx, cy := sb.Center()
+			Draw.Text(r, v.Label, cx-tb.Width()>>1, cy+tb.Height()>>1, labelStyle)
+		}
+	}
+}
+
+func (mc MarimekkoChart) drawXAxis(r Renderer, canvasBox Box, barBoxes []Box) {
+	if mc.XAxis.Hidden {
+		return
+	}
+	axisStyle := mc.XAxis.InheritFrom(mc.styleDefaultsAxes())
+	axisStyle.WriteToRenderer(r)
+
+	r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+	r.LineTo(canvasBox.Right, canvasBox.Bottom)
+	r.Stroke()
+
+	for index, bar := range mc.Bars {
+		bb := barBoxes[index]
+		if len(bar.Name) > 0 && bb.Width() > 0 {
+			Draw.TextWithin(r, bar.Name, Box{
+				Top:    canvasBox.Bottom + DefaultXAxisMargin,
+				Left:   bb.Left,
+				Right:  bb.Right,
+				Bottom: mc.GetHeight(),
+			}, axisStyle)
+		}
+		axisStyle.WriteToRenderer(r)
+		r.MoveTo(bb.Right, canvasBox.Bottom)
+		r.LineTo(bb.Right, canvasBox.Bottom+DefaultVerticalTickHeight)
+		r.Stroke()
+	}
+}
+
+func (mc MarimekkoChart) drawYAxis(r Renderer, canvasBox Box) {
+	if mc.YAxis.Hidden {
+		return
+	}
+	axisStyle := mc.YAxis.InheritFrom(mc.styleDefaultsAxes())
+	axisStyle.WriteToRenderer(r)
+	r.MoveTo(canvasBox.Right, canvasBox.Top)
+	r.LineTo(canvasBox.Right, canvasBox.Bottom)
+	r.Stroke()
+
+	for _, t := range LinearRangeWithStep(0.0, 1.0, 0.2) {
+		axisStyle.GetStrokeOptions().WriteToRenderer(r)
+		ty := canvasBox.Bottom - int(t*float64(canvasBox.Height()))
+		r.MoveTo(canvasBox.Right, ty)
+		r.LineTo(canvasBox.Right+DefaultHorizontalTickWidth, ty)
+		r.Stroke()
+
+		axisStyle.GetTextOptions().WriteToRenderer(r)
+		text := fmt.Sprintf("%0.0f%%", t*100)
+		tb := r.MeasureText(text)
+		Draw.Text(r, text, canvasBox.Right+DefaultYAxisMargin, ty+(tb.Height()>>1), axisStyle)
+	}
+}
+
+func (mc MarimekkoChart) drawTitle(r Renderer) {
+	if len(mc.Title) > 0 && !mc.TitleStyle.Hidden {
+		Draw.TextWithin(r, mc.Title, mc.Box(), mc.styleDefaultsTitle(mc.GetWidth(), mc.GetHeight()))
+	}
+}
+
+// getBarBoxes returns the box of each bar, with widths proportional to the bar totals; the edges of the
+// bars are rounded from their running totals so the bars fill the canvas exactly.
+func (mc MarimekkoChart) getBarBoxes(canvasBox Box) []Box {
+	totals := make([]float64, len(mc.Bars))
+	var total float64
+	for index, bar := range mc.Bars {
+		totals[index] = Sum(Values(bar.Values).Values()...)
+		total += totals[index]
+	}
+
+	spacing := mc.GetBarSpacing()
+	available := float64(canvasBox.Width() - (len(mc.Bars)-1)*spacing)
+	boxes := make([]Box, len(mc.Bars))
+	var cumulative float64
+	for index := range mc.Bars {
+		left := canvasBox.Left + index*spacing + int(math.Round(available*cumulative/total))
+		cumulative += totals[index]
+		right := canvasBox.Left + index*spacing + int(math.Round(available*cumulative/total))
+		boxes[index] = Box{Top: canvasBox.Top, Left: left, Right: right, Bottom: canvasBox.Bottom}
+	}
+	return boxes
+}
+
+// getSectionBoxes returns the box of each value of a bar, stacked from the top of the bar.
+func (mc MarimekkoChart) getSectionBoxes(barBox Box, bar StackedBar) []Box {
+	total := Sum(Values(bar.Values).Values()...)
+	boxes := make([]Box, len(bar.Values))
+	var cumulative float64
+	for index, v := range bar.Values {
+		var top, bottom int
+		if total > 0 {
+			top = barBox.Top + int(math.Round(float64(barBox.Height())*cumulative/total))
+			cumulative += v.Value
+			bottom = barBox.Top + int(math.Round(float64(barBox.Height())*cumulative/total))
+		}
+		boxes[index] = Box{Top: top, Left: barBox.Left, Right: barBox.Right, Bottom: bottom}
+	}
+	return boxes
+}
+
+// getDefaultCanvasBox returns the chart box less space for the title.
+func (mc MarimekkoChart) getDefaultCanvasBox(r Renderer) Box {
+	canvasBox := mc.Box()
+	if len(mc.Title) > 0 && !mc.TitleStyle.Hidden {
+		tb := Draw.MeasureText(r, mc.Title, mc.styleDefaultsTitle(mc.GetWidth(), mc.GetHeight()))
+		canvasBox.Top += tb.Height() + DefaultTitleTop
+	}
+	return canvasBox
+}
+
+// getAdjustedCanvasBox returns the canvas less space for the axes labels.
+func (mc MarimekkoChart) getAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
+	if !mc.YAxis.Hidden {
+		axisStyle := mc.YAxis.InheritFrom(mc.styleDefaultsAxes())
+		tb := Draw.MeasureText(r, "100%", axisStyle)
+		canvasBox.Right -= DefaultYAxisMargin + tb.Width()
+	}
+
+	if !mc.XAxis.Hidden {
+		xaxisHeight := DefaultVerticalTickHeight
+		axisStyle := mc.XAxis.InheritFrom(mc.styleDefaultsAxes())
+		for index, bar := range mc.Bars {
+			if len(bar.Name) > 0 {
+				width := mc.getBarBoxes(canvasBox)[index].Width()
+				lines := Text.WrapFit(r, bar.Name, width, axisStyle)
+				linesBox := Text.MeasureLines(r, lines, axisStyle)
+				xaxisHeight = MaxInt(linesBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
+			}
+		}
+		canvasBox.Bottom = mc.GetHeight() - mc.Background.Margin.GetBottom() - xaxisHeight
+	}
+	return canvasBox
+}
+
+func (mc MarimekkoChart) getCanvasStyle() Style {
+	return mc.Canvas.InheritFrom(mc.styleDefaultsCanvas())
+}
+
+func (mc MarimekkoChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   mc.GetColorPalette().CanvasColor(),
+		StrokeColor: mc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultCanvasStrokeWidth,
+	}
+}
+
+func (mc MarimekkoChart) styleDefaultsMarimekkoValue(index int) Style {
+	return Style{
+		StrokeColor: ColorWhite,
+		StrokeWidth: 1.0,
+		FillColor:   mc.GetColorPalette().GetSeriesColor(index),
+	}
+}
+
+func (mc MarimekkoChart) styleDefaultsLabels() Style {
+	return mc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: mc.GetColorPalette().TextColor(),
+		Font:      mc.GetFont(),
+	})
+}
+
+func (mc MarimekkoChart) styleDefaultsAxes() Style {
+	return Style{
+		StrokeColor:         DefaultAxisColor,
+		Font:                mc.GetFont(),
+		FontSize:            DefaultAxisFontSize,
+		FontColor:           DefaultAxisColor,
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	}
+}
+
+// Box returns the chart bounds as a box, with the padding of a stacked bar chart for the axes.
+// It replaces the frame's, so the title and canvas are placed by the chart's own methods.
+func (mc MarimekkoChart) Box() Box {
+	dpr := mc.Background.Margin.GetRight() + mc.Background.Padding.GetRight(10)
+	dpb := mc.Background.Margin.GetBottom() + mc.Background.Padding.GetBottom(50)
+
+	return Box{
+		Top:    mc.Background.Margin.GetTop() + mc.Background.Padding.GetTop(20),
+		Left:   mc.Background.Margin.GetLeft() + mc.Background.Padding.GetLeft(20),
+		Right:  mc.GetWidth() - dpr,
+		Bottom: mc.GetHeight() - dpb,
+	}
+}
@@ -0,0 +1,66 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testMarimekkoBars() []StackedBar {
+	return []StackedBar{
+		{Name: "One", Values: []Value{{Value: 30, Label: "A"}, {Value: 10, Label: "B"}}},
+		{Name: "Two", Values: []Value{{Value: 20, Label: "A"}, {Value: 20, Label: "B"}}},
+		{Name: "Three", Values: []Value{{Value: 80, Label: "A"}}},
+	}
+}
+
+func TestMarimekkoChart(t *testing.T) {
+	// replaced new assertions helper
+
+	mc := MarimekkoChart{
+		ChartFrame: ChartFrame{
+			Title: "test",
+		},
+		Bars: testMarimekkoBars(),
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, mc.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestMarimekkoChartGetBarBoxes(t *testing.T) {
+	// replaced new assertions helper
+
+	mc := MarimekkoChart{
+		BarSpacing: 10,
+		Bars:       testMarimekkoBars(),
+	}
+
+	boxes := mc.getBarBoxes(Box{Top: 0, Left: 0, Right: 180, Bottom: 100})
+	testutil.AssertLen(t, boxes, 3)
+	testutil.AssertEqual(t, Box{Top: 0, Left: 0, Right: 40, Bottom: 100}, boxes[0])
+	testutil.AssertEqual(t, Box{Top: 0, Left: 50, Right: 90, Bottom: 100}, boxes[1])
+	testutil.AssertEqual(t, Box{Top: 0, Left: 100, Right: 180, Bottom: 100}, boxes[2])
+}
+
+func TestMarimekkoChartGetSectionBoxes(t *testing.T) {
+	// replaced new assertions helper
+
+	mc := MarimekkoChart{}
+	barBox := Box{Top: 0, Left: 0, Right: 40, Bottom: 100}
+
+	sections := mc.getSectionBoxes(barBox, testMarimekkoBars()[0])
+	testutil.AssertLen(t, sections, 2)
+	testutil.AssertEqual(t, Box{Top: 0, Left: 0, Right: 40, Bottom: 75}, sections[0])
+	testutil.AssertEqual(t, Box{Top: 75, Left: 0, Right: 40, Bottom: 100}, sections[1])
+}
+
+func TestMarimekkoChartRenderInvalid(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, MarimekkoChart{}.Render(PNG, bytes.NewBuffer(nil)))
+	testutil.AssertNotNil(t, MarimekkoChart{Bars: []StackedBar{{Values: []Value{{Value: 0}}}}}.Render(PNG, bytes.NewBuffer(nil)))
+	testutil.AssertNotNil(t, MarimekkoChart{Bars: []StackedBar{{Values: []Value{{Value: -1}}}}}.Render(PNG, bytes.NewBuffer(nil)))
+}
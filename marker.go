@@ -0,0 +1,19 @@
+package chart
+
+// Marker is an enum for the glyphs that can mark a point.
+type Marker int
+
+const (
+	// MarkerUnset is the unset state for markers; the drawing element picks its own default.
+	MarkerUnset Marker = 0
+	// MarkerCircle draws a circle.
+	MarkerCircle Marker = 1
+	// MarkerSquare draws a square.
+	MarkerSquare Marker = 2
+	// MarkerDiamond draws a diamond.
+	MarkerDiamond Marker = 3
+	// MarkerTriangle draws a triangle pointing up.
+	MarkerTriangle Marker = 4
+	// MarkerTriangleDown draws a triangle pointing down.
+	MarkerTriangleDown Marker = 5
+)
@@ -179,6 +179,9 @@ func Mean(values ...float64) float64 {
 
 // MeanInt returns the mean of a set of integer values.
 func MeanInt(values ...int) int {
+	if len(values) == 0 {
+		return 0
+	}
 	return SumInt(values...) / len(values)
 }
 
@@ -136,3 +136,17 @@ func (ms *MaxSeries) Validate() error {
 	}
 	return nil
 }
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (ms MinSeries) CopySeries() Series {
+	ms.InnerSeries = copyValuesProvider(ms.InnerSeries)
+	ms.minValue = nil
+	return &ms
+}
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (ms MaxSeries) CopySeries() Series {
+	ms.InnerSeries = copyValuesProvider(ms.InnerSeries)
+	ms.maxValue = nil
+	return &ms
+}
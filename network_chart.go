@@ -0,0 +1,309 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// NetworkLayout is an enum for the ways the nodes of a network chart can be laid out.
+type NetworkLayout int
+
+const (
+	// NetworkLayoutUnset is the unset state for network layouts; it defaults to `NetworkLayoutForce`.
+	NetworkLayoutUnset NetworkLayout = 0
+	// NetworkLayoutForce places nodes by simulating edges as springs between nodes that repel each other.
+	NetworkLayoutForce NetworkLayout = 1
+	// NetworkLayoutCircular places nodes evenly around a circle, in order, clockwise from the top.
+	NetworkLayoutCircular NetworkLayout = 2
+)
+
+// NetworkNode is a node of a network chart.
+type NetworkNode struct {
+	Style Style
+	Label string
+}
+
+// NetworkEdge is an edge of a network chart between two nodes, by index.
+type NetworkEdge struct {
+	Style  Style
+	From   int
+	To     int
+	Weight float64
+}
+
+// NetworkChart is a chart that draws a graph of labeled nodes joined by weighted edges, e.g. a snapshot
+// of a dependency tree or a network topology; edges are drawn as lines as wide as their weight.
+type NetworkChart struct {
+	ChartFrame
+
+	NodeStyle  Style
+	EdgeStyle  Style
+	LabelStyle Style
+
+	Layout NetworkLayout
+	// Iterations is the number of steps of the force directed layout.
+	Iterations int
+	// NodeRadius is the radius of each node.
+	NodeRadius float64
+	// MaxEdgeWidth is the width of the heaviest edges, lighter edges are narrower in proportion.
+	MaxEdgeWidth float64
+
+	Nodes    []NetworkNode
+	Edges    []NetworkEdge
+	Elements []Renderable
+}
+
+// GetIterations returns the number of steps of the force directed layout or the default value.
+func (nc NetworkChart) GetIterations() int {
+	if nc.Iterations == 0 {
+		return DefaultNetworkIterations
+	}
+	return nc.Iterations
+}
+
+// GetNodeRadius returns the node radius or the default value.
+func (nc NetworkChart) GetNodeRadius() float64 {
+	if nc.NodeRadius == 0 {
+		return DefaultNetworkNodeRadius
+	}
+	return nc.NodeRadius
+}
+
+// GetMaxEdgeWidth returns the width of the heaviest edges or the default value.
+func (nc NetworkChart) GetMaxEdgeWidth() float64 {
+	if nc.MaxEdgeWidth == 0 {
+		return DefaultNetworkMaxEdgeWidth
+	}
+	return nc.MaxEdgeWidth
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (nc NetworkChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := nc.validate(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := nc.GetWidth(), nc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if nc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		nc.defaultFont = defaultFont
+	}
+	r.SetDPI(nc.GetDPI(DefaultDPI))
+
+	canvasBox := nc.getDefaultCanvasBox(r, width, height)
+
+	nc.drawBackground(r, width, height)
+	nc.drawCanvas(r, canvasBox)
+	nc.drawNetwork(r, canvasBox)
+	nc.drawTitle(r, width, height)
+	for _, a := range nc.Elements {
+		a(r, canvasBox, nc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (nc NetworkChart) validate() error {
+	if len(nc.Nodes) == 0 {
+		return errors.New("please provide at least one node")
+	}
+	for _, e := range nc.Edges {
+		if e.From < 0 || e.From >= len(nc.Nodes) || e.To < 0 || e.To >= len(nc.Nodes) {
+			return fmt.Errorf("network chart edges must be between nodes in the chart")
+		}
+		if e.Weight < 0 {
+			return fmt.Errorf("network chart edge weights cannot be negative")
+		}
+	}
+	return nil
+}
+
+// getPositions returns the position of each node, each coordinate on the interval [0, 1].
+func (nc NetworkChart) getPositions() [][2]float64 {
+	positions := make([][2]float64, len(nc.Nodes))
+	for index := range positions {
+		theta := -_pi2 + _2pi*float64(index)/float64(len(positions))
+		positions[index] = [2]float64{math.Cos(theta), math.Sin(theta)}
+	}
+	if nc.Layout != NetworkLayoutCircular && len(positions) > 1 {
+		nc.forceLayout(positions)
+	}
+
+	// fit the positions to the unit square.
+	min := [2]float64{math.MaxFloat64, math.MaxFloat64}
+	max := [2]float64{-math.MaxFloat64, -math.MaxFloat64}
+	for _, p := range positions {
+		for axis := range p {
+			min[axis], max[axis] = math.Min(min[axis], p[axis]), math.Max(max[axis], p[axis])
+		}
+	}
+	for index := range positions {
+		for axis := range positions[index] {
+			if max[axis] > min[axis] {
+				positions[index][axis] = (positions[index][axis] - min[axis]) / (max[axis] - min[axis])
+			} else {
+				positions[index][axis] = 0.5
+			}
+		}
+	}
+	return positions
+}
+
+// forceLayout moves the positions with the Fruchterman-Reingold algorithm; every pair of nodes repels,
+// each edge pulls its nodes together, and the distance nodes can move cools with each iteration.
+func (nc NetworkChart) forceLayout(positions [][2]float64) {
+	k := 2 / math.Sqrt(float64(len(positions)))
+	iterations := nc.GetIterations()
+	for iteration := 0; iteration < iterations; iteration++ {
+		displacements := make([][2]float64, len(positions))
+		for i := range positions {
+			for j := i + 1; j < len(positions); j++ {
+				dx, dy, d := nc.delta(positions[i], positions[j])
+				force := k * k / d
+				displacements[i][0] += dx / d * force
+				displacements[i][1] += dy / d * force
+				displacements[j][0] -= dx / d * force
+				displacements[j][1] -= dy / d * force
+			}
+		}
+		for _, e := range nc.Edges {
+			if e.From == e.To {
+				continue
+			}
+			dx, dy, d := nc.delta(positions[e.From], positions[e.To])
+			force := d * d / k
+			displacements[e.From][0] -= dx / d * force
+			displacements[e.From][1] -= dy / d * force
+			displacements[e.To][0] += dx / d * force
+			displacements[e.To][1] += dy / d * force
+		}
+
+		temperature := 0.2 * (1 - float64(iteration)/float64(iterations))
+		for index, displacement := range displacements {
+			// a little gravity keeps disconnected nodes from drifting away.
+			displacement[0] -= positions[index][0] * k
+			displacement[1] -= positions[index][1] * k
+
+			length := math.Hypot(displacement[0], displacement[1])
+			if length == 0 {
+				continue
+			}
+			step := math.Min(length, temperature)
+			positions[index][0] += displacement[0] / length * step
+			positions[index][1] += displacement[1] / length * step
+		}
+	}
+}
+
+// delta returns the difference between two positions and their distance, which is never zero.
+func (nc NetworkChart) delta(a, b [2]float64) (dx, dy, d float64) {
+	dx, dy = a[0]-b[0], a[1]-b[1]
+	d = math.Max(math.Hypot(dx, dy), 0.01)
+	return
+}
+
+// drawNetwork draws the edges, then the nodes over them, then the labels under the nodes.
+func (nc NetworkChart) drawNetwork(r Renderer, canvasBox Box) {
+	radius := nc.GetNodeRadius()
+	labelStyle := nc.styleDefaultsLabels()
+
+	// inset the layout so that the nodes and their labels stay on the canvas.
+	var labelWidth, labelHeight int
+	for _, n := range nc.Nodes {
+		tb := Draw.MeasureText(r, n.Label, labelStyle)
+		labelWidth = MaxInt(labelWidth, tb.Width())
+		labelHeight = MaxInt(labelHeight, tb.Height())
+	}
+	if nc.LabelStyle.Hidden || labelWidth == 0 {
+		labelWidth, labelHeight = 0, 0
+	} else {
+		labelHeight += DefaultNetworkLabelGap
+	}
+	inset := int(math.Ceil(radius))
+	layoutBox := Box{
+		Top:    canvasBox.Top + inset,
+		Left:   canvasBox.Left + MaxInt(inset, labelWidth>>1),
+		Right:  canvasBox.Right - MaxInt(inset, labelWidth>>1),
+		Bottom: canvasBox.Bottom - inset - labelHeight,
+	}
+	if layoutBox.Width() <= 0 || layoutBox.Height() <= 0 {
+		return
+	}
+
+	positions := nc.getPositions()
+	points := make([][2]int, len(positions))
+	for index, p := range positions {
+		points[index] = [2]int{
+			layoutBox.Left + int(math.Round(p[0]*float64(layoutBox.Width()))),
+			layoutBox.Top + int(math.Round(p[1]*float64(layoutBox.Height()))),
+		}
+	}
+
+	var maxWeight float64
+	for _, e := range nc.Edges {
+		maxWeight = math.Max(maxWeight, e.Weight)
+	}
+	for _, e := range nc.Edges {
+		if e.From == e.To {
+			continue
+		}
+		width := 1.0
+		if maxWeight > 0 {
+			width = math.Max(1, nc.GetMaxEdgeWidth()*e.Weight/maxWeight)
+		}
+		e.Style.InheritFrom(nc.styleNetworkEdge(width)).GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(points[e.From][0], points[e.From][1])
+		r.LineTo(points[e.To][0], points[e.To][1])
+		r.Stroke()
+	}
+
+	for index, n := range nc.Nodes {
+		n.Style.InheritFrom(nc.styleNetworkNode(index)).GetFillAndStrokeOptions().WriteToRenderer(r)
+		r.Circle(radius, points[index][0], points[index][1])
+		r.FillStroke()
+	}
+
+	if nc.LabelStyle.Hidden {
+		return
+	}
+	for index, n := range nc.Nodes {
+		if len(n.Label) == 0 {
+			continue
+		}
+		tb := Draw.MeasureText(r, n.Label, labelStyle)
+		Draw.Text(r, n.Label, points[index][0]-tb.Width()>>1, points[index][1]+inset+DefaultNetworkLabelGap+tb.Height(), labelStyle)
+	}
+}
+
+func (nc NetworkChart) styleNetworkNode(index int) Style {
+	return nc.NodeStyle.InheritFrom(Style{
+		FillColor:   nc.GetColorPalette().GetSeriesColor(index),
+		StrokeColor: nc.GetColorPalette().BackgroundColor(),
+		StrokeWidth: 2,
+	})
+}
+
+func (nc NetworkChart) styleNetworkEdge(width float64) Style {
+	return nc.EdgeStyle.InheritFrom(Style{
+		StrokeColor: nc.GetColorPalette().AxisStrokeColor().WithAlpha(96),
+		StrokeWidth: width,
+	})
+}
+
+func (nc NetworkChart) styleDefaultsLabels() Style {
+	return nc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: nc.GetColorPalette().TextColor(),
+		Font:      nc.GetFont(),
+	})
+}
@@ -0,0 +1,77 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testNetworkChart() NetworkChart {
+	return NetworkChart{
+		ChartFrame: ChartFrame{
+			Title: "Test",
+		},
+		Nodes: []NetworkNode{{Label: "a"}, {Label: "b"}, {Label: "c"}, {Label: "d"}},
+		Edges: []NetworkEdge{
+			{From: 0, To: 1, Weight: 2},
+			{From: 1, To: 2, Weight: 1},
+			{From: 2, To: 0, Weight: 1},
+			{From: 3, To: 3, Weight: 1},
+		},
+	}
+}
+
+func TestNetworkChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, layout := range []NetworkLayout{NetworkLayoutUnset, NetworkLayoutForce, NetworkLayoutCircular} {
+		nc := testNetworkChart()
+		nc.Layout = layout
+		for _, rp := range []RendererProvider{PNG, SVG} {
+			b := bytes.NewBuffer([]byte{})
+			testutil.AssertNil(t, nc.Render(rp, b))
+			testutil.AssertNotZero(t, b.Len())
+		}
+	}
+}
+
+func TestNetworkChartRenderInvalid(t *testing.T) {
+	// replaced new assertions helper
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, NetworkChart{}.Render(PNG, b))
+
+	nc := testNetworkChart()
+	nc.Edges = append(nc.Edges, NetworkEdge{From: 0, To: 4})
+	testutil.AssertNotNil(t, nc.Render(PNG, b))
+
+	nc = testNetworkChart()
+	nc.Edges[0].Weight = -1
+	testutil.AssertNotNil(t, nc.Render(PNG, b))
+}
+
+func TestNetworkChartGetPositions(t *testing.T) {
+	// replaced new assertions helper
+
+	nc := testNetworkChart()
+	nc.Layout = NetworkLayoutCircular
+
+	positions := nc.getPositions()
+	testutil.AssertLen(t, positions, 4)
+	// clockwise from the top, fit to the unit square.
+	testutil.AssertInDelta(t, 0.5, positions[0][0], 1e-9)
+	testutil.AssertInDelta(t, 0, positions[0][1], 1e-9)
+	testutil.AssertInDelta(t, 1, positions[1][0], 1e-9)
+	testutil.AssertInDelta(t, 0.5, positions[1][1], 1e-9)
+
+	nc.Layout = NetworkLayoutForce
+	positions = nc.getPositions()
+	for _, p := range positions {
+		testutil.AssertTrue(t, p[0] >= 0 && p[0] <= 1)
+		testutil.AssertTrue(t, p[1] >= 0 && p[1] <= 1)
+	}
+
+	single := NetworkChart{Nodes: []NetworkNode{{}}}
+	testutil.AssertEqual(t, [][2]float64{{0.5, 0.5}}, single.getPositions())
+}
@@ -0,0 +1,114 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Range         = (*OrdinalRange)(nil)
+	_ TicksProvider = (*OrdinalRange)(nil)
+)
+
+// NewOrdinalRange returns an ordinal range for the given keys, in order.
+func NewOrdinalRange(keys ...interface{}) *OrdinalRange {
+	return &OrdinalRange{Keys: keys}
+}
+
+// OrdinalRange is a range of discrete keys, such as names or enum values, evenly spaced across the domain
+// in a given order. Series plot a key with the value returned by `Position`, and the axis labels each
+// position with its key.
+//
+// Keys must be comparable, and are labeled with their default format, or `String()` if they have one.
+type OrdinalRange struct {
+	Keys       []interface{}
+	Domain     int
+	Descending bool
+}
+
+// Position returns the value for a given key, and if the key is in the range.
+func (or OrdinalRange) Position(key interface{}) (float64, bool) {
+	for index, k := range or.Keys {
+		if k == key {
+			return float64(index), true
+		}
+	}
+	return 0, false
+}
+
+// IsDescending returns if the range is descending.
+func (or OrdinalRange) IsDescending() bool {
+	return or.Descending
+}
+
+// IsZero returns if the range has any keys.
+func (or OrdinalRange) IsZero() bool {
+	return len(or.Keys) == 0
+}
+
+// GetMin returns the min value of the range, half a position before the first key.
+func (or OrdinalRange) GetMin() float64 {
+	return -0.5
+}
+
+// SetMin is a no-op, the bounds of an ordinal range are set by its keys.
+func (or *OrdinalRange) SetMin(_ float64) {}
+
+// GetMax returns the max value of the range, half a position after the last key.
+func (or OrdinalRange) GetMax() float64 {
+	return float64(len(or.Keys)) - 0.5
+}
+
+// SetMax is a no-op, the bounds of an ordinal range are set by its keys.
+func (or *OrdinalRange) SetMax(_ float64) {}
+
+// GetDelta returns the difference between the min and max value.
+func (or OrdinalRange) GetDelta() float64 {
+	return or.GetMax() - or.GetMin()
+}
+
+// GetDomain returns the range domain.
+func (or OrdinalRange) GetDomain() int {
+	return or.Domain
+}
+
+// SetDomain sets the range domain.
+func (or *OrdinalRange) SetDomain(domain int) {
+	or.Domain = domain
+}
+
+// Clone returns a copy of the range.
+func (or OrdinalRange) Clone() Range {
+	or.Keys = append([]interface{}{}, or.Keys...)
+	return &or
+}
+
+// String returns a simple string for the OrdinalRange.
+func (or OrdinalRange) String() string {
+	return fmt.Sprintf("OrdinalRange [%d keys] => %d", len(or.Keys), or.Domain)
+}
+
+// Translate maps a given value into the OrdinalRange space.
+func (or OrdinalRange) Translate(value float64) int {
+	if or.IsZero() {
+		return 0
+	}
+	ratio := (value - or.GetMin()) / or.GetDelta()
+	if or.IsDescending() {
+		return or.Domain - int(math.Ceil(ratio*float64(or.Domain)))
+	}
+	return int(math.Ceil(ratio * float64(or.Domain)))
+}
+
+// GetTicks returns a tick labeled with each key.
+func (or OrdinalRange) GetTicks(_ Renderer, _ Style, _ ValueFormatter) []Tick {
+	ticks := make([]Tick, len(or.Keys))
+	for index, key := range or.Keys {
+		ticks[index] = Tick{
+			Value: float64(index),
+			Label: fmt.Sprintf("%v", key),
+		}
+	}
+	return ticks
+}
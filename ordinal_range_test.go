@@ -0,0 +1,65 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+type ordinalTestKey int
+
+func TestOrdinalRange(t *testing.T) {
+	// replaced new assertions helper
+
+	or := NewOrdinalRange("low", "medium", "high")
+	or.SetDomain(300)
+	testutil.AssertFalse(t, or.IsZero())
+	testutil.AssertEqual(t, -0.5, or.GetMin())
+	testutil.AssertEqual(t, 2.5, or.GetMax())
+
+	position, ok := or.Position("medium")
+	testutil.AssertTrue(t, ok)
+	testutil.AssertEqual(t, 1.0, position)
+	_, ok = or.Position("missing")
+	testutil.AssertFalse(t, ok)
+
+	testutil.AssertEqual(t, 50, or.Translate(0))
+	testutil.AssertEqual(t, 150, or.Translate(1))
+	testutil.AssertEqual(t, 250, or.Translate(2))
+
+	or.Descending = true
+	testutil.AssertEqual(t, 250, or.Translate(0))
+
+	// the bounds are fixed by the keys.
+	or.SetMin(-10)
+	or.SetMax(10)
+	testutil.AssertEqual(t, 2.5, or.GetMax())
+
+	ticks := or.GetTicks(nil, Style{}, nil)
+	testutil.AssertLen(t, ticks, 3)
+	testutil.AssertEqual(t, Tick{Value: 2, Label: "high"}, ticks[2])
+
+	enums := NewOrdinalRange(ordinalTestKey(3), ordinalTestKey(1))
+	position, ok = enums.Position(ordinalTestKey(1))
+	testutil.AssertTrue(t, ok)
+	testutil.AssertEqual(t, 1.0, position)
+	_, ok = enums.Position(1)
+	testutil.AssertFalse(t, ok)
+
+	testutil.AssertTrue(t, (&OrdinalRange{}).IsZero())
+}
+
+func TestChartRenderOrdinalAxis(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		XAxis: XAxis{Range: NewOrdinalRange("2019", "2020", "2021")},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{0, 1, 2}, YValues: []float64{3, 1, 2}},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), "2020")
+}
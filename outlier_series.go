@@ -0,0 +1,247 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultOutlierMADThreshold is the default number of scaled median absolute deviations
+	// from the median beyond which a value is an outlier.
+	DefaultOutlierMADThreshold = 3.0
+	// DefaultOutlierIQRThreshold is the default number of interquartile ranges outside
+	// the quartiles beyond which a value is an outlier.
+	DefaultOutlierIQRThreshold = 1.5
+	// DefaultOutlierDotWidth is the default width of the outlier markers.
+	DefaultOutlierDotWidth = 5.0
+
+	// madScale scales the median absolute deviation to be comparable to a standard deviation for normal data.
+	madScale = 1.4826
+	// meanADScale scales the mean absolute deviation to be comparable to a standard deviation for normal data.
+	meanADScale = 1.2533
+)
+
+// OutlierMethod is an enum for the ways outliers can be detected.
+type OutlierMethod int
+
+const (
+	// OutlierMethodUnset is the unset state for outlier methods; it defaults to `OutlierMethodMAD`.
+	OutlierMethodUnset OutlierMethod = 0
+	// OutlierMethodMAD flags values more than K scaled median absolute deviations from the median.
+	// If at least half of the values equal the median, the median absolute deviation is zero, and the
+	// scaled mean absolute deviation is used in its place.
+	OutlierMethodMAD OutlierMethod = 1
+	// OutlierMethodIQR flags values more than K interquartile ranges below the first quartile or above the third.
+	OutlierMethodIQR OutlierMethod = 2
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*OutlierSeries)(nil)
+	_ FirstValuesProvider = (*OutlierSeries)(nil)
+	_ LastValuesProvider  = (*OutlierSeries)(nil)
+)
+
+// OutlierSeries wraps a series and flags the values that fall outside of bounds computed
+// from the values themselves, so that bad samples can be highlighted with `Markers`.
+type OutlierSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Method OutlierMethod
+	K      float64
+	// RemoveOutliers drops the outliers from the values of the series, so they do not
+	// stretch its range or distort its line.
+	RemoveOutliers bool
+	// OutlierStyle is the style of the series returned by `Markers`.
+	OutlierStyle Style
+
+	InnerSeries ValuesProvider
+
+	lower, upper float64
+	outliers     []int
+	kept         []int
+}
+
+// GetName returns the name of the time series.
+func (osr OutlierSeries) GetName() string {
+	return osr.Name
+}
+
+// GetStyle returns the line style.
+func (osr OutlierSeries) GetStyle() Style {
+	return osr.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (osr OutlierSeries) GetYAxis() YAxisType {
+	return osr.YAxis
+}
+
+// GetMethod returns the outlier method or a default.
+func (osr OutlierSeries) GetMethod() OutlierMethod {
+	if osr.Method == OutlierMethodUnset {
+		return OutlierMethodMAD
+	}
+	return osr.Method
+}
+
+// GetK returns the outlier threshold or a default for the method.
+func (osr OutlierSeries) GetK() float64 {
+	if osr.K == 0 {
+		if osr.GetMethod() == OutlierMethodIQR {
+			return DefaultOutlierIQRThreshold
+		}
+		return DefaultOutlierMADThreshold
+	}
+	return osr.K
+}
+
+// Len returns the number of elements in the series.
+func (osr *OutlierSeries) Len() int {
+	if !osr.RemoveOutliers {
+		return osr.InnerSeries.Len()
+	}
+	osr.ensureOutliers()
+	return len(osr.kept)
+}
+
+// GetValues gets a value at a given index.
+func (osr *OutlierSeries) GetValues(index int) (x, y float64) {
+	if !osr.RemoveOutliers {
+		return osr.InnerSeries.GetValues(index)
+	}
+	osr.ensureOutliers()
+	return osr.InnerSeries.GetValues(osr.kept[index])
+}
+
+// GetFirstValues gets the first values.
+func (osr *OutlierSeries) GetFirstValues() (x, y float64) {
+	if osr.InnerSeries == nil || osr.Len() == 0 {
+		return
+	}
+	return osr.GetValues(0)
+}
+
+// GetLastValues gets the last values.
+func (osr *OutlierSeries) GetLastValues() (x, y float64) {
+	if osr.InnerSeries == nil || osr.Len() == 0 {
+		return
+	}
+	return osr.GetValues(osr.Len() - 1)
+}
+
+// Bounds returns the range of values that are not outliers.
+func (osr *OutlierSeries) Bounds() (lower, upper float64) {
+	osr.ensureOutliers()
+	return osr.lower, osr.upper
+}
+
+// Outliers returns the indexes of the outliers in the inner series.
+func (osr *OutlierSeries) Outliers() []int {
+	osr.ensureOutliers()
+	return osr.outliers
+}
+
+// Markers returns the outliers as a series of dots, to be added to a chart alongside the series.
+func (osr *OutlierSeries) Markers() ContinuousSeries {
+	osr.ensureOutliers()
+	markers := ContinuousSeries{
+		YAxis: osr.YAxis,
+		Style: osr.OutlierStyle.InheritFrom(Style{
+			StrokeWidth: Disabled,
+			DotWidth:    DefaultOutlierDotWidth,
+			DotColor:    ColorRed,
+		}),
+		XValues: make([]float64, len(osr.outliers)),
+		YValues: make([]float64, len(osr.outliers)),
+	}
+	if len(osr.Name) > 0 {
+		markers.Name = osr.Name + " (outliers)"
+	}
+	for index, outlier := range osr.outliers {
+		markers.XValues[index], markers.YValues[index] = osr.InnerSeries.GetValues(outlier)
+	}
+	return markers
+}
+
+// Render renders the series.
+func (osr *OutlierSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := osr.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, osr)
+}
+
+// Validate validates the series.
+func (osr *OutlierSeries) Validate() error {
+	if osr.InnerSeries == nil {
+		return fmt.Errorf("outlier series requires InnerSeries to be set")
+	}
+	if osr.K < 0 {
+		return fmt.Errorf("outlier series requires K to be positive")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (osr OutlierSeries) CopySeries() Series {
+	osr.InnerSeries = copyValuesProvider(osr.InnerSeries)
+	osr.lower, osr.upper = 0, 0
+	osr.outliers, osr.kept = nil, nil
+	return &osr
+}
+
+func (osr *OutlierSeries) ensureOutliers() {
+	if osr.kept != nil {
+		return
+	}
+
+	length := osr.InnerSeries.Len()
+	values := make([]float64, length)
+	for index := range values {
+		_, values[index] = osr.InnerSeries.GetValues(index)
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	k := osr.GetK()
+	if osr.GetMethod() == OutlierMethodIQR {
+		q1, q3 := quantile(sorted, 0.25), quantile(sorted, 0.75)
+		osr.lower, osr.upper = q1-k*(q3-q1), q3+k*(q3-q1)
+	} else {
+		median := quantile(sorted, 0.5)
+		deviations := make([]float64, length)
+		for index, value := range sorted {
+			deviations[index] = math.Abs(value - median)
+		}
+		sort.Float64s(deviations)
+		mad := madScale * quantile(deviations, 0.5)
+		if mad == 0 {
+			// at least half of the values are the median, which would flag every other value.
+			mad = meanADScale * Mean(deviations...)
+		}
+		osr.lower, osr.upper = median-k*mad, median+k*mad
+	}
+
+	osr.outliers = []int{}
+	osr.kept = make([]int, 0, length)
+	for index, value := range values {
+		if value < osr.lower || value > osr.upper {
+			osr.outliers = append(osr.outliers, index)
+		} else {
+			osr.kept = append(osr.kept, index)
+		}
+	}
+}
+
+// quantile returns the linearly interpolated quantile `q` of a sorted set of values.
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(position-float64(lower))
+}
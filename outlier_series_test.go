@@ -0,0 +1,87 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func outlierTestSeries() ContinuousSeries {
+	return ContinuousSeries{
+		XValues: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		YValues: []float64{10, 11, 9, 10, 50, 10, 12, 8, 10, -30},
+	}
+}
+
+func TestOutlierSeriesMAD(t *testing.T) {
+	// replaced new assertions helper
+
+	ols := &OutlierSeries{Name: "samples", InnerSeries: outlierTestSeries()}
+	testutil.AssertNil(t, ols.Validate())
+	testutil.AssertEqual(t, []int{4, 9}, ols.Outliers())
+	testutil.AssertEqual(t, 10, ols.Len())
+
+	lower, upper := ols.Bounds()
+	testutil.AssertTrue(t, lower < 8 && upper > 12)
+
+	markers := ols.Markers()
+	testutil.AssertEqual(t, "samples (outliers)", markers.GetName())
+	testutil.AssertEqual(t, []float64{5, 10}, markers.XValues)
+	testutil.AssertEqual(t, []float64{50, -30}, markers.YValues)
+	testutil.AssertTrue(t, markers.Style.ShouldDrawDot())
+	testutil.AssertFalse(t, markers.Style.ShouldDrawStroke())
+}
+
+func TestOutlierSeriesMADZero(t *testing.T) {
+	// replaced new assertions helper
+
+	ols := &OutlierSeries{InnerSeries: ContinuousSeries{
+		XValues: LinearRange(1, 7),
+		YValues: []float64{1, 1, 1, 1, 2, 2, 2},
+	}}
+	testutil.AssertEmpty(t, ols.Outliers())
+
+	ols = &OutlierSeries{InnerSeries: ContinuousSeries{
+		XValues: LinearRange(1, 7),
+		YValues: []float64{1, 1, 1, 1, 1, 1, 50},
+	}}
+	testutil.AssertEqual(t, []int{6}, ols.Outliers())
+}
+
+func TestOutlierSeriesIQRRemove(t *testing.T) {
+	// replaced new assertions helper
+
+	ols := &OutlierSeries{Method: OutlierMethodIQR, RemoveOutliers: true, InnerSeries: outlierTestSeries()}
+	testutil.AssertEqual(t, DefaultOutlierIQRThreshold, ols.GetK())
+	testutil.AssertEqual(t, []int{4, 9}, ols.Outliers())
+	testutil.AssertEqual(t, 8, ols.Len())
+
+	x, y := ols.GetValues(4)
+	testutil.AssertEqual(t, 6.0, x)
+	testutil.AssertEqual(t, 10.0, y)
+
+	x, y = ols.GetLastValues()
+	testutil.AssertEqual(t, 9.0, x)
+	testutil.AssertEqual(t, 10.0, y)
+
+	// a wide enough threshold keeps every value.
+	wide := &OutlierSeries{Method: OutlierMethodIQR, K: 100, InnerSeries: outlierTestSeries()}
+	testutil.AssertEmpty(t, wide.Outliers())
+}
+
+func TestOutlierSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	ols := &OutlierSeries{RemoveOutliers: true, InnerSeries: outlierTestSeries()}
+	c := Chart{
+		Series: []Series{ols, ols.Markers()},
+	}
+	xrange, yrange, _ := c.getRanges()
+	testutil.AssertEqual(t, 10.0, xrange.GetMax())
+	testutil.AssertEqual(t, 50.0, yrange.GetMax())
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotNil(t, (&OutlierSeries{}).Validate())
+}
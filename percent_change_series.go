@@ -87,3 +87,11 @@ func (pcs PercentChangeSeries) Render(r Renderer, canvasBox Box, xrange, yrange
 func (pcs PercentChangeSeries) Validate() error {
 	return pcs.InnerSeries.Validate()
 }
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (pcs PercentChangeSeries) CopySeries() Series {
+	if copied, isSource := copyValuesProvider(pcs.InnerSeries).(PercentChangeSeriesSource); isSource {
+		pcs.InnerSeries = copied
+	}
+	return pcs
+}
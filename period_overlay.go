@@ -0,0 +1,139 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// DefaultPeriodOverlayPastAlpha is the default alpha of the colors of the past periods of a period overlay.
+	DefaultPeriodOverlayPastAlpha = 160
+	// DefaultPeriodOverlayCurrentStrokeWidth is the default stroke width of the current period of a period overlay.
+	DefaultPeriodOverlayCurrentStrokeWidth = 3.0
+)
+
+// PeriodOverlay folds a time series onto a repeating calendar period, e.g. each week of daily values
+// as its own line over a Monday to Sunday axis, to compare the periods with each other.
+//
+// The x values of the folded series are the days since the start of their period. Each past period
+// is colored from the alternate colors, and the current period, the one with the last value, is
+// emphasized.
+type PeriodOverlay struct {
+	// Period is the repeating period, it defaults to `TimeBoundaryWeek`.
+	Period TimeBoundary
+	// Style is the style of the past periods.
+	Style Style
+	// CurrentStyle is the style of the current period.
+	CurrentStyle Style
+
+	// InnerSeries are the values to fold, with times as x values (see `TimeToFloat64`), e.g. a `TimeSeries`.
+	InnerSeries ValuesProvider
+}
+
+// GetPeriod returns the period or the default.
+func (po PeriodOverlay) GetPeriod() TimeBoundary {
+	if po.Period == TimeBoundaryUnset {
+		return TimeBoundaryWeek
+	}
+	return po.Period
+}
+
+// Validate validates the overlay.
+func (po PeriodOverlay) Validate() error {
+	if po.InnerSeries == nil {
+		return fmt.Errorf("period overlay requires InnerSeries to be set")
+	}
+	if po.Period < TimeBoundaryUnset || po.Period > TimeBoundaryQuarter {
+		return fmt.Errorf("period overlay has an invalid period: %d", po.Period)
+	}
+	return nil
+}
+
+// GetSeries returns a series for each period of the inner series, in order, named by the start of
+// the period and styled by whether it is the current period.
+func (po PeriodOverlay) GetSeries() []Series {
+	period := po.GetPeriod()
+	var periods []ContinuousSeries
+	var start time.Time
+	for index := 0; index < po.InnerSeries.Len(); index++ {
+		x, y := po.InnerSeries.GetValues(index)
+		t := TimeFromFloat64(x)
+		if len(periods) == 0 || !period.Start(t).Equal(start) {
+			start = period.Start(t)
+			periods = append(periods, ContinuousSeries{
+				Name: period.ValueFormatter()(TimeToFloat64(start)),
+			})
+		}
+		current := &periods[len(periods)-1]
+		current.XValues = append(current.XValues, float64(t.Sub(start))/float64(24*time.Hour))
+		current.YValues = append(current.YValues, y)
+	}
+
+	series := make([]Series, len(periods))
+	for index, cs := range periods {
+		if index == len(periods)-1 {
+			cs.Style = po.CurrentStyle.InheritFrom(Style{
+				StrokeColor: GetDefaultColor(0),
+				StrokeWidth: DefaultPeriodOverlayCurrentStrokeWidth,
+			})
+		} else {
+			cs.Style = po.Style.InheritFrom(Style{
+				StrokeColor: GetAlternateColor(index).WithAlpha(DefaultPeriodOverlayPastAlpha),
+				StrokeWidth: DefaultStrokeWidth,
+			})
+		}
+		series[index] = cs
+	}
+	return series
+}
+
+// GetXAxis returns an x-axis of the days of the period: the days of the week for weeks, the days of the
+// month for months, and every other week for quarters.
+func (po PeriodOverlay) GetXAxis() XAxis {
+	var values []float64
+	var vf ValueFormatter
+	switch po.GetPeriod() {
+	case TimeBoundaryMonth:
+		values = []float64{0, 4, 9, 14, 19, 24, 29}
+		vf = func(v interface{}) string {
+			if typed, isTyped := v.(float64); isTyped {
+				return fmt.Sprintf("%d", int(math.Floor(typed))+1)
+			}
+			return ""
+		}
+	case TimeBoundaryQuarter:
+		values = LinearRangeWithStep(0, 84, 14)
+		vf = func(v interface{}) string {
+			if typed, isTyped := v.(float64); isTyped {
+				return fmt.Sprintf("Week %d", int(math.Floor(typed/7))+1)
+			}
+			return ""
+		}
+	default:
+		values = LinearRange(0, 6)
+		vf = func(v interface{}) string {
+			if typed, isTyped := v.(float64); isTyped {
+				return time.Weekday((int(math.Floor(typed)) + 1) % 7).String()[:3]
+			}
+			return ""
+		}
+	}
+	return XAxis{
+		ValueFormatter: vf,
+		TickGenerator:  ValuesTickGenerator{Values: values},
+	}
+}
+
+// GetChart returns a chart of the folded periods on the period x-axis, with a legend.
+func (po PeriodOverlay) GetChart() Chart {
+	c := Chart{
+		XAxis: po.GetXAxis(),
+		YAxisSecondary: YAxis{
+			Style: Hidden(),
+		},
+		Series: po.GetSeries(),
+	}
+	c.Elements = []Renderable{Legend(&c)}
+	return c
+}
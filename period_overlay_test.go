@@ -0,0 +1,79 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testPeriodOverlayValues() TimeSeries {
+	start := time.Date(2024, 3, 4, 0, 0, 0, 0, time.Local)
+	var ts TimeSeries
+	for day := 0; day < 17; day++ {
+		ts.XValues = append(ts.XValues, start.AddDate(0, 0, day).Add(12*time.Hour))
+		ts.YValues = append(ts.YValues, float64(day))
+	}
+	return ts
+}
+
+func TestPeriodOverlayGetSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	po := PeriodOverlay{InnerSeries: testPeriodOverlayValues()}
+	testutil.AssertEqual(t, TimeBoundaryWeek, po.GetPeriod())
+
+	series := po.GetSeries()
+	testutil.AssertLen(t, series, 3)
+
+	first := series[0].(ContinuousSeries)
+	testutil.AssertEqual(t, TimeBoundaryWeek.ValueFormatter()(TimeToFloat64(time.Date(2024, 3, 4, 0, 0, 0, 0, time.Local))), first.Name)
+	testutil.AssertLen(t, first.XValues, 7)
+	testutil.AssertEqual(t, 0.5, first.XValues[0])
+	testutil.AssertEqual(t, 6.5, first.XValues[6])
+	testutil.AssertEqual(t, DefaultStrokeWidth, first.Style.StrokeWidth)
+
+	last := series[2].(ContinuousSeries)
+	testutil.AssertLen(t, last.XValues, 3)
+	testutil.AssertEqual(t, 14.0, last.YValues[0])
+	testutil.AssertEqual(t, DefaultPeriodOverlayCurrentStrokeWidth, last.Style.StrokeWidth)
+	testutil.AssertEqual(t, GetDefaultColor(0), last.Style.StrokeColor)
+
+	po.CurrentStyle = Style{StrokeWidth: 5}
+	testutil.AssertEqual(t, 5.0, po.GetSeries()[2].(ContinuousSeries).Style.StrokeWidth)
+}
+
+func TestPeriodOverlayGetXAxis(t *testing.T) {
+	// replaced new assertions helper
+
+	xa := PeriodOverlay{}.GetXAxis()
+	values := xa.TickGenerator.(ValuesTickGenerator).Values
+	testutil.AssertLen(t, values, 7)
+	testutil.AssertEqual(t, "Mon", xa.ValueFormatter(values[0]))
+	testutil.AssertEqual(t, "Sun", xa.ValueFormatter(values[6]))
+
+	xa = PeriodOverlay{Period: TimeBoundaryMonth}.GetXAxis()
+	testutil.AssertEqual(t, "1", xa.ValueFormatter(0.0))
+	testutil.AssertEqual(t, "30", xa.ValueFormatter(29.0))
+
+	xa = PeriodOverlay{Period: TimeBoundaryQuarter}.GetXAxis()
+	testutil.AssertEqual(t, "Week 3", xa.ValueFormatter(14.0))
+}
+
+func TestPeriodOverlayValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, PeriodOverlay{}.Validate())
+	testutil.AssertNotNil(t, PeriodOverlay{Period: TimeBoundary(42), InnerSeries: testPeriodOverlayValues()}.Validate())
+	testutil.AssertNil(t, PeriodOverlay{InnerSeries: testPeriodOverlayValues()}.Validate())
+}
+
+func TestPeriodOverlayRender(t *testing.T) {
+	// replaced new assertions helper
+
+	c := PeriodOverlay{InnerSeries: testPeriodOverlayValues()}.GetChart()
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
@@ -0,0 +1,197 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// PictogramValue is a category of a pictogram chart.
+type PictogramValue struct {
+	Style Style
+	Label string
+	Value float64
+	// Icon is drawn for the value in place of the chart's icon, if it is set.
+	Icon Icon
+}
+
+// PictogramChart is an infographic-style chart that draws a row of repeated icons for each value,
+// with an icon for each `UnitValue` and a partial icon for any fraction left over.
+//
+// Where the renderer cannot draw an icon, a square colored for the value is drawn in its place.
+type PictogramChart struct {
+	ChartFrame
+
+	LabelStyle Style
+
+	Icon Icon
+	// UnitValue is the value each icon stands for, it defaults to 1.
+	UnitValue float64
+	// IconSize is the pixel size of the icons, it defaults to the largest size the icons fit the canvas at.
+	IconSize int
+	// IconSpacing is the pixel spacing between icons.
+	IconSpacing int
+
+	Values   []PictogramValue
+	Elements []Renderable
+}
+
+// GetUnitValue returns the value of each icon or the default value.
+func (pc PictogramChart) GetUnitValue() float64 {
+	if pc.UnitValue == 0 {
+		return 1
+	}
+	return pc.UnitValue
+}
+
+// GetIconSpacing returns the spacing between icons or the default value.
+func (pc PictogramChart) GetIconSpacing() int {
+	if pc.IconSpacing == 0 {
+		return DefaultPictogramIconSpacing
+	}
+	return pc.IconSpacing
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (pc PictogramChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(pc.Values) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one value"))
+	}
+	if err := pc.validateValues(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := pc.GetWidth(), pc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if pc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		pc.defaultFont = defaultFont
+	}
+	r.SetDPI(pc.GetDPI(DefaultDPI))
+
+	canvasBox := pc.getDefaultCanvasBox(r, width, height)
+	labelWidth := pc.getLabelWidth(r)
+	iconSize := pc.getIconSize(canvasBox, labelWidth)
+
+	pc.drawBackground(r, width, height)
+	pc.drawCanvas(r, canvasBox)
+	pc.drawValues(r, canvasBox, labelWidth, iconSize)
+	pc.drawTitle(r, width, height)
+	for _, a := range pc.Elements {
+		a(r, canvasBox, pc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (pc PictogramChart) validateValues() error {
+	for _, v := range pc.Values {
+		if v.Value < 0 {
+			return fmt.Errorf("pictogram chart values cannot be negative")
+		}
+	}
+	if pc.GetUnitValue() < 0 {
+		return fmt.Errorf("pictogram chart unit value must be positive")
+	}
+	return nil
+}
+
+// drawValues draws a row for each value, its label right aligned beside its icons, with the rows
+// vertically centered in the canvas.
+func (pc PictogramChart) drawValues(r Renderer, canvasBox Box, labelWidth, iconSize int) {
+	spacing := pc.GetIconSpacing()
+	labelStyle := pc.styleDefaultsLabels()
+	rowsHeight := len(pc.Values)*(iconSize+spacing) - spacing
+	top := canvasBox.Top + (canvasBox.Height()-rowsHeight)>>1
+	iconsLeft := canvasBox.Left + labelWidth
+
+	for index, v := range pc.Values {
+		rowTop := top + index*(iconSize+spacing)
+		if len(v.Label) > 0 && !pc.LabelStyle.Hidden {
+			tb := Draw.MeasureText(r, v.Label, labelStyle)
+			Draw.Text(r, v.Label, iconsLeft-DefaultPictogramLabelGap-tb.Width(), rowTop+(iconSize+tb.Height())>>1, labelStyle)
+		}
+
+		icon := v.Icon
+		if icon.IsZero() {
+			icon = pc.Icon
+		}
+		style := v.Style.InheritFrom(pc.stylePictogramValue(index))
+
+		units := v.Value / pc.GetUnitValue()
+		for unit := 0; float64(unit) < units; unit++ {
+			left := iconsLeft + unit*(iconSize+spacing)
+			box := Box{Top: rowTop, Left: left, Right: left + iconSize, Bottom: rowTop + iconSize}
+			fraction := math.Min(1, units-float64(unit))
+			if !icon.RenderPartial(r, box, fraction) {
+				box.Right = box.Left + int(math.Round(float64(iconSize)*fraction))
+				Draw.Box(r, box, style)
+			}
+		}
+	}
+}
+
+// getIconCount returns the number of icons, whole or partial, in the longest row.
+func (pc PictogramChart) getIconCount() int {
+	var count int
+	for _, v := range pc.Values {
+		count = MaxInt(count, int(math.Ceil(v.Value/pc.GetUnitValue())))
+	}
+	return count
+}
+
+// getIconSize returns the icon size, limited to the largest size that fits all the rows in the canvas.
+func (pc PictogramChart) getIconSize(canvasBox Box, labelWidth int) int {
+	spacing := pc.GetIconSpacing()
+	rows := len(pc.Values)
+	size := (canvasBox.Height() - (rows-1)*spacing) / rows
+	if count := pc.getIconCount(); count > 0 {
+		size = MinInt(size, (canvasBox.Width()-labelWidth-(count-1)*spacing)/count)
+	}
+	if pc.IconSize > 0 {
+		size = MinInt(size, pc.IconSize)
+	}
+	return MaxInt(0, size)
+}
+
+// getLabelWidth returns the width of the label column, including the gap before the icons.
+func (pc PictogramChart) getLabelWidth(r Renderer) int {
+	if pc.LabelStyle.Hidden {
+		return 0
+	}
+	labelStyle := pc.styleDefaultsLabels()
+	var width int
+	for _, v := range pc.Values {
+		if len(v.Label) > 0 {
+			width = MaxInt(width, Draw.MeasureText(r, v.Label, labelStyle).Width())
+		}
+	}
+	if width == 0 {
+		return 0
+	}
+	return width + DefaultPictogramLabelGap
+}
+
+func (pc PictogramChart) stylePictogramValue(index int) Style {
+	return Style{
+		StrokeColor: pc.GetColorPalette().GetSeriesColor(index),
+		StrokeWidth: DefaultStrokeWidth,
+		FillColor:   pc.GetColorPalette().GetSeriesColor(index),
+	}
+}
+
+func (pc PictogramChart) styleDefaultsLabels() Style {
+	return pc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: pc.GetColorPalette().TextColor(),
+		Font:      pc.GetFont(),
+	})
+}
@@ -0,0 +1,73 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestPictogramChart(t *testing.T) {
+	// replaced new assertions helper
+
+	pc := PictogramChart{
+		ChartFrame: ChartFrame{
+			Title: "test",
+		},
+		UnitValue: 10,
+		Icon:      Icon{Image: testIconImage()},
+		Values: []PictogramValue{
+			{Value: 45, Label: "Cats"},
+			{Value: 72.5, Label: "Dogs"},
+			{Value: 18, Label: "Fish"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, pc.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+
+	b = bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, pc.Render(SVG, b))
+	testutil.AssertContains(t, b.String(), "<image")
+}
+
+func TestPictogramChartProps(t *testing.T) {
+	// replaced new assertions helper
+
+	pc := PictogramChart{}
+	testutil.AssertEqual(t, 1.0, pc.GetUnitValue())
+	testutil.AssertEqual(t, DefaultPictogramIconSpacing, pc.GetIconSpacing())
+
+	pc.UnitValue, pc.IconSpacing = 5, 2
+	testutil.AssertEqual(t, 5.0, pc.GetUnitValue())
+	testutil.AssertEqual(t, 2, pc.GetIconSpacing())
+}
+
+func TestPictogramChartGetIconSize(t *testing.T) {
+	// replaced new assertions helper
+
+	pc := PictogramChart{
+		UnitValue:   10,
+		IconSpacing: 10,
+		Values: []PictogramValue{
+			{Value: 45},
+			{Value: 12},
+		},
+	}
+	testutil.AssertEqual(t, 5, pc.getIconCount())
+
+	canvasBox := Box{Right: 1000, Bottom: 100}
+	testutil.AssertEqual(t, 45, pc.getIconSize(canvasBox, 0))
+	testutil.AssertEqual(t, 36, pc.getIconSize(Box{Right: 220, Bottom: 100}, 0))
+
+	pc.IconSize = 20
+	testutil.AssertEqual(t, 20, pc.getIconSize(canvasBox, 0))
+}
+
+func TestPictogramChartRenderInvalid(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, PictogramChart{}.Render(PNG, bytes.NewBuffer(nil)))
+	testutil.AssertNotNil(t, PictogramChart{Values: []PictogramValue{{Value: -1}}}.Render(PNG, bytes.NewBuffer(nil)))
+}
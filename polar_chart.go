@@ -0,0 +1,425 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// PolarSeries is a set of points in polar coordinates, an angle and a distance from the center, drawn
+// as a line through the points in order.
+type PolarSeries struct {
+	Name  string
+	Style Style
+
+	// ThetaValues are the angles of the points in degrees, and RValues their distances from the center.
+	ThetaValues []float64
+	RValues     []float64
+
+	// Closed joins the last point back to the first, e.g. for an antenna pattern.
+	Closed bool
+}
+
+// PolarChart is a chart that draws series of points in polar coordinates, within concentric grid
+// circles labeled with their distance and spokes labeled with their angle, e.g. for antenna patterns
+// or wind roses.
+type PolarChart struct {
+	ChartFrame
+
+	// GridStyle is the style of the grid circles and spokes.
+	GridStyle Style
+	// LabelStyle is the style of the angle labels around the edge, and AxisStyle of the distance
+	// labels of the grid circles.
+	LabelStyle  Style
+	AxisStyle   Style
+	LegendStyle Style
+
+	// Range bounds the distances from the center, it defaults to zero to the largest distance.
+	Range Range
+	// GridLines is the number of grid circles between the center and the edge.
+	GridLines int
+	// Spokes is the number of angular grid lines, evenly spaced from the zero angle.
+	Spokes int
+
+	// ThetaZero is the direction of the zero angle, in degrees clockwise from the top, and
+	// CounterClockwise makes angles increase counterclockwise from it; the defaults are compass
+	// bearings, and a ThetaZero of 90 with CounterClockwise set are the mathematical convention.
+	ThetaZero        float64
+	CounterClockwise bool
+
+	// ValueFormatter formats the distance labels, and ThetaFormatter the angle labels.
+	ValueFormatter ValueFormatter
+	ThetaFormatter ValueFormatter
+
+	Series   []PolarSeries
+	Elements []Renderable
+}
+
+// GetHeight returns the chart height or the default value.
+func (pc PolarChart) GetHeight() int {
+	if pc.Height == 0 {
+		return DefaultChartWidth
+	}
+	return pc.Height
+}
+
+// Box returns the chart bounds as a box, at the chart's own default size.
+func (pc PolarChart) Box() Box {
+	return pc.box(pc.GetWidth(), pc.GetHeight())
+}
+
+// GetGridLines returns the number of grid circles or the default.
+func (pc PolarChart) GetGridLines() int {
+	if pc.GridLines == 0 {
+		return DefaultPolarGridLines
+	}
+	return pc.GridLines
+}
+
+// GetSpokes returns the number of spokes or the default.
+func (pc PolarChart) GetSpokes() int {
+	if pc.Spokes == 0 {
+		return DefaultPolarSpokes
+	}
+	return pc.Spokes
+}
+
+// GetValueFormatter returns the distance label formatter or the default.
+func (pc PolarChart) GetValueFormatter() ValueFormatter {
+	if pc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return pc.ValueFormatter
+}
+
+// GetThetaFormatter returns the angle label formatter or the default, whole degrees.
+func (pc PolarChart) GetThetaFormatter() ValueFormatter {
+	if pc.ThetaFormatter == nil {
+		return func(v interface{}) string {
+			return FloatValueFormatterWithFormat(v, "%0.0f°")
+		}
+	}
+	return pc.ThetaFormatter
+}
+
+// GetRange returns the min and max distance from the center, either the range or zero to the largest distance,
+// or zero to one if the distances are all zero.
+func (pc PolarChart) GetRange() (min, max float64) {
+	if pc.Range != nil && !pc.Range.IsZero() {
+		return pc.Range.GetMin(), pc.Range.GetMax()
+	}
+	for _, s := range pc.Series {
+		for _, value := range s.RValues {
+			max = math.Max(max, value)
+		}
+	}
+	if max == min {
+		// all the distances are zero; draw them at the center of a unit range.
+		max = min + 1
+	}
+	return
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (pc PolarChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := pc.validate(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := pc.GetWidth(), pc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if pc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		pc.defaultFont = defaultFont
+	}
+	r.SetDPI(pc.GetDPI(DefaultDPI))
+
+	canvasBox := pc.getDefaultCanvasBox(r, width, height)
+	plotBox, legendBox := pc.getLayout(r, canvasBox)
+	cx, cy := plotBox.Center()
+	radius := pc.getRadius(r, plotBox)
+
+	pc.drawBackground(r, width, height)
+	pc.drawCanvas(r, canvasBox)
+	pc.drawGrid(r, cx, cy, radius)
+	pc.drawSeries(r, cx, cy, radius)
+	pc.drawAxisLabels(r, cx, cy, radius)
+	pc.drawLabels(r, cx, cy, radius)
+	pc.drawLegend(r, legendBox)
+	pc.drawTitle(r, width, height)
+	for _, a := range pc.Elements {
+		a(r, canvasBox, pc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (pc PolarChart) validate() error {
+	if len(pc.Series) == 0 {
+		return errors.New("please provide at least one series")
+	}
+	for index, s := range pc.Series {
+		if len(s.ThetaValues) != len(s.RValues) {
+			return fmt.Errorf("polar chart series %d has %d theta values for %d r values", index, len(s.ThetaValues), len(s.RValues))
+		}
+	}
+	if min, max := pc.GetRange(); max <= min {
+		return errors.New("polar chart range must have a max greater than its min")
+	}
+	return nil
+}
+
+// getAngle returns the angle on the canvas, in radians clockwise from three o'clock, of an angle in degrees.
+func (pc PolarChart) getAngle(theta float64) float64 {
+	if pc.CounterClockwise {
+		theta = -theta
+	}
+	return DegreesToRadians(pc.ThetaZero+theta) - _pi2
+}
+
+// pointAt returns the point at an angle in degrees and a distance from the center.
+func (pc PolarChart) pointAt(cx, cy int, theta, distance float64) (x, y int) {
+	angle := pc.getAngle(theta)
+	return cx + int(math.Round(distance*math.Cos(angle))), cy + int(math.Round(distance*math.Sin(angle)))
+}
+
+// getSpokeTheta returns the angle in degrees of a spoke.
+func (pc PolarChart) getSpokeTheta(spoke int) float64 {
+	return 360 * float64(spoke) / float64(pc.GetSpokes())
+}
+
+// getLayout splits the canvas into the plot, and the legend to the right of it if any series is named.
+func (pc PolarChart) getLayout(r Renderer, canvasBox Box) (plotBox, legendBox Box) {
+	plotBox = canvasBox
+	if pc.LegendStyle.Hidden {
+		return
+	}
+	textStyle := pc.styleDefaultsLegend()
+	var labelWidth int
+	for _, s := range pc.Series {
+		labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, s.Name, textStyle).Width())
+	}
+	if labelWidth == 0 {
+		return
+	}
+	legendWidth := DefaultRadarLegendGap + pc.getLegendSwatchSize() + DefaultRadarLegendGap + labelWidth
+	plotBox.Right -= legendWidth
+	legendBox = Box{
+		Top:    canvasBox.Top,
+		Left:   plotBox.Right + DefaultRadarLegendGap,
+		Right:  canvasBox.Right,
+		Bottom: canvasBox.Bottom,
+	}
+	return
+}
+
+// getRadius returns the radius of the plot, leaving room for the angle labels around it.
+func (pc PolarChart) getRadius(r Renderer, plotBox Box) float64 {
+	var labelWidth, labelHeight int
+	if !pc.LabelStyle.Hidden {
+		labelStyle := pc.styleDefaultsLabels()
+		for spoke := 0; spoke < pc.GetSpokes(); spoke++ {
+			tb := Draw.MeasureText(r, pc.GetThetaFormatter()(pc.getSpokeTheta(spoke)), labelStyle)
+			labelWidth, labelHeight = MaxInt(labelWidth, tb.Width()), MaxInt(labelHeight, tb.Height())
+		}
+		labelWidth, labelHeight = labelWidth+DefaultPolarLabelGap, labelHeight+DefaultPolarLabelGap
+	}
+	return math.Max(0, math.Min(
+		float64(plotBox.Width()>>1-labelWidth),
+		float64(plotBox.Height()>>1-labelHeight),
+	))
+}
+
+// drawGrid draws the grid circles and the spokes.
+func (pc PolarChart) drawGrid(r Renderer, cx, cy int, radius float64) {
+	if pc.GridStyle.Hidden {
+		return
+	}
+	pc.styleDefaultsGrid().GetStrokeOptions().WriteToRenderer(r)
+	lines := pc.GetGridLines()
+	for line := 1; line <= lines; line++ {
+		distance := radius * float64(line) / float64(lines)
+		// stroke each circle on its own, as an arc joins the end of the path before it, and draw
+		// it in halves, as svg cannot draw an arc that ends where it starts.
+		r.ArcTo(cx, cy, distance, distance, 0, _pi)
+		r.ArcTo(cx, cy, distance, distance, _pi, _pi)
+		r.Close()
+		r.Stroke()
+	}
+	for spoke := 0; spoke < pc.GetSpokes(); spoke++ {
+		r.MoveTo(cx, cy)
+		r.LineTo(pc.pointAt(cx, cy, pc.getSpokeTheta(spoke), radius))
+	}
+	r.Stroke()
+}
+
+// drawSeries draws each series as a line through its points, with the distances clamped to the range.
+func (pc PolarChart) drawSeries(r Renderer, cx, cy int, radius float64) {
+	min, max := pc.GetRange()
+	for index, s := range pc.Series {
+		if len(s.RValues) == 0 {
+			continue
+		}
+		style := s.Style.InheritFrom(pc.styleDefaultsSeries(index))
+		points := make([][2]int, len(s.RValues))
+		for i, value := range s.RValues {
+			distance := radius * math.Max(0, math.Min(1, (value-min)/(max-min)))
+			points[i][0], points[i][1] = pc.pointAt(cx, cy, s.ThetaValues[i], distance)
+		}
+
+		if s.Closed {
+			style.GetFillAndStrokeOptions().WriteToRenderer(r)
+		} else {
+			style.GetStrokeOptions().WriteToRenderer(r)
+		}
+		r.MoveTo(points[0][0], points[0][1])
+		for _, p := range points[1:] {
+			r.LineTo(p[0], p[1])
+		}
+		if s.Closed {
+			r.Close()
+			r.FillStroke()
+		} else {
+			r.Stroke()
+		}
+
+		if style.ShouldDrawDot() {
+			dotStyle := style.GetDotOptions()
+			for _, p := range points {
+				dotStyle.WriteToRenderer(r)
+				r.Circle(style.GetDotWidth(), p[0], p[1])
+				r.FillStroke()
+			}
+		}
+	}
+}
+
+// drawAxisLabels draws the distance of each grid circle, between the first two spokes.
+func (pc PolarChart) drawAxisLabels(r Renderer, cx, cy int, radius float64) {
+	if pc.AxisStyle.Hidden {
+		return
+	}
+	axisStyle := pc.styleDefaultsAxis()
+	theta := pc.getSpokeTheta(1) / 2
+	min, max := pc.GetRange()
+	lines := pc.GetGridLines()
+	for line := 1; line <= lines; line++ {
+		label := pc.GetValueFormatter()(min + (max-min)*float64(line)/float64(lines))
+		tb := Draw.MeasureText(r, label, axisStyle)
+		x, y := pc.pointAt(cx, cy, theta, radius*float64(line)/float64(lines))
+		Draw.Text(r, label, x-tb.Width()>>1, y+tb.Height()>>1, axisStyle)
+	}
+}
+
+// drawLabels draws the angle of each spoke beyond its end, aligned away from the center.
+func (pc PolarChart) drawLabels(r Renderer, cx, cy int, radius float64) {
+	if pc.LabelStyle.Hidden {
+		return
+	}
+	labelStyle := pc.styleDefaultsLabels()
+	for spoke := 0; spoke < pc.GetSpokes(); spoke++ {
+		theta := pc.getSpokeTheta(spoke)
+		label := pc.GetThetaFormatter()(theta)
+		angle := pc.getAngle(theta)
+		cos, sin := math.Cos(angle), math.Sin(angle)
+		tb := Draw.MeasureText(r, label, labelStyle)
+
+		x, y := pc.pointAt(cx, cy, theta, radius+DefaultPolarLabelGap)
+		if cos < -0.1 {
+			x -= tb.Width()
+		} else if cos <= 0.1 {
+			x -= tb.Width() >> 1
+		}
+		if sin > 0.1 {
+			y += tb.Height()
+		} else if sin >= -0.1 {
+			y += tb.Height() >> 1
+		}
+		Draw.Text(r, label, x, y, labelStyle)
+	}
+}
+
+// drawLegend draws a swatch and label for each named series, vertically centered beside the plot.
+func (pc PolarChart) drawLegend(r Renderer, legendBox Box) {
+	if pc.LegendStyle.Hidden || legendBox.IsZero() {
+		return
+	}
+
+	textStyle := pc.styleDefaultsLegend()
+	swatch := pc.getLegendSwatchSize()
+	lineHeight := swatch + DefaultLineSpacing
+	top := legendBox.Top + (legendBox.Height()-lineHeight*len(pc.Series))>>1
+
+	for index, s := range pc.Series {
+		y := top + index*lineHeight
+		style := s.Style.InheritFrom(pc.styleDefaultsSeries(index))
+		Draw.Box(r, Box{
+			Top:    y,
+			Left:   legendBox.Left,
+			Right:  legendBox.Left + swatch,
+			Bottom: y + swatch,
+		}, Style{
+			FillColor:   style.StrokeColor,
+			StrokeColor: style.StrokeColor,
+			StrokeWidth: DefaultStrokeWidth,
+		})
+		if len(s.Name) > 0 {
+			tb := Draw.MeasureText(r, s.Name, textStyle)
+			Draw.Text(r, s.Name, legendBox.Left+swatch+DefaultRadarLegendGap, y+(swatch+tb.Height())>>1, textStyle)
+		}
+	}
+}
+
+// getLegendSwatchSize returns the size of the legend swatches, the height of the legend text in pixels.
+func (pc PolarChart) getLegendSwatchSize() int {
+	return int(pc.styleDefaultsLegend().GetFontSize() * pc.GetDPI(DefaultDPI) / 72.0)
+}
+
+func (pc PolarChart) styleDefaultsLabels() Style {
+	return pc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: pc.GetColorPalette().TextColor(),
+		Font:      pc.GetFont(),
+	})
+}
+
+func (pc PolarChart) styleDefaultsAxis() Style {
+	return pc.AxisStyle.InheritFrom(Style{
+		FontSize:  DefaultAxisFontSize,
+		FontColor: pc.GetColorPalette().TextColor(),
+		Font:      pc.GetFont(),
+	})
+}
+
+func (pc PolarChart) styleDefaultsGrid() Style {
+	return pc.GridStyle.InheritFrom(Style{
+		StrokeColor: pc.GetColorPalette().AxisStrokeColor().WithAlpha(96),
+		StrokeWidth: DefaultAxisLineWidth,
+	})
+}
+
+func (pc PolarChart) styleDefaultsSeries(index int) Style {
+	color := pc.GetColorPalette().GetSeriesColor(index)
+	return Style{
+		StrokeColor: color,
+		StrokeWidth: 2,
+		FillColor:   color.WithAlpha(64),
+		DotColor:    color,
+	}
+}
+
+func (pc PolarChart) styleDefaultsLegend() Style {
+	return pc.LegendStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: pc.GetColorPalette().TextColor(),
+		Font:      pc.GetFont(),
+	})
+}
@@ -0,0 +1,98 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestPolarChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	pc := PolarChart{
+		ChartFrame: ChartFrame{
+			Title: "Test",
+		},
+		Series: []PolarSeries{
+			{Name: "a", ThetaValues: []float64{0, 90, 180, 270}, RValues: []float64{1, 2, 3, 4}, Closed: true},
+			{ThetaValues: []float64{45, 135}, RValues: []float64{2, 5}},
+		},
+	}
+	for _, rp := range []RendererProvider{PNG, SVG} {
+		b := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, pc.Render(rp, b))
+		testutil.AssertNotZero(t, b.Len())
+	}
+}
+
+func TestPolarChartDefaultSize(t *testing.T) {
+	// replaced new assertions helper
+
+	// the chart is square by default, and its frame is drawn at that size.
+	pc := PolarChart{}
+	testutil.AssertEqual(t, DefaultChartWidth, pc.GetHeight())
+	testutil.AssertEqual(t, Box{Top: DefaultBackgroundPadding.Top, Left: DefaultBackgroundPadding.Left, Right: DefaultChartWidth - DefaultBackgroundPadding.Right, Bottom: DefaultChartWidth - DefaultBackgroundPadding.Bottom}, pc.Box())
+}
+
+func TestPolarChartRenderInvalid(t *testing.T) {
+	// replaced new assertions helper
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, PolarChart{}.Render(PNG, b))
+	testutil.AssertNotNil(t, PolarChart{Series: []PolarSeries{{ThetaValues: []float64{0}, RValues: []float64{1, 2}}}}.Render(PNG, b))
+	testutil.AssertNotNil(t, PolarChart{Series: []PolarSeries{{ThetaValues: []float64{0}, RValues: []float64{1}}}, Range: &ContinuousRange{Min: 1, Max: 1}}.Render(PNG, b))
+}
+
+func TestPolarChartRenderZeroValues(t *testing.T) {
+	// replaced new assertions helper
+
+	pc := PolarChart{Series: []PolarSeries{{ThetaValues: []float64{0, 90}, RValues: []float64{0, 0}}}}
+	for _, rp := range []RendererProvider{PNG, SVG} {
+		b := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, pc.Render(rp, b))
+		testutil.AssertNotZero(t, b.Len())
+	}
+}
+
+func TestPolarChartGetRange(t *testing.T) {
+	// replaced new assertions helper
+
+	pc := PolarChart{
+		Series: []PolarSeries{
+			{ThetaValues: []float64{0, 90}, RValues: []float64{3, 7}},
+		},
+	}
+	min, max := pc.GetRange()
+	testutil.AssertEqual(t, 0.0, min)
+	testutil.AssertEqual(t, 7.0, max)
+
+	pc.Range = &ContinuousRange{Min: 1, Max: 10}
+	min, max = pc.GetRange()
+	testutil.AssertEqual(t, 1.0, min)
+	testutil.AssertEqual(t, 10.0, max)
+
+	// all zero distances fall back to a unit range.
+	pc = PolarChart{Series: []PolarSeries{{ThetaValues: []float64{0}, RValues: []float64{0}}}}
+	min, max = pc.GetRange()
+	testutil.AssertEqual(t, 0.0, min)
+	testutil.AssertEqual(t, 1.0, max)
+}
+
+func TestPolarChartPointAt(t *testing.T) {
+	// replaced new assertions helper
+
+	pc := PolarChart{}
+	// compass bearings, zero at the top and clockwise.
+	x, y := pc.pointAt(100, 100, 0, 10)
+	testutil.AssertEqual(t, []int{100, 90}, []int{x, y})
+	x, y = pc.pointAt(100, 100, 90, 10)
+	testutil.AssertEqual(t, []int{110, 100}, []int{x, y})
+
+	// the mathematical convention, zero to the right and counterclockwise.
+	pc = PolarChart{ThetaZero: 90, CounterClockwise: true}
+	x, y = pc.pointAt(100, 100, 0, 10)
+	testutil.AssertEqual(t, []int{110, 100}, []int{x, y})
+	x, y = pc.pointAt(100, 100, 90, 10)
+	testutil.AssertEqual(t, []int{100, 90}, []int{x, y})
+}
@@ -12,6 +12,7 @@ var (
 	_ Series              = (*PolynomialRegressionSeries)(nil)
 	_ FirstValuesProvider = (*PolynomialRegressionSeries)(nil)
 	_ LastValuesProvider  = (*PolynomialRegressionSeries)(nil)
+	_ RegressionProvider  = (*PolynomialRegressionSeries)(nil)
 )
 
 // PolynomialRegressionSeries implements a polynomial regression over a given
@@ -142,6 +143,18 @@ func (prs *PolynomialRegressionSeries) GetLastValues() (x, y float64) {
 	return
 }
 
+// Predict returns the fitted value for a given x value.
+func (prs *PolynomialRegressionSeries) Predict(x float64) float64 {
+	if prs.coeffs == nil {
+		coeffs, err := prs.computeCoefficients()
+		if err != nil {
+			panic(err)
+		}
+		prs.coeffs = coeffs
+	}
+	return prs.apply(x)
+}
+
 func (prs *PolynomialRegressionSeries) apply(v float64) (out float64) {
 	for index, coeff := range prs.coeffs {
 		out = out + (coeff * math.Pow(v, float64(index)))
@@ -175,3 +188,10 @@ func (prs *PolynomialRegressionSeries) Render(r Renderer, canvasBox Box, xrange,
 	style := prs.Style.InheritFrom(defaults)
 	Draw.LineSeries(r, canvasBox, xrange, yrange, style, prs)
 }
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (prs PolynomialRegressionSeries) CopySeries() Series {
+	prs.InnerSeries = copyValuesProvider(prs.InnerSeries)
+	prs.coeffs = nil
+	return &prs
+}
@@ -0,0 +1,377 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// RadarSeries is a set of values, one for each axis of a radar chart, drawn as a polygon.
+type RadarSeries struct {
+	Name   string
+	Style  Style
+	Values []float64
+}
+
+// RadarChart is a chart that draws one or more series across axes arranged radially, e.g. to compare
+// the scores of a few items across several categories.
+type RadarChart struct {
+	ChartFrame
+
+	// GridStyle is the style of the grid lines and the spokes of the axes.
+	GridStyle   Style
+	LabelStyle  Style
+	LegendStyle Style
+
+	// Range bounds the values along each axis, it defaults to zero (or the smallest value if negative)
+	// to the largest value.
+	Range Range
+	// GridLines is the number of grid lines between the center and the edge.
+	GridLines int
+	// CircularGrid draws the grid lines as circles rather than polygons.
+	CircularGrid bool
+	// RotateLabels draws the axis labels along their spokes.
+	RotateLabels bool
+
+	// Axes are the labels of the axes, clockwise from the top.
+	Axes     []string
+	Series   []RadarSeries
+	Elements []Renderable
+}
+
+// GetHeight returns the chart height or the default value.
+func (rc RadarChart) GetHeight() int {
+	if rc.Height == 0 {
+		return DefaultChartWidth
+	}
+	return rc.Height
+}
+
+// Box returns the chart bounds as a box, at the chart's own default size.
+func (rc RadarChart) Box() Box {
+	return rc.box(rc.GetWidth(), rc.GetHeight())
+}
+
+// GetGridLines returns the number of grid lines or the default.
+func (rc RadarChart) GetGridLines() int {
+	if rc.GridLines == 0 {
+		return DefaultRadarGridLines
+	}
+	return rc.GridLines
+}
+
+// GetRange returns the min and max of the axes, either the range or zero to the largest value,
+// or zero to one if the values are all zero.
+func (rc RadarChart) GetRange() (min, max float64) {
+	if rc.Range != nil && !rc.Range.IsZero() {
+		return rc.Range.GetMin(), rc.Range.GetMax()
+	}
+	for _, s := range rc.Series {
+		for _, value := range s.Values {
+			min, max = math.Min(min, value), math.Max(max, value)
+		}
+	}
+	if max == min {
+		// all the values are zero; draw them at the center of a unit range.
+		max = min + 1
+	}
+	return
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (rc RadarChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := rc.validate(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := rc.GetWidth(), rc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if rc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		rc.defaultFont = defaultFont
+	}
+	r.SetDPI(rc.GetDPI(DefaultDPI))
+
+	canvasBox := rc.getDefaultCanvasBox(r, width, height)
+	plotBox, legendBox := rc.getLayout(r, canvasBox)
+	cx, cy := plotBox.Center()
+	radius := rc.getRadius(r, plotBox)
+
+	rc.drawBackground(r, width, height)
+	rc.drawCanvas(r, canvasBox)
+	rc.drawGrid(r, cx, cy, radius)
+	rc.drawSeries(r, cx, cy, radius)
+	rc.drawLabels(r, cx, cy, radius)
+	rc.drawLegend(r, legendBox)
+	rc.drawTitle(r, width, height)
+	for _, a := range rc.Elements {
+		a(r, canvasBox, rc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (rc RadarChart) validate() error {
+	if len(rc.Axes) < 3 {
+		return errors.New("radar chart must have at least (3) axes")
+	}
+	if len(rc.Series) == 0 {
+		return errors.New("please provide at least one series")
+	}
+	for index, s := range rc.Series {
+		if len(s.Values) != len(rc.Axes) {
+			return fmt.Errorf("radar chart series %d has %d values for %d axes", index, len(s.Values), len(rc.Axes))
+		}
+	}
+	if min, max := rc.GetRange(); max <= min {
+		return errors.New("radar chart range must have a max greater than its min")
+	}
+	return nil
+}
+
+// getAngle returns the angle of an axis, clockwise from the top.
+func (rc RadarChart) getAngle(axis int) float64 {
+	return DefaultRadarStartAngle + _2pi*float64(axis)/float64(len(rc.Axes))
+}
+
+// pointAt returns the point at a distance along an axis.
+func (rc RadarChart) pointAt(cx, cy int, axis int, distance float64) (x, y int) {
+	angle := rc.getAngle(axis)
+	return cx + int(math.Round(distance*math.Cos(angle))), cy + int(math.Round(distance*math.Sin(angle)))
+}
+
+// getLayout splits the canvas into the plot, and the legend to the right of it if any series is named.
+func (rc RadarChart) getLayout(r Renderer, canvasBox Box) (plotBox, legendBox Box) {
+	plotBox = canvasBox
+	if rc.LegendStyle.Hidden {
+		return
+	}
+	textStyle := rc.styleDefaultsLegend()
+	var labelWidth int
+	for _, s := range rc.Series {
+		labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, s.Name, textStyle).Width())
+	}
+	if labelWidth == 0 {
+		return
+	}
+	legendWidth := DefaultRadarLegendGap + rc.getLegendSwatchSize() + DefaultRadarLegendGap + labelWidth
+	plotBox.Right -= legendWidth
+	legendBox = Box{
+		Top:    canvasBox.Top,
+		Left:   plotBox.Right + DefaultRadarLegendGap,
+		Right:  canvasBox.Right,
+		Bottom: canvasBox.Bottom,
+	}
+	return
+}
+
+// getRadius returns the radius of the plot, leaving room for the axis labels around it.
+func (rc RadarChart) getRadius(r Renderer, plotBox Box) float64 {
+	labelWidth, labelHeight := rc.getLabelExtent(r)
+	if rc.RotateLabels {
+		labelWidth, labelHeight = labelWidth+DefaultRadarLabelGap, labelWidth+DefaultRadarLabelGap
+	} else {
+		labelWidth, labelHeight = labelWidth+DefaultRadarLabelGap, labelHeight+DefaultRadarLabelGap
+	}
+	return math.Max(0, math.Min(
+		float64(plotBox.Width()>>1-labelWidth),
+		float64(plotBox.Height()>>1-labelHeight),
+	))
+}
+
+// getLabelExtent returns the largest width and height of the axis labels.
+func (rc RadarChart) getLabelExtent(r Renderer) (width, height int) {
+	if rc.LabelStyle.Hidden {
+		return
+	}
+	labelStyle := rc.styleDefaultsLabels()
+	for _, label := range rc.Axes {
+		tb := Draw.MeasureText(r, label, labelStyle)
+		width, height = MaxInt(width, tb.Width()), MaxInt(height, tb.Height())
+	}
+	return
+}
+
+// drawGrid draws the grid lines, as polygons or circles, and the spokes of the axes.
+func (rc RadarChart) drawGrid(r Renderer, cx, cy int, radius float64) {
+	if rc.GridStyle.Hidden {
+		return
+	}
+	rc.styleDefaultsGrid().GetStrokeOptions().WriteToRenderer(r)
+	lines := rc.GetGridLines()
+	for line := 1; line <= lines; line++ {
+		distance := radius * float64(line) / float64(lines)
+		if rc.CircularGrid {
+			// stroke each circle on its own, as an arc joins the end of the path before it, and draw
+			// it in halves, as svg cannot draw an arc that ends where it starts.
+			r.ArcTo(cx, cy, distance, distance, 0, _pi)
+			r.ArcTo(cx, cy, distance, distance, _pi, _pi)
+			r.Close()
+			r.Stroke()
+			continue
+		}
+		r.MoveTo(rc.pointAt(cx, cy, 0, distance))
+		for axis := 1; axis < len(rc.Axes); axis++ {
+			r.LineTo(rc.pointAt(cx, cy, axis, distance))
+		}
+		r.Close()
+	}
+	for axis := range rc.Axes {
+		r.MoveTo(cx, cy)
+		r.LineTo(rc.pointAt(cx, cy, axis, radius))
+	}
+	r.Stroke()
+}
+
+// drawSeries draws each series as a filled polygon, with the values clamped to the range.
+func (rc RadarChart) drawSeries(r Renderer, cx, cy int, radius float64) {
+	min, max := rc.GetRange()
+	for index, s := range rc.Series {
+		style := s.Style.InheritFrom(rc.styleDefaultsSeries(index))
+		style.GetFillAndStrokeOptions().WriteToRenderer(r)
+		for axis, value := range s.Values {
+			distance := radius * math.Max(0, math.Min(1, (value-min)/(max-min)))
+			x, y := rc.pointAt(cx, cy, axis, distance)
+			if axis == 0 {
+				r.MoveTo(x, y)
+			} else {
+				r.LineTo(x, y)
+			}
+		}
+		r.Close()
+		r.FillStroke()
+
+		if style.ShouldDrawDot() {
+			dotStyle := style.GetDotOptions()
+			for axis, value := range s.Values {
+				distance := radius * math.Max(0, math.Min(1, (value-min)/(max-min)))
+				x, y := rc.pointAt(cx, cy, axis, distance)
+				dotStyle.WriteToRenderer(r)
+				r.Circle(style.GetDotWidth(), x, y)
+				r.FillStroke()
+			}
+		}
+	}
+}
+
+// drawLabels draws the label of each axis beyond the end of its spoke, either upright and aligned
+// away from the center, or rotated along the spoke and kept upright.
+func (rc RadarChart) drawLabels(r Renderer, cx, cy int, radius float64) {
+	if rc.LabelStyle.Hidden {
+		return
+	}
+	labelStyle := rc.styleDefaultsLabels()
+	for axis, label := range rc.Axes {
+		if len(label) == 0 {
+			continue
+		}
+		angle := rc.getAngle(axis)
+		cos, sin := math.Cos(angle), math.Sin(angle)
+		tb := Draw.MeasureText(r, label, labelStyle)
+
+		if rc.RotateLabels {
+			rotation, distance := angle, radius+DefaultRadarLabelGap
+			if cos < -1e-9 {
+				// flip the labels on the left so they read left to right, starting from their far end.
+				rotation, distance = angle+math.Pi, distance+float64(tb.Width())
+			}
+			// move the baseline down by half the text height, perpendicular to the text.
+			half := float64(tb.Height()) / 2
+			x := float64(cx) + distance*cos - half*math.Sin(rotation)
+			y := float64(cy) + distance*sin + half*math.Cos(rotation)
+			style := labelStyle
+			style.TextRotationDegrees = RadiansToDegrees(rotation)
+			Draw.Text(r, label, int(math.Round(x)), int(math.Round(y)), style)
+			continue
+		}
+
+		x, y := rc.pointAt(cx, cy, axis, radius+DefaultRadarLabelGap)
+		if cos < -0.1 {
+			x -= tb.Width()
+		} else if cos <= 0.1 {
+			x -= tb.Width() >> 1
+		}
+		if sin > 0.1 {
+			y += tb.Height()
+		} else if sin >= -0.1 {
+			y += tb.Height() >> 1
+		}
+		Draw.Text(r, label, x, y, labelStyle)
+	}
+}
+
+// drawLegend draws a swatch and label for each named series, vertically centered beside the plot.
+func (rc RadarChart) drawLegend(r Renderer, legendBox Box) {
+	if rc.LegendStyle.Hidden || legendBox.IsZero() {
+		return
+	}
+
+	textStyle := rc.styleDefaultsLegend()
+	swatch := rc.getLegendSwatchSize()
+	lineHeight := swatch + DefaultLineSpacing
+	top := legendBox.Top + (legendBox.Height()-lineHeight*len(rc.Series))>>1
+
+	for index, s := range rc.Series {
+		y := top + index*lineHeight
+		style := s.Style.InheritFrom(rc.styleDefaultsSeries(index))
+		Draw.Box(r, Box{
+			Top:    y,
+			Left:   legendBox.Left,
+			Right:  legendBox.Left + swatch,
+			Bottom: y + swatch,
+		}, Style{
+			FillColor:   style.StrokeColor,
+			StrokeColor: style.StrokeColor,
+			StrokeWidth: DefaultStrokeWidth,
+		})
+		if len(s.Name) > 0 {
+			tb := Draw.MeasureText(r, s.Name, textStyle)
+			Draw.Text(r, s.Name, legendBox.Left+swatch+DefaultRadarLegendGap, y+(swatch+tb.Height())>>1, textStyle)
+		}
+	}
+}
+
+// getLegendSwatchSize returns the size of the legend swatches, the height of the legend text in pixels.
+func (rc RadarChart) getLegendSwatchSize() int {
+	return int(rc.styleDefaultsLegend().GetFontSize() * rc.GetDPI(DefaultDPI) / 72.0)
+}
+
+func (rc RadarChart) styleDefaultsLabels() Style {
+	return rc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: rc.GetColorPalette().TextColor(),
+		Font:      rc.GetFont(),
+	})
+}
+
+func (rc RadarChart) styleDefaultsGrid() Style {
+	return rc.GridStyle.InheritFrom(Style{
+		StrokeColor: rc.GetColorPalette().AxisStrokeColor().WithAlpha(96),
+		StrokeWidth: DefaultAxisLineWidth,
+	})
+}
+
+func (rc RadarChart) styleDefaultsSeries(index int) Style {
+	color := rc.GetColorPalette().GetSeriesColor(index)
+	return Style{
+		StrokeColor: color,
+		StrokeWidth: 2,
+		FillColor:   color.WithAlpha(64),
+	}
+}
+
+func (rc RadarChart) styleDefaultsLegend() Style {
+	return rc.LegendStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: rc.GetColorPalette().TextColor(),
+		Font:      rc.GetFont(),
+	})
+}
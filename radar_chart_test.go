@@ -0,0 +1,103 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestRadarChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, rotate := range []bool{false, true} {
+		rc := RadarChart{
+			ChartFrame: ChartFrame{
+				Title: "Test",
+			},
+			RotateLabels: rotate,
+			CircularGrid: rotate,
+			Axes:         []string{"a", "b", "c", "d", "e"},
+			Series: []RadarSeries{
+				{Name: "x", Values: []float64{1, 2, 3, 4, 5}},
+				{Name: "y", Values: []float64{5, 4, 3, 2, 1}, Style: Style{DotWidth: 3}},
+			},
+		}
+		for _, rp := range []RendererProvider{PNG, SVG} {
+			b := bytes.NewBuffer([]byte{})
+			testutil.AssertNil(t, rc.Render(rp, b))
+			testutil.AssertNotZero(t, b.Len())
+		}
+	}
+}
+
+func TestRadarChartValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	axes := []string{"a", "b", "c"}
+	testutil.AssertNotNil(t, RadarChart{Axes: axes[:2], Series: []RadarSeries{{Values: []float64{1, 2}}}}.validate())
+	testutil.AssertNotNil(t, RadarChart{Axes: axes}.validate())
+	testutil.AssertNotNil(t, RadarChart{Axes: axes, Series: []RadarSeries{{Values: []float64{1, 2}}}}.validate())
+	testutil.AssertNotNil(t, RadarChart{Axes: axes, Series: []RadarSeries{{Values: []float64{1, 2, 3}}}, Range: &ContinuousRange{Min: 1, Max: 1}}.validate())
+	testutil.AssertNil(t, RadarChart{Axes: axes, Series: []RadarSeries{{Values: []float64{1, 2, 3}}}}.validate())
+}
+
+func TestRadarChartGetRange(t *testing.T) {
+	// replaced new assertions helper
+
+	rc := RadarChart{
+		Series: []RadarSeries{
+			{Values: []float64{1, 5}},
+			{Values: []float64{3, 2}},
+		},
+	}
+	min, max := rc.GetRange()
+	testutil.AssertEqual(t, 0.0, min)
+	testutil.AssertEqual(t, 5.0, max)
+
+	rc.Series[1].Values[0] = -2
+	min, _ = rc.GetRange()
+	testutil.AssertEqual(t, -2.0, min)
+
+	rc.Range = &ContinuousRange{Min: 0, Max: 10}
+	min, max = rc.GetRange()
+	testutil.AssertEqual(t, 0.0, min)
+	testutil.AssertEqual(t, 10.0, max)
+
+	// all zero values fall back to a unit range.
+	rc = RadarChart{Series: []RadarSeries{{Values: []float64{0, 0, 0}}}}
+	min, max = rc.GetRange()
+	testutil.AssertEqual(t, 0.0, min)
+	testutil.AssertEqual(t, 1.0, max)
+}
+
+func TestRadarChartRenderZeroValues(t *testing.T) {
+	// replaced new assertions helper
+
+	rc := RadarChart{
+		Axes:   []string{"a", "b", "c"},
+		Series: []RadarSeries{{Name: "x", Values: []float64{0, 0, 0}}},
+	}
+	for _, rp := range []RendererProvider{PNG, SVG} {
+		b := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, rc.Render(rp, b))
+		testutil.AssertNotZero(t, b.Len())
+	}
+}
+
+func TestRadarChartPointAt(t *testing.T) {
+	// replaced new assertions helper
+
+	rc := RadarChart{Axes: []string{"a", "b", "c", "d"}}
+	testutil.AssertInDelta(t, DefaultRadarStartAngle, rc.getAngle(0), 1e-9)
+	testutil.AssertInDelta(t, DefaultRadarStartAngle+math.Pi/2, rc.getAngle(1), 1e-9)
+
+	// the first axis points up, and the axes go clockwise.
+	x, y := rc.pointAt(100, 100, 0, 10)
+	testutil.AssertEqual(t, 100, x)
+	testutil.AssertEqual(t, 90, y)
+	x, y = rc.pointAt(100, 100, 1, 10)
+	testutil.AssertEqual(t, 110, x)
+	testutil.AssertEqual(t, 100, y)
+}
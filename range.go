@@ -20,6 +20,19 @@ type Stringable interface {
 	String() string
 }
 
+// RangeCloner is a range that can return an independent copy of itself.
+type RangeCloner interface {
+	Clone() Range
+}
+
+// CloneRange returns a copy of a range if it implements `RangeCloner`, otherwise it returns the range itself.
+func CloneRange(r Range) Range {
+	if typed, isTyped := r.(RangeCloner); isTyped {
+		return typed.Clone()
+	}
+	return r
+}
+
 // Range is a common interface for a range of values.
 type Range interface {
 	Stringable
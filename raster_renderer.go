@@ -8,6 +8,7 @@ import (
 
 	"github.com/golang/freetype/truetype"
 	"github.com/wcharczuk/go-chart/v2/drawing"
+	xdraw "golang.org/x/image/draw"
 )
 
 // PNG returns a new png/raster renderer.
@@ -23,12 +24,54 @@ func PNG(width, height int) (Renderer, error) {
 	return nil, err
 }
 
+// imageRegion returns a renderer provider that draws into a region of an existing image. An
+// `*image.RGBA` is drawn into in place; any other image is drawn into a buffer the size of the
+// region, which saving draws over the region.
+func imageRegion(dst xdraw.Image, bounds image.Rectangle) RendererProvider {
+	return func(width, height int) (Renderer, error) {
+		if typed, isTyped := dst.(*image.RGBA); isTyped {
+			sub := typed.SubImage(bounds).(*image.RGBA)
+			// rebase the region to the origin, sharing its pixels, as the renderer draws from (0, 0).
+			i := &image.RGBA{Pix: sub.Pix, Stride: sub.Stride, Rect: image.Rect(0, 0, width, height)}
+			gc, err := drawing.NewRasterGraphicContext(i)
+			if err != nil {
+				return nil, err
+			}
+			return &imageRegionRenderer{rasterRenderer: &rasterRenderer{i: i, gc: gc}}, nil
+		}
+		r, err := PNG(width, height)
+		if err != nil {
+			return nil, err
+		}
+		return &imageRegionRenderer{rasterRenderer: r.(*rasterRenderer), dst: dst, bounds: bounds}, nil
+	}
+}
+
+// imageRegionRenderer is a raster renderer that saves to a region of an image rather than a writer.
+type imageRegionRenderer struct {
+	*rasterRenderer
+
+	// dst is the image to draw the buffer over, or nil if the renderer draws into it in place.
+	dst    xdraw.Image
+	bounds image.Rectangle
+}
+
+// Save implements the interface method, drawing the chart over the image region if it was buffered.
+func (irr *imageRegionRenderer) Save(_ io.Writer) error {
+	if irr.dst != nil {
+		xdraw.Draw(irr.dst, irr.bounds, irr.i, image.Point{}, xdraw.Over)
+	}
+	return nil
+}
+
 // rasterRenderer renders chart commands to a bitmap.
 type rasterRenderer struct {
 	i  *image.RGBA
 	gc *drawing.RasterGraphicContext
 
 	rotateRadians *float64
+	// clip, if set, is the rectangle drawing is restricted to.
+	clip *image.Rectangle
 
 	s Style
 }
@@ -86,6 +129,11 @@ func (rr *rasterRenderer) QuadCurveTo(cx, cy, x, y int) {
 	rr.gc.QuadCurveTo(float64(cx), float64(cy), float64(x), float64(y))
 }
 
+// CubicCurveTo implements CubicCurveRenderer.
+func (rr *rasterRenderer) CubicCurveTo(cx1, cy1, cx2, cy2, x, y int) {
+	rr.gc.CubicCurveTo(float64(cx1), float64(cy1), float64(cx2), float64(cy2), float64(x), float64(y))
+}
+
 // ArcTo implements the interface method.
 func (rr *rasterRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
 	rr.gc.ArcTo(float64(cx), float64(cy), rx, ry, startAngle, delta)
@@ -119,6 +167,28 @@ func (rr *rasterRenderer) FillStroke() {
 	rr.gc.FillStroke()
 }
 
+// DrawImage implements ImageRenderer.
+func (rr *rasterRenderer) DrawImage(img image.Image, box Box) {
+	dst := rr.i
+	if rr.clip != nil {
+		dst = rr.i.SubImage(*rr.clip).(*image.RGBA)
+	}
+	xdraw.ApproxBiLinear.Scale(dst, image.Rect(box.Left, box.Top, box.Right, box.Bottom), img, img.Bounds(), xdraw.Over, nil)
+}
+
+// SetClip implements ClipRenderer.
+func (rr *rasterRenderer) SetClip(box Box) {
+	clip := image.Rect(box.Left, box.Top, box.Right, box.Bottom)
+	rr.clip = &clip
+	rr.gc.SetClip(clip)
+}
+
+// ClearClip implements ClipRenderer.
+func (rr *rasterRenderer) ClearClip() {
+	rr.clip = nil
+	rr.gc.ClearClip()
+}
+
 // Circle fully draws a circle at a given point but does not apply the fill or stroke.
 func (rr *rasterRenderer) Circle(radius float64, x, y int) {
 	xf := float64(x)
@@ -0,0 +1,140 @@
+package chart
+
+import "fmt"
+
+// RenderStage is an enum for the stages of rendering a chart.
+type RenderStage int
+
+const (
+	// RenderStageUnset is the unset state for a render stage.
+	RenderStageUnset RenderStage = 0
+	// RenderStageValidate is the stage that checks the chart configuration; a series that fails its own
+	// validation is reported with `RenderStageSeries` and its index.
+	RenderStageValidate RenderStage = 1
+	// RenderStageRenderer is the stage that creates the renderer from the renderer provider.
+	RenderStageRenderer RenderStage = 2
+	// RenderStageFonts is the stage that loads fonts.
+	RenderStageFonts RenderStage = 3
+	// RenderStageRanges is the stage that computes the value ranges.
+	RenderStageRanges RenderStage = 4
+	// RenderStageLayout is the stage that measures and positions the canvas, axes and annotations.
+	RenderStageLayout RenderStage = 5
+	// RenderStageAxes is the stage that draws the axes.
+	RenderStageAxes RenderStage = 6
+	// RenderStageSeries is the stage that draws an individual series.
+	RenderStageSeries RenderStage = 7
+	// RenderStageElements is the stage that draws the extra chart elements (legends etc.).
+	RenderStageElements RenderStage = 8
+	// RenderStageEncode is the stage that writes the final output.
+	RenderStageEncode RenderStage = 9
+	// RenderStageRender is the whole render, the parent of the other stages when tracing spans.
+	RenderStageRender RenderStage = 10
+	// RenderStageBackground is the stage that draws the chart background, before the ranges are computed.
+	RenderStageBackground RenderStage = 11
+)
+
+// String returns a string representation of the stage.
+func (rs RenderStage) String() string {
+	switch rs {
+	case RenderStageValidate:
+		return "validate"
+	case RenderStageRenderer:
+		return "renderer"
+	case RenderStageFonts:
+		return "fonts"
+	case RenderStageBackground:
+		return "background"
+	case RenderStageRanges:
+		return "ranges"
+	case RenderStageLayout:
+		return "layout"
+	case RenderStageAxes:
+		return "axes"
+	case RenderStageSeries:
+		return "series"
+	case RenderStageElements:
+		return "elements"
+	case RenderStageEncode:
+		return "encode"
+	case RenderStageRender:
+		return "render"
+	}
+	return "unset"
+}
+
+// RenderError is an error returned from rendering a chart.
+// It records the stage that failed and, for series errors, which series failed.
+type RenderError struct {
+	Stage RenderStage
+
+	// SeriesIndex and SeriesName are only set when the stage is `RenderStageSeries`.
+	SeriesIndex int
+	SeriesName  string
+
+	Err error
+}
+
+// Error implements error.
+func (re *RenderError) Error() string {
+	if re.Stage == RenderStageSeries {
+		if re.SeriesName != "" {
+			return fmt.Sprintf("chart render; %s %d (%s): %v", re.Stage, re.SeriesIndex, re.SeriesName, re.Err)
+		}
+		return fmt.Sprintf("chart render; %s %d: %v", re.Stage, re.SeriesIndex, re.Err)
+	}
+	return fmt.Sprintf("chart render; %s: %v", re.Stage, re.Err)
+}
+
+// Unwrap returns the underlying cause of the error.
+func (re *RenderError) Unwrap() error {
+	return re.Err
+}
+
+// RecoverRender calls a render function, converting a panic raised while rendering into a `*RenderError`
+// rather than propagating it to the caller. It is the equivalent of `Chart.RecoverPanics` for the
+// other chart types, e.g.
+//
+//	err := chart.RecoverRender(func() error { return pie.Render(chart.PNG, w) })
+//
+// The stage of an error converted from a panic is unset, as it is not known.
+func RecoverRender(render func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = newRenderError(RenderStageUnset, panicError(r))
+		}
+	}()
+	return render()
+}
+
+// panicError returns the cause of an error converted from a recovered panic.
+func panicError(r interface{}) error {
+	cause, isError := r.(error)
+	if !isError {
+		cause = fmt.Errorf("%v", r)
+	}
+	return fmt.Errorf("panic: %w", cause)
+}
+
+// newRenderError returns a new render error for a given stage, or nil if the cause is nil.
+func newRenderError(stage RenderStage, err error) error {
+	if err == nil {
+		return nil
+	}
+	if typed, isTyped := err.(*RenderError); isTyped {
+		return typed
+	}
+	return &RenderError{Stage: stage, Err: err}
+}
+
+// newSeriesRenderError returns a new render error for a given series, or nil if the cause is nil.
+func newSeriesRenderError(index int, s Series, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &RenderError{
+		Stage:       RenderStageSeries,
+		SeriesIndex: index,
+		SeriesName:  s.GetName(),
+		Err:         err,
+	}
+}
@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"image"
 	"io"
 
 	"github.com/golang/freetype/truetype"
@@ -87,3 +88,32 @@ type Renderer interface {
 	// Save writes the image to the given writer.
 	Save(w io.Writer) error
 }
+
+// ImageRenderer is a renderer that can draw images, e.g. for icon markers.
+type ImageRenderer interface {
+	// DrawImage draws an image scaled to fill a given box.
+	DrawImage(img image.Image, box Box)
+}
+
+// SVGImageRenderer is a renderer that can embed svg documents as images.
+type SVGImageRenderer interface {
+	// DrawSVGImage draws an svg document scaled to fill a given box.
+	DrawSVGImage(svg []byte, box Box)
+}
+
+// CubicCurveRenderer is a renderer that can draw cubic bezier curves; `Draw.CubicCurveTo` flattens
+// curves into lines on renderers that cannot.
+type CubicCurveRenderer interface {
+	// CubicCurveTo draws a cubic bezier curve from the current point.
+	// (cx1,cy1) and (cx2,cy2) are the control points of the start and end of the curve.
+	CubicCurveTo(cx1, cy1, cx2, cy2, x, y int)
+}
+
+// ClipRenderer is a renderer that can restrict drawing to a box, e.g. to keep each chart of a
+// stack within its own part of the image.
+type ClipRenderer interface {
+	// SetClip restricts drawing to a box, replacing any box set before.
+	SetClip(box Box)
+	// ClearClip lifts the restriction set by `SetClip`.
+	ClearClip()
+}
@@ -0,0 +1,94 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// RegressionProvider is a fitted model, such as a regression series, that can predict a value for any x value.
+type RegressionProvider interface {
+	Predict(x float64) float64
+}
+
+// Residuals returns the difference between each of the values and the value predicted for it by the fit.
+func Residuals(fit RegressionProvider, values ValuesProvider) ContinuousSeries {
+	residuals := valuesToContinuousSeries(values)
+	residuals.Name = "Residuals"
+	for index, x := range residuals.XValues {
+		residuals.YValues[index] -= fit.Predict(x)
+	}
+	return residuals
+}
+
+// ResidualChartStack returns a stack of two charts with linked x axes for checking a fit: the values,
+// along with the fit if it is a series, above a scatter of the residuals and a line at zero.
+func ResidualChartStack(fit RegressionProvider, values ValuesProvider) (ChartStack, error) {
+	if fit == nil || values == nil {
+		return ChartStack{}, fmt.Errorf("residual chart stack requires a fit and values")
+	}
+	if values.Len() == 0 {
+		return ChartStack{}, fmt.Errorf("residual chart stack requires at least one value")
+	}
+
+	scatter := Style{
+		StrokeWidth: Disabled,
+		DotWidth:    3,
+	}
+
+	observed := valuesToContinuousSeries(values)
+	observed.Style = scatter
+	fitted := []Series{observed}
+	if series, isSeries := fit.(Series); isSeries {
+		fitted = append(fitted, series)
+	}
+
+	residuals := Residuals(fit, values)
+	residuals.Style = scatter
+
+	minx, maxx := math.MaxFloat64, -math.MaxFloat64
+	for _, x := range residuals.XValues {
+		minx, maxx = math.Min(minx, x), math.Max(maxx, x)
+	}
+	zero := ContinuousSeries{
+		Name: "Zero",
+		Style: Style{
+			StrokeColor:     DefaultAxisColor,
+			StrokeWidth:     1,
+			StrokeDashArray: []float64{5, 5},
+		},
+		XValues: []float64{minx, maxx},
+		YValues: []float64{0, 0},
+	}
+
+	return ChartStack{
+		Charts: []Chart{
+			{
+				XAxis:          HideXAxis(),
+				YAxisSecondary: YAxis{Style: Hidden()},
+				Series:         fitted,
+			},
+			{
+				YAxis:          YAxis{Name: residuals.Name},
+				YAxisSecondary: YAxis{Style: Hidden()},
+				Series:         []Series{residuals, zero},
+			},
+		},
+		Weights:   []float64{2, 1},
+		LinkXAxes: true,
+	}, nil
+}
+
+// valuesToContinuousSeries copies the values into a continuous series, keeping their value formatters.
+func valuesToContinuousSeries(values ValuesProvider) ContinuousSeries {
+	output := ContinuousSeries{
+		XValues: make([]float64, values.Len()),
+		YValues: make([]float64, values.Len()),
+	}
+	for index := range output.XValues {
+		output.XValues[index], output.YValues[index] = values.GetValues(index)
+	}
+	if vfp, isVfp := values.(ValueFormatterProvider); isVfp {
+		output.XValueFormatter, output.YValueFormatter = vfp.GetValueFormatters()
+	}
+	return output
+}
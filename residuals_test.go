@@ -0,0 +1,62 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestResiduals(t *testing.T) {
+	// replaced new assertions helper
+
+	// note: the regression series fit every value but the last.
+	data := ContinuousSeries{
+		XValues: []float64{1, 2, 3, 4},
+		YValues: []float64{3, 5, 7, 20},
+	}
+
+	fit := &PolynomialRegressionSeries{Degree: 1, InnerSeries: data}
+	testutil.AssertInDelta(t, 1.0, fit.Predict(0), 1e-6)
+	testutil.AssertInDelta(t, 11.0, fit.Predict(5), 1e-6)
+
+	residuals := Residuals(fit, data)
+	testutil.AssertEqual(t, data.XValues, residuals.XValues)
+	for index, expected := range []float64{0, 0, 0, 11} {
+		testutil.AssertInDelta(t, expected, residuals.YValues[index], 1e-6)
+	}
+
+	linear := &LinearRegressionSeries{InnerSeries: data}
+	testutil.AssertInDelta(t, 11.0, linear.Predict(5), 1e-6)
+	x, y := linear.GetValues(1)
+	testutil.AssertInDelta(t, y, linear.Predict(x), 1e-9)
+
+	// the original values are not modified.
+	testutil.AssertEqual(t, []float64{3, 5, 7, 20}, data.YValues)
+}
+
+func TestResidualChartStack(t *testing.T) {
+	// replaced new assertions helper
+
+	data := ContinuousSeries{
+		XValues: []float64{1, 2, 3, 4},
+		YValues: []float64{2, 5, 6, 9},
+	}
+	stack, err := ResidualChartStack(&LinearRegressionSeries{InnerSeries: data}, data)
+	testutil.AssertNil(t, err)
+	testutil.AssertLen(t, stack.Charts, 2)
+	testutil.AssertLen(t, stack.Charts[0].Series, 2)
+	testutil.AssertLen(t, stack.Charts[1].Series, 2)
+
+	zero := stack.Charts[1].Series[1].(ContinuousSeries)
+	testutil.AssertEqual(t, []float64{1, 4}, zero.XValues)
+	testutil.AssertEqual(t, []float64{0, 0}, zero.YValues)
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, stack.Render(PNG, buffer))
+
+	_, err = ResidualChartStack(nil, data)
+	testutil.AssertNotNil(t, err)
+	_, err = ResidualChartStack(&LinearRegressionSeries{}, ContinuousSeries{})
+	testutil.AssertNotNil(t, err)
+}
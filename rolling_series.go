@@ -0,0 +1,164 @@
+package chart
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*RollingSeries)(nil)
+	_ FirstValuesProvider = (*RollingSeries)(nil)
+	_ LastValuesProvider  = (*RollingSeries)(nil)
+)
+
+// RollingStatistic computes a statistic over the values in a rolling window.
+// The values are a copy of the window, so a statistic may reorder them.
+type RollingStatistic func(values ...float64) float64
+
+// RollingStdDev is the population standard deviation of the window.
+func RollingStdDev(values ...float64) float64 {
+	return Seq{Array(values)}.StdDev()
+}
+
+// RollingMin is the smallest value in the window.
+func RollingMin(values ...float64) float64 {
+	min, _ := MinMax(values...)
+	return min
+}
+
+// RollingMax is the largest value in the window.
+func RollingMax(values ...float64) float64 {
+	_, max := MinMax(values...)
+	return max
+}
+
+// RollingQuantile returns a statistic for the linearly interpolated quantile `q`
+// of the window, where `q` is on the interval [0, 1], e.g. 0.5 for the median.
+func RollingQuantile(q float64) RollingStatistic {
+	return func(values ...float64) float64 {
+		sort.Float64s(values)
+		return quantile(values, q)
+	}
+}
+
+// RollingSeries is a computed series of a statistic over a trailing window of the inner series,
+// such as a rolling standard deviation or median.
+type RollingSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Period int
+	// Statistic is computed over each window, it defaults to the mean.
+	Statistic   RollingStatistic
+	InnerSeries ValuesProvider
+
+	cache []float64
+}
+
+// GetName returns the name of the time series.
+func (rs RollingSeries) GetName() string {
+	return rs.Name
+}
+
+// GetStyle returns the line style.
+func (rs RollingSeries) GetStyle() Style {
+	return rs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (rs RollingSeries) GetYAxis() YAxisType {
+	return rs.YAxis
+}
+
+// GetPeriod returns the window size.
+func (rs RollingSeries) GetPeriod(defaults ...int) int {
+	if rs.Period == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultSimpleMovingAveragePeriod
+	}
+	return rs.Period
+}
+
+// GetStatistic returns the window statistic or a default.
+func (rs RollingSeries) GetStatistic() RollingStatistic {
+	if rs.Statistic == nil {
+		return Mean
+	}
+	return rs.Statistic
+}
+
+// Len returns the number of elements in the series.
+func (rs RollingSeries) Len() int {
+	return rs.InnerSeries.Len()
+}
+
+// GetValues gets a value at a given index.
+func (rs *RollingSeries) GetValues(index int) (x, y float64) {
+	if rs.InnerSeries == nil || rs.InnerSeries.Len() == 0 {
+		return
+	}
+	if len(rs.cache) == 0 {
+		rs.ensureCachedValues()
+	}
+	x, _ = rs.InnerSeries.GetValues(index)
+	y = rs.cache[index]
+	return
+}
+
+// GetFirstValues computes the first rolling value.
+func (rs *RollingSeries) GetFirstValues() (x, y float64) {
+	return rs.GetValues(0)
+}
+
+// GetLastValues computes the last rolling value.
+func (rs *RollingSeries) GetLastValues() (x, y float64) {
+	if rs.InnerSeries == nil || rs.InnerSeries.Len() == 0 {
+		return
+	}
+	return rs.GetValues(rs.InnerSeries.Len() - 1)
+}
+
+func (rs *RollingSeries) ensureCachedValues() {
+	period := rs.GetPeriod()
+	statistic := rs.GetStatistic()
+
+	seriesLength := rs.InnerSeries.Len()
+	rs.cache = make([]float64, seriesLength)
+	window := NewValueBufferWithCapacity(period)
+	for index := 0; index < seriesLength; index++ {
+		_, y := rs.InnerSeries.GetValues(index)
+		if window.Len() >= period {
+			window.Dequeue()
+		}
+		window.Enqueue(y)
+		rs.cache[index] = statistic(window.Array()...)
+	}
+}
+
+// Render renders the series.
+func (rs *RollingSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := rs.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, rs)
+}
+
+// Validate validates the series.
+func (rs *RollingSeries) Validate() error {
+	if rs.InnerSeries == nil {
+		return fmt.Errorf("rolling series requires InnerSeries to be set")
+	}
+	if rs.Period < 0 {
+		return fmt.Errorf("rolling series requires Period to be positive")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (rs RollingSeries) CopySeries() Series {
+	rs.InnerSeries = copyValuesProvider(rs.InnerSeries)
+	rs.cache = nil
+	return &rs
+}
@@ -0,0 +1,62 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestRollingSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	inner := ContinuousSeries{
+		XValues: []float64{1, 2, 3, 4, 5, 6},
+		YValues: []float64{4, 8, 2, 6, 10, 0},
+	}
+
+	mean := &RollingSeries{Period: 3, InnerSeries: inner}
+	testutil.AssertNil(t, mean.Validate())
+	_, y := mean.GetFirstValues()
+	testutil.AssertEqual(t, 4.0, y)
+	_, y = mean.GetValues(1)
+	testutil.AssertEqual(t, 6.0, y)
+	x, y := mean.GetLastValues()
+	testutil.AssertEqual(t, 6.0, x)
+	testutil.AssertInDelta(t, 16.0/3.0, y, 1e-9)
+
+	min := &RollingSeries{Period: 3, Statistic: RollingMin, InnerSeries: inner}
+	max := &RollingSeries{Period: 3, Statistic: RollingMax, InnerSeries: inner}
+	median := &RollingSeries{Period: 3, Statistic: RollingQuantile(0.5), InnerSeries: inner}
+	stddev := &RollingSeries{Period: 2, Statistic: RollingStdDev, InnerSeries: inner}
+
+	expected := [][4]float64{
+		{4, 4, 4, 0},
+		{4, 8, 6, 2},
+		{2, 8, 4, 3},
+		{2, 8, 6, 2},
+		{2, 10, 6, 2},
+		{0, 10, 6, 5},
+	}
+	for index, values := range expected {
+		_, vmin := min.GetValues(index)
+		_, vmax := max.GetValues(index)
+		_, vmedian := median.GetValues(index)
+		_, vstddev := stddev.GetValues(index)
+		testutil.AssertEqual(t, values[0], vmin)
+		testutil.AssertEqual(t, values[1], vmax)
+		testutil.AssertEqual(t, values[2], vmedian)
+		testutil.AssertInDelta(t, values[3], vstddev, 1e-9)
+	}
+
+	// the window passed to a statistic is a copy, sorting it leaves the series alone.
+	testutil.AssertEqual(t, []float64{4, 8, 2, 6, 10, 0}, inner.YValues)
+	testutil.AssertNotNil(t, (&RollingSeries{}).Validate())
+}
+
+func TestRollingQuantile(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertEqual(t, 1.0, RollingQuantile(0)(3, 1, 2))
+	testutil.AssertEqual(t, 3.0, RollingQuantile(1)(3, 1, 2))
+	testutil.AssertEqual(t, 2.5, RollingQuantile(0.75)(3, 1, 2))
+}
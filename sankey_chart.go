@@ -0,0 +1,300 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// SankeyFlow is a weighted flow from one node of a sankey chart to another.
+type SankeyFlow struct {
+	Source string
+	Target string
+	Value  float64
+	Style  Style
+}
+
+// SankeyNode is a node of a sankey chart, as laid out from its flows.
+type SankeyNode struct {
+	Name string
+	// Column is the column of the node, the length of the longest path of flows to it.
+	Column int
+	// Value is the larger of the total of the flows into and out of the node.
+	Value float64
+	Box   Box
+}
+
+// SankeyChart is a chart that draws weighted flows between nodes as ribbons, each as thick as its value,
+// with the nodes laid out in columns from left to right by how many flows lead to them.
+// The flows must not form a cycle.
+type SankeyChart struct {
+	ChartFrame
+
+	NodeStyle  Style
+	FlowStyle  Style
+	LabelStyle Style
+
+	// NodeWidth is the pixel width of the nodes, and NodePadding the pixel spacing between the nodes of a column.
+	NodeWidth   int
+	NodePadding int
+
+	// Flows are the flows of the chart; the nodes are named by them, in order of first appearance.
+	Flows    []SankeyFlow
+	Elements []Renderable
+}
+
+// GetNodeWidth returns the node width or the default value.
+func (sc SankeyChart) GetNodeWidth() int {
+	if sc.NodeWidth == 0 {
+		return DefaultSankeyNodeWidth
+	}
+	return sc.NodeWidth
+}
+
+// GetNodePadding returns the spacing between nodes or the default value.
+func (sc SankeyChart) GetNodePadding() int {
+	if sc.NodePadding == 0 {
+		return DefaultSankeyNodePadding
+	}
+	return sc.NodePadding
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (sc SankeyChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(sc.Flows) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one flow"))
+	}
+	if err := sc.validateFlows(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := sc.GetWidth(), sc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if sc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		sc.defaultFont = defaultFont
+	}
+	r.SetDPI(sc.GetDPI(DefaultDPI))
+
+	canvasBox := sc.getDefaultCanvasBox(r, width, height)
+	nodes := sc.GetNodes(canvasBox)
+
+	sc.drawBackground(r, width, height)
+	sc.drawCanvas(r, canvasBox)
+	sc.drawFlows(r, nodes)
+	sc.drawNodes(r, nodes)
+	sc.drawLabels(r, canvasBox, nodes)
+	sc.drawTitle(r, width, height)
+	for _, a := range sc.Elements {
+		a(r, canvasBox, sc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (sc SankeyChart) validateFlows() error {
+	for _, f := range sc.Flows {
+		if f.Value < 0 {
+			return fmt.Errorf("sankey chart flow values cannot be negative")
+		}
+		if f.Source == f.Target {
+			return fmt.Errorf("sankey chart flow cannot be from a node to itself: %s", f.Source)
+		}
+	}
+	if _, err := sc.getColumns(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getNodeNames returns the names of the nodes in order of first appearance in the flows.
+func (sc SankeyChart) getNodeNames() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, f := range sc.Flows {
+		for _, name := range []string{f.Source, f.Target} {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// getColumns returns the column of each node, the length of the longest path of flows to it,
+// or an error if the flows form a cycle.
+func (sc SankeyChart) getColumns() (map[string]int, error) {
+	columns := map[string]int{}
+	for _, name := range sc.getNodeNames() {
+		columns[name] = 0
+	}
+	// relax the columns along every flow; a longest path has at most one flow per node,
+	// so a flow that can still be relaxed after that many passes is part of a cycle.
+	for pass := 0; pass <= len(columns); pass++ {
+		var changed bool
+		for _, f := range sc.Flows {
+			if columns[f.Target] < columns[f.Source]+1 {
+				columns[f.Target] = columns[f.Source] + 1
+				changed = true
+			}
+		}
+		if !changed {
+			return columns, nil
+		}
+	}
+	return nil, fmt.Errorf("sankey chart flows cannot form a cycle")
+}
+
+// GetNodes returns the nodes of the chart laid out within a canvas box, in order of first appearance
+// in the flows. Each column is stacked from the top, with the node heights on the same scale for every column.
+func (sc SankeyChart) GetNodes(canvasBox Box) []SankeyNode {
+	columns, err := sc.getColumns()
+	if err != nil {
+		return nil
+	}
+	inflows, outflows := map[string]float64{}, map[string]float64{}
+	for _, f := range sc.Flows {
+		outflows[f.Source] += f.Value
+		inflows[f.Target] += f.Value
+	}
+
+	names := sc.getNodeNames()
+	nodes := make([]SankeyNode, len(names))
+	var columnCount int
+	columnTotals, columnSizes := map[int]float64{}, map[int]int{}
+	for index, name := range names {
+		nodes[index] = SankeyNode{
+			Name:   name,
+			Column: columns[name],
+			Value:  math.Max(inflows[name], outflows[name]),
+		}
+		columnTotals[nodes[index].Column] += nodes[index].Value
+		columnSizes[nodes[index].Column]++
+		columnCount = MaxInt(columnCount, nodes[index].Column+1)
+	}
+
+	nodeWidth, padding := sc.GetNodeWidth(), sc.GetNodePadding()
+	scale := math.Inf(1)
+	for column, total := range columnTotals {
+		if total > 0 {
+			scale = math.Min(scale, float64(canvasBox.Height()-(columnSizes[column]-1)*padding)/total)
+		}
+	}
+	if math.IsInf(scale, 1) {
+		scale = 0
+	}
+
+	columnStep := 0.0
+	if columnCount > 1 {
+		columnStep = float64(canvasBox.Width()-nodeWidth) / float64(columnCount-1)
+	}
+	tops := map[int]float64{}
+	for index := range nodes {
+		column := nodes[index].Column
+		left := canvasBox.Left + int(columnStep*float64(column))
+		top := float64(canvasBox.Top) + tops[column]
+		height := nodes[index].Value * scale
+		nodes[index].Box = Box{
+			Top:    int(top),
+			Left:   left,
+			Right:  left + nodeWidth,
+			Bottom: int(top + height),
+		}
+		tops[column] += height + float64(padding)
+	}
+	return nodes
+}
+
+// drawFlows draws each flow as a ribbon from the right of its source node to the left of its target node,
+// with the flows out of and into each node stacked in order from its top.
+func (sc SankeyChart) drawFlows(r Renderer, nodes []SankeyNode) {
+	indexes := map[string]int{}
+	for index, node := range nodes {
+		indexes[node.Name] = index
+	}
+	outOffsets, inOffsets := make([]float64, len(nodes)), make([]float64, len(nodes))
+	for _, f := range sc.Flows {
+		source, target := indexes[f.Source], indexes[f.Target]
+		sourceBox, targetBox := nodes[source].Box, nodes[target].Box
+
+		var thickness float64
+		if nodes[source].Value > 0 {
+			thickness = f.Value / nodes[source].Value * float64(sourceBox.Height())
+		}
+		sy0 := float64(sourceBox.Top) + outOffsets[source]
+		ty0 := float64(targetBox.Top) + inOffsets[target]
+		outOffsets[source] += thickness
+		inOffsets[target] += thickness
+		if thickness == 0 {
+			continue
+		}
+
+		sx, tx := sourceBox.Right, targetBox.Left
+		mx := (sx + tx) >> 1
+		top0, top1 := int(sy0), int(ty0)
+		bottom0, bottom1 := int(sy0+thickness), int(ty0+thickness)
+
+		f.Style.InheritFrom(sc.styleDefaultsFlow(source)).WriteToRenderer(r)
+		r.MoveTo(sx, top0)
+		Draw.CubicCurveTo(r, sx, top0, mx, top0, mx, top1, tx, top1)
+		r.LineTo(tx, bottom1)
+		Draw.CubicCurveTo(r, tx, bottom1, mx, bottom1, mx, bottom0, sx, bottom0)
+		r.Close()
+		r.Fill()
+	}
+}
+
+func (sc SankeyChart) drawNodes(r Renderer, nodes []SankeyNode) {
+	for index, node := range nodes {
+		Draw.Box(r, node.Box, sc.styleDefaultsNode(index))
+	}
+}
+
+// drawLabels draws the name of each node beside it, to its right, or to its left for the nodes at the
+// right of the canvas.
+func (sc SankeyChart) drawLabels(r Renderer, canvasBox Box, nodes []SankeyNode) {
+	if sc.LabelStyle.Hidden {
+		return
+	}
+	style := sc.styleDefaultsLabels()
+	for _, node := range nodes {
+		tb := Draw.MeasureText(r, node.Name, style)
+		y := node.Box.Top + (node.Box.Height()+tb.Height())>>1
+		if node.Box.Right+DefaultSankeyLabelGap+tb.Width() > canvasBox.Right {
+			Draw.Text(r, node.Name, node.Box.Left-DefaultSankeyLabelGap-tb.Width(), y, style)
+			continue
+		}
+		Draw.Text(r, node.Name, node.Box.Right+DefaultSankeyLabelGap, y, style)
+	}
+}
+
+func (sc SankeyChart) styleDefaultsNode(index int) Style {
+	return sc.NodeStyle.InheritFrom(Style{
+		StrokeColor: sc.GetColorPalette().GetSeriesColor(index),
+		StrokeWidth: DefaultStrokeWidth,
+		FillColor:   sc.GetColorPalette().GetSeriesColor(index),
+	})
+}
+
+func (sc SankeyChart) styleDefaultsFlow(sourceIndex int) Style {
+	return sc.FlowStyle.InheritFrom(Style{
+		FillColor: sc.GetColorPalette().GetSeriesColor(sourceIndex).WithAlpha(DefaultSankeyFlowAlpha),
+	})
+}
+
+func (sc SankeyChart) styleDefaultsLabels() Style {
+	return sc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: sc.GetColorPalette().TextColor(),
+		Font:      sc.GetFont(),
+	})
+}
@@ -0,0 +1,75 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestSankeyChartNodes(t *testing.T) {
+	// replaced new assertions helper
+
+	sc := SankeyChart{
+		NodePadding: 10,
+		Flows: []SankeyFlow{
+			{Source: "a", Target: "c", Value: 30},
+			{Source: "b", Target: "c", Value: 10},
+			{Source: "c", Target: "d", Value: 20},
+			{Source: "a", Target: "d", Value: 10},
+		},
+	}
+	nodes := sc.GetNodes(Box{Top: 0, Left: 0, Right: 216, Bottom: 110})
+	testutil.AssertLen(t, nodes, 4)
+
+	testutil.AssertEqual(t, "a", nodes[0].Name)
+	testutil.AssertEqual(t, 0, nodes[0].Column)
+	testutil.AssertEqual(t, 40.0, nodes[0].Value)
+	testutil.AssertEqual(t, "c", nodes[1].Name)
+	testutil.AssertEqual(t, 1, nodes[1].Column)
+	testutil.AssertEqual(t, 40.0, nodes[1].Value)
+	testutil.AssertEqual(t, 0, nodes[2].Column)
+	testutil.AssertEqual(t, 2, nodes[3].Column)
+	testutil.AssertEqual(t, 30.0, nodes[3].Value)
+
+	// the first column holds the most value, and fills the height less its padding.
+	testutil.AssertEqual(t, Box{Top: 0, Left: 0, Right: 16, Bottom: 80}, nodes[0].Box)
+	testutil.AssertEqual(t, Box{Top: 0, Left: 100, Right: 116, Bottom: 80}, nodes[1].Box)
+	testutil.AssertEqual(t, Box{Top: 90, Left: 0, Right: 16, Bottom: 110}, nodes[2].Box)
+	testutil.AssertEqual(t, Box{Top: 0, Left: 200, Right: 216, Bottom: 60}, nodes[3].Box)
+}
+
+func TestSankeyChartValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, SankeyChart{}.Render(PNG, bytes.NewBuffer(nil)))
+	testutil.AssertNotNil(t, SankeyChart{Flows: []SankeyFlow{{Source: "a", Target: "b", Value: -1}}}.validateFlows())
+	testutil.AssertNotNil(t, SankeyChart{Flows: []SankeyFlow{{Source: "a", Target: "a", Value: 1}}}.validateFlows())
+	testutil.AssertNotNil(t, SankeyChart{Flows: []SankeyFlow{
+		{Source: "a", Target: "b", Value: 1},
+		{Source: "b", Target: "c", Value: 1},
+		{Source: "c", Target: "a", Value: 1},
+	}}.validateFlows())
+}
+
+func TestSankeyChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	sc := SankeyChart{
+		ChartFrame: ChartFrame{
+			Title: "Test",
+		},
+		Flows: []SankeyFlow{
+			{Source: "a", Target: "b", Value: 3},
+			{Source: "a", Target: "c", Value: 1},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, sc.Render(SVG, buffer))
+	testutil.AssertEqual(t, 4, strings.Count(buffer.String(), "\nC "))
+	testutil.AssertContains(t, buffer.String(), ">c</text>")
+
+	buffer.Reset()
+	testutil.AssertNil(t, sc.Render(PNG, buffer))
+}
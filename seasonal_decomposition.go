@@ -0,0 +1,172 @@
+package chart
+
+import "fmt"
+
+const (
+	// DefaultSeasonalDecompositionIterations is the default number of times the trend
+	// and seasonal components are refined.
+	DefaultSeasonalDecompositionIterations = 2
+)
+
+// SeasonalDecomposition splits a series into trend, seasonal and residual components, in the style of STL.
+//
+// The components are refined by alternately averaging the detrended values at each point in the season,
+// and smoothing the deseasonalized values with a centered moving average.
+type SeasonalDecomposition struct {
+	// Period is the number of values in a season, e.g. 7 for daily values with a weekly pattern.
+	Period int
+	// TrendPeriod is the window of the trend moving average, it defaults to the season period.
+	TrendPeriod int
+	Iterations  int
+
+	InnerSeries ValuesProvider
+}
+
+// GetTrendPeriod returns the window of the trend moving average, which is always odd so the average is centered.
+func (sd SeasonalDecomposition) GetTrendPeriod() int {
+	period := sd.TrendPeriod
+	if period == 0 {
+		period = sd.Period
+	}
+	if period%2 == 0 {
+		return period + 1
+	}
+	return period
+}
+
+// GetIterations returns the number of refinement iterations or a default.
+func (sd SeasonalDecomposition) GetIterations() int {
+	if sd.Iterations == 0 {
+		return DefaultSeasonalDecompositionIterations
+	}
+	return sd.Iterations
+}
+
+// Validate validates the decomposition.
+func (sd SeasonalDecomposition) Validate() error {
+	if sd.InnerSeries == nil {
+		return fmt.Errorf("seasonal decomposition requires InnerSeries to be set")
+	}
+	if sd.Period < 2 {
+		return fmt.Errorf("seasonal decomposition requires a Period of at least 2")
+	}
+	if sd.InnerSeries.Len() < 2*sd.Period {
+		return fmt.Errorf("seasonal decomposition requires at least two periods of values")
+	}
+	return nil
+}
+
+// Decompose returns the trend, seasonal and residual components of the inner series as series
+// that share its x values and value formatters.
+func (sd SeasonalDecomposition) Decompose() (trend, seasonal, residual ContinuousSeries, err error) {
+	if err = sd.Validate(); err != nil {
+		return
+	}
+
+	length := sd.InnerSeries.Len()
+	xvalues := make([]float64, length)
+	values := make([]float64, length)
+	for index := 0; index < length; index++ {
+		xvalues[index], values[index] = sd.InnerSeries.GetValues(index)
+	}
+
+	trendValues := make([]float64, length)
+	seasonalValues := make([]float64, length)
+	adjusted := make([]float64, length)
+	for iteration := 0; iteration < sd.GetIterations(); iteration++ {
+		for index := range values {
+			adjusted[index] = values[index] - trendValues[index]
+		}
+		sd.averageSeason(adjusted, seasonalValues)
+
+		for index := range values {
+			adjusted[index] = values[index] - seasonalValues[index]
+		}
+		sd.smoothTrend(adjusted, trendValues)
+	}
+
+	residualValues := make([]float64, length)
+	for index := range values {
+		residualValues[index] = values[index] - trendValues[index] - seasonalValues[index]
+	}
+
+	var xf, yf ValueFormatter
+	if vfp, isVfp := sd.InnerSeries.(ValueFormatterProvider); isVfp {
+		xf, yf = vfp.GetValueFormatters()
+	}
+	component := func(name string, yvalues []float64) ContinuousSeries {
+		return ContinuousSeries{
+			Name:            name,
+			XValueFormatter: xf,
+			YValueFormatter: yf,
+			XValues:         xvalues,
+			YValues:         yvalues,
+		}
+	}
+	trend = component("Trend", trendValues)
+	seasonal = component("Seasonal", seasonalValues)
+	residual = component("Residual", residualValues)
+	return
+}
+
+// ChartStack returns the inner series and its components as a stack of charts with linked x axes.
+func (sd SeasonalDecomposition) ChartStack() (ChartStack, error) {
+	trend, seasonal, residual, err := sd.Decompose()
+	if err != nil {
+		return ChartStack{}, err
+	}
+
+	observed := valuesToContinuousSeries(sd.InnerSeries)
+	observed.Name = "Observed"
+
+	components := []ContinuousSeries{observed, trend, seasonal, residual}
+	charts := make([]Chart, len(components))
+	for index, component := range components {
+		charts[index] = Chart{
+			Background: Style{
+				Padding: Box{Top: 10, Left: 20, Right: 20, Bottom: 10},
+			},
+			YAxis: YAxis{
+				Name: component.Name,
+			},
+			YAxisSecondary: YAxis{
+				Style: Hidden(),
+			},
+			Series: []Series{component},
+		}
+		if index < len(components)-1 {
+			charts[index].XAxis = HideXAxis()
+		}
+	}
+	return ChartStack{
+		Charts:    charts,
+		LinkXAxes: true,
+	}, nil
+}
+
+// averageSeason sets each seasonal value to the mean of the values at the same point in the season,
+// centered so that the season sums to zero.
+func (sd SeasonalDecomposition) averageSeason(values, seasonal []float64) {
+	means := make([]float64, sd.Period)
+	for phase := range means {
+		var sum float64
+		var count int
+		for index := phase; index < len(values); index += sd.Period {
+			sum += values[index]
+			count++
+		}
+		means[phase] = sum / float64(count)
+	}
+	level := Mean(means...)
+	for index := range seasonal {
+		seasonal[index] = means[index%sd.Period] - level
+	}
+}
+
+// smoothTrend sets the trend to the centered moving average of the values, shrinking the window at the edges.
+func (sd SeasonalDecomposition) smoothTrend(values, trend []float64) {
+	half := sd.GetTrendPeriod() / 2
+	for index := range trend {
+		trend[index] = Mean(values[MaxInt(0, index-half):MinInt(len(values), index+half+1)]...)
+	}
+}
@@ -0,0 +1,60 @@
+package chart
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestSeasonalDecomposition(t *testing.T) {
+	// replaced new assertions helper
+
+	pattern := []float64{3, -1, -2, 0}
+	inner := ContinuousSeries{}
+	for index := 0; index < 40; index++ {
+		inner.XValues = append(inner.XValues, float64(index))
+		inner.YValues = append(inner.YValues, 10+pattern[index%len(pattern)])
+	}
+
+	trend, seasonal, residual, err := SeasonalDecomposition{Period: 4, InnerSeries: inner}.Decompose()
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, 40, trend.Len())
+	testutil.AssertEqual(t, "Seasonal", seasonal.GetName())
+
+	for index := range inner.YValues {
+		testutil.AssertInDelta(t, inner.YValues[index], trend.YValues[index]+seasonal.YValues[index]+residual.YValues[index], 1e-9)
+		testutil.AssertInDelta(t, seasonal.YValues[index], seasonal.YValues[(index+4)%40], 1e-9)
+	}
+	testutil.AssertInDelta(t, 0, Mean(seasonal.YValues[:4]...), 1e-9)
+	testutil.AssertInDelta(t, 3.0, seasonal.YValues[0], 0.5)
+	testutil.AssertInDelta(t, -2.0, seasonal.YValues[2], 0.5)
+}
+
+func TestSeasonalDecompositionValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, SeasonalDecomposition{Period: 4}.Validate())
+	short := ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}}
+	testutil.AssertNotNil(t, SeasonalDecomposition{Period: 1, InnerSeries: short}.Validate())
+	testutil.AssertNotNil(t, SeasonalDecomposition{Period: 2, InnerSeries: short}.Validate())
+
+	_, err := SeasonalDecomposition{Period: 2, InnerSeries: short}.ChartStack()
+	testutil.AssertNotNil(t, err)
+}
+
+func TestSeasonalDecompositionChartStack(t *testing.T) {
+	// replaced new assertions helper
+
+	inner := ContinuousSeries{}
+	for index := 0; index < 60; index++ {
+		inner.XValues = append(inner.XValues, float64(index))
+		inner.YValues = append(inner.YValues, float64(index)+10*math.Sin(float64(index)))
+	}
+	stack, err := SeasonalDecomposition{Period: 6, InnerSeries: inner}.ChartStack()
+	testutil.AssertNil(t, err)
+	testutil.AssertLen(t, stack.Charts, 4)
+	testutil.AssertTrue(t, stack.LinkXAxes)
+	testutil.AssertTrue(t, stack.Charts[0].XAxis.Style.Hidden)
+	testutil.AssertFalse(t, stack.Charts[3].XAxis.Style.Hidden)
+}
@@ -179,10 +179,10 @@ func (s Seq) Median() (median float64) {
 	sorted := s.Sort()
 	if l%2 == 0 {
 		v0 := sorted.GetValue(l/2 - 1)
-		v1 := sorted.GetValue(l/2 + 1)
+		v1 := sorted.GetValue(l / 2)
 		median = (v0 + v1) / 2
 	} else {
-		median = float64(sorted.GetValue(l << 1))
+		median = float64(sorted.GetValue(l >> 1))
 	}
 
 	return
@@ -75,6 +75,16 @@ func TestSeqAverage(t *testing.T) {
 	testutil.AssertEqual(t, 3, valuesOdd.Average())
 }
 
+func TestSeqMedian(t *testing.T) {
+	// replaced new assertions helper
+
+	values := Seq{NewArray(4, 1, 3, 2)}
+	testutil.AssertEqual(t, 2.5, values.Median())
+
+	valuesOdd := Seq{NewArray(5, 1, 4, 2, 3)}
+	testutil.AssertEqual(t, 3, valuesOdd.Median())
+}
+
 func TestSequenceVariance(t *testing.T) {
 	// replaced new assertions helper
 
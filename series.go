@@ -8,3 +8,10 @@ type Series interface {
 	Validate() error
 	Render(r Renderer, canvasBox Box, xrange, yrange Range, s Style)
 }
+
+// MeasuredSeries is a series that draws outside of the points it plots, e.g. labels,
+// and reports the bounds it needs so the canvas can be shrunk to fit it.
+type MeasuredSeries interface {
+	Series
+	Measure(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) Box
+}
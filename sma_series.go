@@ -118,3 +118,9 @@ func (sma SMASeries) Validate() error {
 	}
 	return nil
 }
+
+// CopySeries returns a copy of the series whose inner series does not share its values with the original.
+func (sma SMASeries) CopySeries() Series {
+	sma.InnerSeries = copyValuesProvider(sma.InnerSeries)
+	return sma
+}
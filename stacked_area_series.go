@@ -0,0 +1,113 @@
+package chart
+
+import "fmt"
+
+// Interface Assertions.
+var (
+	_ Series                = (*StackedAreaSeries)(nil)
+	_ BoundedValuesProvider = (*StackedAreaSeries)(nil)
+)
+
+// StackAreas returns a stacked area series for each of the series, stacked in order from the bottom up,
+// so the top of each area is the running sum of the series up to and including it. The stacked series
+// keep the name, style and y axis of series that provide them.
+//
+// The series are stacked value by value, so they should share the same x values.
+func StackAreas(series ...ValuesProvider) []Series {
+	output := make([]Series, len(series))
+	for index, s := range series {
+		stacked := StackedAreaSeries{
+			InnerSeries: s,
+			Below:       series[:index],
+		}
+		if typed, isTyped := s.(NameProvider); isTyped {
+			stacked.Name = typed.GetName()
+		}
+		if typed, isTyped := s.(StyleProvider); isTyped {
+			stacked.Style = typed.GetStyle()
+		}
+		if typed, isTyped := s.(Series); isTyped {
+			stacked.YAxis = typed.GetYAxis()
+		}
+		output[index] = stacked
+	}
+	return output
+}
+
+// StackedAreaSeries is a series drawn as a filled area stacked on top of the series below it.
+type StackedAreaSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries ValuesProvider
+	// Below are the series stacked beneath the inner series.
+	Below []ValuesProvider
+}
+
+// GetName returns the name of the time series.
+func (sas StackedAreaSeries) GetName() string {
+	return sas.Name
+}
+
+// GetStyle returns the line style.
+func (sas StackedAreaSeries) GetStyle() Style {
+	return sas.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (sas StackedAreaSeries) GetYAxis() YAxisType {
+	return sas.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (sas StackedAreaSeries) Len() int {
+	return sas.InnerSeries.Len()
+}
+
+// GetBoundedValues returns the top and bottom of the area at a given index.
+func (sas StackedAreaSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	for _, below := range sas.Below {
+		_, vy := below.GetValues(index)
+		y2 += vy
+	}
+	x, vy := sas.InnerSeries.GetValues(index)
+	y1 = y2 + vy
+	return
+}
+
+// Render renders the series.
+func (sas StackedAreaSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := sas.Style.InheritFrom(defaults)
+	if style.FillColor.IsZero() {
+		style.FillColor = style.GetStrokeColor().WithAlpha(160)
+	}
+	Draw.BoundedSeries(r, canvasBox, xrange, yrange, style, sas)
+}
+
+// Validate validates the series.
+func (sas StackedAreaSeries) Validate() error {
+	if sas.InnerSeries == nil {
+		return fmt.Errorf("stacked area series requires InnerSeries to be set")
+	}
+	for _, below := range sas.Below {
+		if below.Len() != sas.InnerSeries.Len() {
+			return fmt.Errorf("stacked area series must have the same number of values as the series below it")
+		}
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series whose inner series and the series below it do not share their
+// values with the original.
+func (sas StackedAreaSeries) CopySeries() Series {
+	sas.InnerSeries = copyValuesProvider(sas.InnerSeries)
+	if sas.Below != nil {
+		below := make([]ValuesProvider, len(sas.Below))
+		for index, vp := range sas.Below {
+			below[index] = copyValuesProvider(vp)
+		}
+		sas.Below = below
+	}
+	return sas
+}
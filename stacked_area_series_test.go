@@ -0,0 +1,79 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestStackAreas(t *testing.T) {
+	// replaced new assertions helper
+
+	xs := []float64{1, 2, 3}
+	stacked := StackAreas(
+		ContinuousSeries{Name: "a", XValues: xs, YValues: []float64{1, 2, 3}},
+		ContinuousSeries{Name: "b", XValues: xs, YValues: []float64{4, 5, 6}, YAxis: YAxisSecondary},
+	)
+	testutil.AssertLen(t, stacked, 2)
+
+	bottom := stacked[0].(StackedAreaSeries)
+	testutil.AssertEqual(t, "a", bottom.GetName())
+	testutil.AssertEqual(t, 3, bottom.Len())
+	x, y1, y2 := bottom.GetBoundedValues(1)
+	testutil.AssertEqual(t, 2.0, x)
+	testutil.AssertEqual(t, 2.0, y1)
+	testutil.AssertEqual(t, 0.0, y2)
+
+	top := stacked[1].(StackedAreaSeries)
+	testutil.AssertEqual(t, "b", top.GetName())
+	testutil.AssertEqual(t, YAxisSecondary, top.GetYAxis())
+	x, y1, y2 = top.GetBoundedValues(2)
+	testutil.AssertEqual(t, 3.0, x)
+	testutil.AssertEqual(t, 9.0, y1)
+	testutil.AssertEqual(t, 3.0, y2)
+}
+
+func TestStackedAreaSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, StackedAreaSeries{}.Validate())
+
+	mismatched := StackedAreaSeries{
+		InnerSeries: ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+		Below:       []ValuesProvider{ContinuousSeries{XValues: []float64{1}, YValues: []float64{1}}},
+	}
+	testutil.AssertNotNil(t, mismatched.Validate())
+}
+
+func TestStackedAreaSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	xs := []float64{1, 2, 3, 4}
+	graph := Chart{
+		Series: StackAreas(
+			ContinuousSeries{XValues: xs, YValues: []float64{1, 2, 1, 2}},
+			ContinuousSeries{XValues: xs, YValues: []float64{2, 1, 2, 1}},
+		),
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
+
+func TestStackedAreaSeriesCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	xs := []float64{1, 2, 3}
+	a := ContinuousSeries{XValues: xs, YValues: []float64{1, 2, 3}}
+	b := ContinuousSeries{XValues: xs, YValues: []float64{4, 5, 6}}
+	top := StackAreas(a, b)[1].(StackedAreaSeries)
+
+	copied := top.CopySeries().(StackedAreaSeries)
+	a.YValues[0] = 10
+	b.YValues[0] = 10
+	_, y1, y2 := copied.GetBoundedValues(0)
+	testutil.AssertEqual(t, 5.0, y1)
+	testutil.AssertEqual(t, 1.0, y2)
+}
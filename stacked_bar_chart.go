@@ -98,18 +98,18 @@ func (sbc StackedBarChart) GetBarSpacing() int {
 // Render renders the chart with the given renderer to the given io.Writer.
 func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
 	if len(sbc.Bars) == 0 {
-		return errors.New("please provide at least one bar")
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one bar"))
 	}
 
 	r, err := rp(sbc.GetWidth(), sbc.GetHeight())
 	if err != nil {
-		return err
+		return newRenderError(RenderStageRenderer, err)
 	}
 
 	if sbc.Font == nil {
 		defaultFont, err := GetDefaultFont()
 		if err != nil {
-			return err
+			return newRenderError(RenderStageFonts, err)
 		}
 		sbc.defaultFont = defaultFont
 	}
@@ -135,10 +135,13 @@ func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
 		a(r, canvasBox, sbc.styleDefaultsElements())
 	}
 
-	return r.Save(w)
+	return newRenderError(RenderStageEncode, r.Save(w))
 }
 
 func (sbc StackedBarChart) drawCanvas(r Renderer, canvasBox Box) {
+	if sbc.Canvas.Hidden {
+		return
+	}
 	Draw.Box(r, canvasBox, sbc.getCanvasStyle())
 }
 
@@ -401,7 +404,7 @@ func (sbc StackedBarChart) drawTitle(r Renderer) {
 		textHeight := textBox.Height()
 
 		titleX := (sbc.GetWidth() >> 1) - (textWidth >> 1)
-		titleY := sbc.TitleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+		titleY := sbc.Background.Margin.GetTop() + sbc.TitleStyle.Margin.GetTop(sbc.TitleStyle.Padding.GetTop(DefaultTitleTop)) + textHeight
 
 		r.Text(sbc.Title, titleX, titleY)
 	}
@@ -518,12 +521,12 @@ func (sbc StackedBarChart) getHorizontalAdjustedCanvasBox(r Renderer, canvasBox
 
 // Box returns the chart bounds as a box.
 func (sbc StackedBarChart) Box() Box {
-	dpr := sbc.Background.Padding.GetRight(10)
-	dpb := sbc.Background.Padding.GetBottom(50)
+	dpr := sbc.Background.Margin.GetRight() + sbc.Background.Padding.GetRight(10)
+	dpb := sbc.Background.Margin.GetBottom() + sbc.Background.Padding.GetBottom(50)
 
 	return Box{
-		Top:    sbc.Background.Padding.GetTop(20),
-		Left:   sbc.Background.Padding.GetLeft(20),
+		Top:    sbc.Background.Margin.GetTop() + sbc.Background.Padding.GetTop(20),
+		Left:   sbc.Background.Margin.GetLeft() + sbc.Background.Padding.GetLeft(20),
 		Right:  sbc.GetWidth() - dpr,
 		Bottom: sbc.GetHeight() - dpb,
 	}
@@ -0,0 +1,194 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	// DefaultStripJitter is the default largest distance of a point from its category, in x axis units.
+	DefaultStripJitter = 0.2
+	// DefaultStripDotWidth is the default radius of the points of a strip series.
+	DefaultStripDotWidth = 3.0
+)
+
+// Interface Assertions.
+var (
+	_ Series         = (*StripSeries)(nil)
+	_ ValuesProvider = (*StripSeries)(nil)
+)
+
+// StripSeries draws the raw observations of each of a set of categories as dots along the position of
+// their category, spread sideways by random jitter so that equal values stay visible, or packed into a
+// beeswarm where no two dots overlap.
+type StripSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// XValues are the positions of each category, e.g. from `OrdinalRange.Position`.
+	XValues []float64
+	// YValues are the observations of each category.
+	YValues [][]float64
+
+	// Jitter is the largest random distance of a point from its category, in x axis units.
+	Jitter float64
+	// Seed seeds the jitter, so that a given series always draws the same way.
+	Seed int64
+	// Beeswarm packs the points of each category as close to it as they can be without overlapping, in place of jitter.
+	Beeswarm bool
+}
+
+// GetName returns the name of the time series.
+func (ss StripSeries) GetName() string {
+	return ss.Name
+}
+
+// GetStyle returns the line style.
+func (ss StripSeries) GetStyle() Style {
+	return ss.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ss StripSeries) GetYAxis() YAxisType {
+	return ss.YAxis
+}
+
+// GetJitter returns the jitter or the default.
+func (ss StripSeries) GetJitter() float64 {
+	if ss.Jitter == 0 {
+		return DefaultStripJitter
+	}
+	return ss.Jitter
+}
+
+// Len returns the number of observations across all categories.
+func (ss StripSeries) Len() int {
+	var length int
+	for _, values := range ss.YValues {
+		length += len(values)
+	}
+	return length
+}
+
+// GetValues gets the position of the category of an observation, and the observation.
+func (ss StripSeries) GetValues(index int) (x, y float64) {
+	for category, values := range ss.YValues {
+		if index < len(values) {
+			return ss.XValues[category], values[index]
+		}
+		index -= len(values)
+	}
+	return
+}
+
+// Render renders the series.
+func (ss StripSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := ss.Style.InheritFrom(defaults.InheritFrom(Style{
+		DotWidth: DefaultStripDotWidth,
+	}))
+	if style.DotColor.IsZero() {
+		style.DotColor = style.GetStrokeColor().WithAlpha(192)
+	}
+	radius := style.GetDotWidth()
+	style.GetDotOptions().WriteDrawingOptionsToRenderer(r)
+
+	rnd := rand.New(rand.NewSource(ss.Seed))
+	for category, x := range ss.XValues {
+		values := ss.YValues[category]
+		cx := canvasBox.Left + xrange.Translate(x)
+
+		ys := make([]int, len(values))
+		for index, value := range values {
+			ys[index] = canvasBox.Bottom - yrange.Translate(value)
+		}
+
+		var offsets []int
+		if ss.Beeswarm {
+			offsets = beeswarmOffsets(ys, radius)
+		} else {
+			jitter := float64(canvasBox.Left + xrange.Translate(x+ss.GetJitter()) - cx)
+			offsets = make([]int, len(values))
+			for index := range offsets {
+				offsets[index] = int(math.Round(jitter * (2*rnd.Float64() - 1)))
+			}
+		}
+
+		for index, y := range ys {
+			r.Circle(radius, cx+offsets[index], y)
+			r.FillStroke()
+		}
+	}
+}
+
+// Validate validates the series.
+func (ss StripSeries) Validate() error {
+	if len(ss.XValues) == 0 {
+		return fmt.Errorf("strip series must have xvalues set")
+	}
+	if len(ss.XValues) != len(ss.YValues) {
+		return fmt.Errorf("strip series must have the same number of xvalues as categories of yvalues")
+	}
+	return nil
+}
+
+// beeswarmOffsets returns horizontal offsets for dots of a given radius at the given heights, placing each
+// dot in turn, from the bottom, at the offset closest to zero where it does not overlap any placed dot.
+func beeswarmOffsets(ys []int, radius float64) []int {
+	order := make([]int, len(ys))
+	for index := range order {
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return ys[order[i]] > ys[order[j]]
+	})
+
+	diameter := 2 * radius
+	offsets := make([]int, len(ys))
+	var placed []int
+	for _, index := range order {
+		// the candidates are the center, and either side of each placed dot that is close enough vertically to touch.
+		candidates := []float64{0}
+		for _, p := range placed {
+			dy := float64(ys[index] - ys[p])
+			if math.Abs(dy) < diameter {
+				dx := math.Sqrt(diameter*diameter - dy*dy)
+				candidates = append(candidates, float64(offsets[p])+dx, float64(offsets[p])-dx)
+			}
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return math.Abs(candidates[i]) < math.Abs(candidates[j])
+		})
+
+		for _, candidate := range candidates {
+			offset := int(math.Round(candidate))
+			if !beeswarmOverlaps(ys, offsets, placed, index, offset, diameter) {
+				offsets[index] = offset
+				break
+			}
+		}
+		placed = append(placed, index)
+	}
+	return offsets
+}
+
+// beeswarmOverlaps returns if a dot at an offset overlaps any placed dot, allowing a pixel for rounding.
+func beeswarmOverlaps(ys, offsets, placed []int, index, offset int, diameter float64) bool {
+	for _, p := range placed {
+		dx := float64(offset - offsets[p])
+		dy := float64(ys[index] - ys[p])
+		if math.Sqrt(dx*dx+dy*dy) < diameter-1 {
+			return true
+		}
+	}
+	return false
+}
+
+// CopySeries returns a copy of the series that does not share its values with the original.
+func (ss StripSeries) CopySeries() Series {
+	ss.XValues = append([]float64(nil), ss.XValues...)
+	ss.YValues = copyFloat64Slices(ss.YValues)
+	return ss
+}
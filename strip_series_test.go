@@ -0,0 +1,84 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestStripSeriesValues(t *testing.T) {
+	// replaced new assertions helper
+
+	ss := StripSeries{
+		XValues: []float64{0, 1},
+		YValues: [][]float64{{1, 2, 3}, {4, 5}},
+	}
+
+	testutil.AssertEqual(t, 5, ss.Len())
+	x, y := ss.GetValues(2)
+	testutil.AssertEqual(t, 0.0, x)
+	testutil.AssertEqual(t, 3.0, y)
+	x, y = ss.GetValues(3)
+	testutil.AssertEqual(t, 1.0, x)
+	testutil.AssertEqual(t, 4.0, y)
+
+	testutil.AssertEqual(t, DefaultStripJitter, ss.GetJitter())
+	testutil.AssertNil(t, ss.Validate())
+	testutil.AssertNotNil(t, StripSeries{XValues: []float64{0}}.Validate())
+}
+
+func TestBeeswarmOffsets(t *testing.T) {
+	// replaced new assertions helper
+
+	ys := []int{50, 50, 50, 52, 80}
+	offsets := beeswarmOffsets(ys, 3)
+
+	// a point on its own sits on the category.
+	testutil.AssertZero(t, offsets[4])
+	for i := range ys {
+		for j := i + 1; j < len(ys); j++ {
+			dx, dy := float64(offsets[i]-offsets[j]), float64(ys[i]-ys[j])
+			testutil.AssertTrue(t, math.Sqrt(dx*dx+dy*dy) >= 5)
+		}
+	}
+}
+
+func TestStripSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	render := func(beeswarm bool) string {
+		graph := Chart{
+			XAxis: XAxis{Range: NewOrdinalRange("one", "two")},
+			Series: []Series{
+				StripSeries{
+					XValues:  []float64{0, 1},
+					YValues:  [][]float64{{1, 2, 2, 3}, {2, 2, 2}},
+					Beeswarm: beeswarm,
+				},
+			},
+		}
+		buffer := bytes.NewBuffer(nil)
+		testutil.AssertNil(t, graph.Render(SVG, buffer))
+		return buffer.String()
+	}
+
+	testutil.AssertContains(t, render(false), "<circle")
+	testutil.AssertEqual(t, render(false), render(false))
+	testutil.AssertContains(t, render(true), "<circle")
+}
+
+func TestStripSeriesCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	ss := StripSeries{
+		XValues: []float64{0, 1},
+		YValues: [][]float64{{1, 2}, {3, 4}},
+	}
+	copied := ss.CopySeries().(StripSeries)
+	copied.XValues[1] = 10
+	copied.YValues[0][1] = 10
+	testutil.AssertEqual(t, 1.0, ss.XValues[1])
+	testutil.AssertEqual(t, 2.0, ss.YValues[0][1])
+}
@@ -46,6 +46,12 @@ type Style struct {
 	Hidden  bool
 	Padding Box
 
+	// Margin is the space outside of the element the style applies to, where
+	// Padding is the space inside of it. It is honored by the background, title, canvas and
+	// legend of a chart, and by axis tick and name labels, where it is the space between the
+	// label and the axis. The other chart types honor it for their background and title.
+	Margin Box
+
 	ClassName string
 
 	StrokeWidth     float64
@@ -58,7 +64,18 @@ type Style struct {
 	DotWidthProvider SizeProvider
 	DotColorProvider DotColorProvider
 
-	FillColor drawing.Color
+	// DotIcon, if set, is drawn in place of dots and markers, sized by the dot width.
+	DotIcon *Icon
+
+	// LineInterpolation is how line series join their points, e.g. with steps.
+	LineInterpolation LineInterpolation
+
+	FillColor        drawing.Color
+	FillPattern      FillPattern
+	FillPatternColor drawing.Color
+
+	// CornerRadius rounds the corners of boxes drawn with the style, e.g. the background or canvas.
+	CornerRadius float64
 
 	FontSize  float64
 	FontColor drawing.Color
@@ -76,15 +93,29 @@ func (s Style) IsZero() bool {
 	return !s.Hidden &&
 		s.StrokeColor.IsZero() &&
 		s.StrokeWidth == 0 &&
+		len(s.StrokeDashArray) == 0 &&
 		s.DotColor.IsZero() &&
 		s.DotWidth == 0 &&
+		s.DotIcon == nil &&
+		s.LineInterpolation == LineInterpolationUnset &&
 		s.FillColor.IsZero() &&
+		s.FillPattern == FillPatternNone &&
+		s.FillPatternColor.IsZero() &&
+		s.CornerRadius == 0 &&
 		s.FontColor.IsZero() &&
 		s.FontSize == 0 &&
 		s.Font == nil &&
 		s.ClassName == ""
 }
 
+// Clone returns a copy of the style that does not share its stroke dash array with the original.
+func (s Style) Clone() Style {
+	if s.StrokeDashArray != nil {
+		s.StrokeDashArray = append([]float64{}, s.StrokeDashArray...)
+	}
+	return s
+}
+
 // String returns a text representation of the style.
 func (s Style) String() string {
 	if s.IsZero() {
@@ -205,6 +236,50 @@ func (s Style) GetFillColor(defaults ...drawing.Color) drawing.Color {
 	return s.FillColor
 }
 
+// GetLineInterpolation returns the line interpolation or a default.
+func (s Style) GetLineInterpolation(defaults ...LineInterpolation) LineInterpolation {
+	if s.LineInterpolation == LineInterpolationUnset {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return LineInterpolationUnset
+	}
+	return s.LineInterpolation
+}
+
+// GetFillPattern returns the fill pattern or a default.
+func (s Style) GetFillPattern(defaults ...FillPattern) FillPattern {
+	if s.FillPattern == FillPatternNone {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return FillPatternNone
+	}
+	return s.FillPattern
+}
+
+// GetFillPatternColor returns the fill pattern color or a default.
+func (s Style) GetFillPatternColor(defaults ...drawing.Color) drawing.Color {
+	if s.FillPatternColor.IsZero() {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return drawing.ColorTransparent
+	}
+	return s.FillPatternColor
+}
+
+// GetDotIcon returns the dot icon or a default.
+func (s Style) GetDotIcon(defaults ...*Icon) *Icon {
+	if s.DotIcon == nil {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return nil
+	}
+	return s.DotIcon
+}
+
 // GetDotColor returns the stroke color.
 func (s Style) GetDotColor(defaults ...drawing.Color) drawing.Color {
 	if s.DotColor.IsZero() {
@@ -227,6 +302,17 @@ func (s Style) GetStrokeWidth(defaults ...float64) float64 {
 	return s.StrokeWidth
 }
 
+// GetCornerRadius returns the corner radius or a default.
+func (s Style) GetCornerRadius(defaults ...float64) float64 {
+	if s.CornerRadius == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return 0
+	}
+	return s.CornerRadius
+}
+
 // GetDotWidth returns the dot width for scatter plots.
 func (s Style) GetDotWidth(defaults ...float64) float64 {
 	if s.DotWidth == 0 {
@@ -293,6 +379,17 @@ func (s Style) GetPadding(defaults ...Box) Box {
 	return s.Padding
 }
 
+// GetMargin returns the margin or a default.
+func (s Style) GetMargin(defaults ...Box) Box {
+	if s.Margin.IsZero() {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return Box{}
+	}
+	return s.Margin
+}
+
 // GetTextHorizontalAlign returns the horizontal alignment.
 func (s Style) GetTextHorizontalAlign(defaults ...TextHorizontalAlign) TextHorizontalAlign {
 	if s.TextHorizontalAlign == TextHorizontalAlignUnset {
@@ -394,12 +491,18 @@ func (s Style) InheritFrom(defaults Style) (final Style) {
 
 	final.DotWidthProvider = s.DotWidthProvider
 	final.DotColorProvider = s.DotColorProvider
+	final.DotIcon = s.GetDotIcon(defaults.DotIcon)
+	final.LineInterpolation = s.GetLineInterpolation(defaults.LineInterpolation)
 
 	final.FillColor = s.GetFillColor(defaults.FillColor)
+	final.FillPattern = s.GetFillPattern(defaults.FillPattern)
+	final.FillPatternColor = s.GetFillPatternColor(defaults.FillPatternColor)
+	final.CornerRadius = s.GetCornerRadius(defaults.CornerRadius)
 	final.FontColor = s.GetFontColor(defaults.FontColor)
 	final.FontSize = s.GetFontSize(defaults.FontSize)
 	final.Font = s.GetFont(defaults.Font)
 	final.Padding = s.GetPadding(defaults.Padding)
+	final.Margin = s.GetMargin(defaults.Margin)
 	final.TextHorizontalAlign = s.GetTextHorizontalAlign(defaults.TextHorizontalAlign)
 	final.TextVerticalAlign = s.GetTextVerticalAlign(defaults.TextVerticalAlign)
 	final.TextWrap = s.GetTextWrap(defaults.TextWrap)
@@ -471,7 +574,7 @@ func (s Style) ShouldDrawStroke() bool {
 
 // ShouldDrawDot tells drawing functions if they should draw the dot.
 func (s Style) ShouldDrawDot() bool {
-	return (!s.DotColor.IsZero() && s.DotWidth > 0) || s.DotColorProvider != nil || s.DotWidthProvider != nil
+	return (!s.DotColor.IsZero() && s.DotWidth > 0) || s.DotColorProvider != nil || s.DotWidthProvider != nil || s.DotIcon != nil
 }
 
 // ShouldDrawFill tells drawing functions if they should draw the stroke.
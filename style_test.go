@@ -30,6 +30,12 @@ func TestStyleIsZero(t *testing.T) {
 
 	font := Style{Font: &truetype.Font{}}
 	testutil.AssertFalse(t, font.IsZero())
+
+	strokeDashArray := Style{StrokeDashArray: []float64{1, 2}}
+	testutil.AssertFalse(t, strokeDashArray.IsZero())
+
+	fillPattern := Style{FillPattern: FillPatternDiagonal}
+	testutil.AssertFalse(t, fillPattern.IsZero())
 }
 
 func TestStyleGetStrokeColor(t *testing.T) {
@@ -133,12 +139,14 @@ func TestStyleWithDefaultsFrom(t *testing.T) {
 
 	unset := Style{}
 	set := Style{
-		StrokeColor: drawing.ColorWhite,
-		StrokeWidth: 5.0,
-		FillColor:   drawing.ColorWhite,
-		FontColor:   drawing.ColorWhite,
-		Font:        f,
-		Padding:     DefaultBackgroundPadding,
+		StrokeColor:  drawing.ColorWhite,
+		StrokeWidth:  5.0,
+		FillColor:    drawing.ColorWhite,
+		FontColor:    drawing.ColorWhite,
+		Font:         f,
+		Padding:      DefaultBackgroundPadding,
+		Margin:       Box{Top: 1, Left: 2, Right: 3, Bottom: 4},
+		CornerRadius: 8.0,
 	}
 
 	coalesced := unset.InheritFrom(set)
@@ -0,0 +1,344 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// SurfaceProjection is an enum for the ways a surface chart can be projected onto the canvas.
+type SurfaceProjection int
+
+const (
+	// SurfaceProjectionUnset is the unset state for surface projections; it defaults to `SurfaceProjectionIsometric`.
+	SurfaceProjectionUnset SurfaceProjection = 0
+	// SurfaceProjectionIsometric draws parallel lines of the surface as parallel, whatever their distance.
+	SurfaceProjectionIsometric SurfaceProjection = 1
+	// SurfaceProjectionPerspective draws the far parts of the surface smaller than the near parts.
+	SurfaceProjectionPerspective SurfaceProjection = 2
+)
+
+// SurfaceChart is a chart that draws a grid of heights, `Z[row][column]`, as a 3d surface viewed
+// from above, colored by height through a color map.
+//
+// The columns are spread along the x axis, at `XValues` if set, and the rows along the y axis, at
+// `YValues` if set; cells with a NaN corner are left out.
+type SurfaceChart struct {
+	ChartFrame
+
+	// SurfaceStyle is the style of the cells, e.g. the stroke of the grid lines.
+	SurfaceStyle Style
+	LegendStyle  Style
+
+	Projection SurfaceProjection
+	// Wireframe draws only the grid lines, colored by height, rather than filled cells.
+	Wireframe bool
+	// Azimuth is the angle in degrees the surface is turned about its vertical axis.
+	Azimuth float64
+	// Elevation is the angle in degrees the surface is viewed from above.
+	Elevation float64
+	// ZScale is the height of the surface relative to its width and depth.
+	ZScale float64
+
+	// ColorMap maps the heights to colors, it defaults to `Jet`.
+	ColorMap       ColorMap
+	ValueFormatter ValueFormatter
+
+	XValues  []float64
+	YValues  []float64
+	Z        [][]float64
+	Elements []Renderable
+}
+
+// surfaceCell is a cell of a surface chart projected onto the canvas.
+type surfaceCell struct {
+	Points [4][2]float64
+	Z      float64
+	Depth  float64
+}
+
+// GetHeight returns the chart height or the default value.
+func (sc SurfaceChart) GetHeight() int {
+	if sc.Height == 0 {
+		return DefaultChartWidth
+	}
+	return sc.Height
+}
+
+// Box returns the chart bounds as a box, at the chart's own default size.
+func (sc SurfaceChart) Box() Box {
+	return sc.box(sc.GetWidth(), sc.GetHeight())
+}
+
+// GetAzimuth returns the azimuth in degrees or the default.
+func (sc SurfaceChart) GetAzimuth() float64 {
+	if sc.Azimuth == 0 {
+		return DefaultSurfaceAzimuth
+	}
+	return sc.Azimuth
+}
+
+// GetElevation returns the elevation in degrees or the default.
+func (sc SurfaceChart) GetElevation() float64 {
+	if sc.Elevation == 0 {
+		return DefaultSurfaceElevation
+	}
+	return sc.Elevation
+}
+
+// GetZScale returns the height of the surface relative to its width or the default.
+func (sc SurfaceChart) GetZScale() float64 {
+	if sc.ZScale == 0 {
+		return DefaultSurfaceZScale
+	}
+	return sc.ZScale
+}
+
+// GetColorMap returns the color map or the default.
+func (sc SurfaceChart) GetColorMap() ColorMap {
+	if sc.ColorMap == nil {
+		return Jet
+	}
+	return sc.ColorMap
+}
+
+// GetValueFormatter returns the legend value formatter or the default.
+func (sc SurfaceChart) GetValueFormatter() ValueFormatter {
+	if sc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return sc.ValueFormatter
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (sc SurfaceChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := sc.validate(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := sc.GetWidth(), sc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if sc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		sc.defaultFont = defaultFont
+	}
+	r.SetDPI(sc.GetDPI(DefaultDPI))
+
+	canvasBox := sc.getDefaultCanvasBox(r, width, height)
+	plotBox, legendBox := sc.getLayout(r, canvasBox)
+
+	sc.drawBackground(r, width, height)
+	sc.drawCanvas(r, canvasBox)
+	sc.drawSurface(r, plotBox)
+	if !sc.LegendStyle.Hidden {
+		min, max := sc.getZRange()
+		drawColorBarLegend(r, legendBox, sc.GetColorMap(), min, max, sc.GetValueFormatter(), sc.styleDefaultsLegend())
+	}
+	sc.drawTitle(r, width, height)
+	for _, a := range sc.Elements {
+		a(r, canvasBox, sc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (sc SurfaceChart) validate() error {
+	if len(sc.Z) < 2 || len(sc.Z[0]) < 2 {
+		return errors.New("surface chart must have at least (2) rows and (2) columns")
+	}
+	var hasValue bool
+	for _, row := range sc.Z {
+		if len(row) != len(sc.Z[0]) {
+			return errors.New("surface chart rows must have the same number of values")
+		}
+		for _, z := range row {
+			hasValue = hasValue || !math.IsNaN(z)
+		}
+	}
+	if !hasValue {
+		return errors.New("surface chart must contain at least (1) value that is not NaN")
+	}
+	if len(sc.XValues) > 0 && len(sc.XValues) != len(sc.Z[0]) {
+		return fmt.Errorf("surface chart has %d x values for %d columns", len(sc.XValues), len(sc.Z[0]))
+	}
+	if len(sc.YValues) > 0 && len(sc.YValues) != len(sc.Z) {
+		return fmt.Errorf("surface chart has %d y values for %d rows", len(sc.YValues), len(sc.Z))
+	}
+	return nil
+}
+
+// getZRange returns the min and max heights.
+func (sc SurfaceChart) getZRange() (min, max float64) {
+	min, max = math.MaxFloat64, -math.MaxFloat64
+	for _, row := range sc.Z {
+		for _, z := range row {
+			if !math.IsNaN(z) {
+				min, max = math.Min(min, z), math.Max(max, z)
+			}
+		}
+	}
+	return
+}
+
+// getLayout splits the canvas into the plot, and the color bar and its labels to the right of it.
+func (sc SurfaceChart) getLayout(r Renderer, canvasBox Box) (plotBox, legendBox Box) {
+	plotBox = canvasBox
+	if sc.LegendStyle.Hidden {
+		return
+	}
+	min, max := sc.getZRange()
+	plotBox.Right -= DefaultColorBarGap + colorBarLegendWidth(r, min, max, sc.GetValueFormatter(), sc.styleDefaultsLegend())
+	legendBox = colorBarLegendBox(plotBox)
+	return
+}
+
+// getSurfaceAxisValue returns the position of an index along an axis in [-1, 1], from the values if set.
+func getSurfaceAxisValue(values []float64, index, count int) float64 {
+	if len(values) == 0 {
+		return 2*float64(index)/float64(count-1) - 1
+	}
+	min, max := MinMax(values...)
+	if max == min {
+		return 0
+	}
+	return 2*(values[index]-min)/(max-min) - 1
+}
+
+// project returns the canvas x and y, up to scale and with y increasing downward, of a point of the
+// surface in [-1, 1] on each axis, and its depth, increasing away from the viewer.
+func (sc SurfaceChart) project(x, y, z float64) (px, py, depth float64) {
+	azimuth, elevation := DegreesToRadians(sc.GetAzimuth()), DegreesToRadians(sc.GetElevation())
+
+	// turn the surface about its vertical axis, then tilt it towards the viewer.
+	tx := x*math.Cos(azimuth) - y*math.Sin(azimuth)
+	ty := x*math.Sin(azimuth) + y*math.Cos(azimuth)
+	up := z*math.Cos(elevation) + ty*math.Sin(elevation)
+	depth = ty*math.Cos(elevation) - z*math.Sin(elevation)
+
+	if sc.Projection == SurfaceProjectionPerspective {
+		scale := DefaultSurfaceCameraDistance / (DefaultSurfaceCameraDistance + depth)
+		return tx * scale, -up * scale, depth
+	}
+	return tx, -up, depth
+}
+
+// getCells returns the cells of the surface projected into a box, ordered far to near so that
+// drawing them in order paints the near cells over the far ones.
+func (sc SurfaceChart) getCells(plotBox Box) []surfaceCell {
+	rows, columns := len(sc.Z), len(sc.Z[0])
+	min, max := sc.getZRange()
+	zScale := sc.GetZScale()
+
+	points := make([][][3]float64, rows)
+	for row := range sc.Z {
+		points[row] = make([][3]float64, columns)
+		y := getSurfaceAxisValue(sc.YValues, row, rows)
+		for column, z := range sc.Z[row] {
+			x := getSurfaceAxisValue(sc.XValues, column, columns)
+			height := 0.0
+			if max > min {
+				height = zScale * (2*(z-min)/(max-min) - 1)
+			}
+			px, py, depth := sc.project(x, y, height)
+			points[row][column] = [3]float64{px, py, depth}
+		}
+	}
+
+	// fit the corners of the bounding cube of the surface to the box, so that the scale does not
+	// depend on the heights.
+	minX, minY := math.MaxFloat64, math.MaxFloat64
+	maxX, maxY := -math.MaxFloat64, -math.MaxFloat64
+	for _, corner := range [][3]float64{
+		{-1, -1, -zScale}, {1, -1, -zScale}, {1, 1, -zScale}, {-1, 1, -zScale},
+		{-1, -1, zScale}, {1, -1, zScale}, {1, 1, zScale}, {-1, 1, zScale},
+	} {
+		px, py, _ := sc.project(corner[0], corner[1], corner[2])
+		minX, maxX = math.Min(minX, px), math.Max(maxX, px)
+		minY, maxY = math.Min(minY, py), math.Max(maxY, py)
+	}
+	scale := math.Min(float64(plotBox.Width())/(maxX-minX), float64(plotBox.Height())/(maxY-minY))
+	cx, cy := plotBox.Center()
+	midX, midY := (minX+maxX)/2, (minY+maxY)/2
+
+	var cells []surfaceCell
+	for row := 0; row < rows-1; row++ {
+		for column := 0; column < columns-1; column++ {
+			corners := [4][2]int{{row, column}, {row, column + 1}, {row + 1, column + 1}, {row + 1, column}}
+			var cell surfaceCell
+			var missing bool
+			for index, corner := range corners {
+				z := sc.Z[corner[0]][corner[1]]
+				if math.IsNaN(z) {
+					missing = true
+					break
+				}
+				p := points[corner[0]][corner[1]]
+				cell.Points[index] = [2]float64{float64(cx) + (p[0]-midX)*scale, float64(cy) + (p[1]-midY)*scale}
+				cell.Z += z / 4
+				cell.Depth += p[2] / 4
+			}
+			if !missing {
+				cells = append(cells, cell)
+			}
+		}
+	}
+	sort.SliceStable(cells, func(i, j int) bool {
+		return cells[i].Depth > cells[j].Depth
+	})
+	return cells
+}
+
+// drawSurface draws the cells of the surface, far to near, filled by height or as a wireframe.
+func (sc SurfaceChart) drawSurface(r Renderer, plotBox Box) {
+	if plotBox.Width() <= 0 || plotBox.Height() <= 0 {
+		return
+	}
+	min, max := sc.getZRange()
+	for _, cell := range sc.getCells(plotBox) {
+		color := sc.GetColorMap()(cell.Z, min, max)
+		var style Style
+		if sc.Wireframe {
+			style = sc.SurfaceStyle.InheritFrom(Style{
+				StrokeColor: color,
+				StrokeWidth: DefaultAxisLineWidth,
+			})
+		} else {
+			style = sc.SurfaceStyle.InheritFrom(Style{
+				FillColor:   color,
+				StrokeColor: ColorBlack.WithAlpha(64),
+				StrokeWidth: 0.5,
+			})
+		}
+
+		style.GetFillAndStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(int(math.Round(cell.Points[0][0])), int(math.Round(cell.Points[0][1])))
+		for _, point := range cell.Points[1:] {
+			r.LineTo(int(math.Round(point[0])), int(math.Round(point[1])))
+		}
+		r.Close()
+		if sc.Wireframe {
+			r.Stroke()
+		} else {
+			r.FillStroke()
+		}
+	}
+}
+
+func (sc SurfaceChart) styleDefaultsLegend() Style {
+	return sc.LegendStyle.InheritFrom(Style{
+		FontSize:    DefaultFontSize,
+		FontColor:   sc.GetColorPalette().TextColor(),
+		Font:        sc.GetFont(),
+		StrokeColor: sc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
@@ -0,0 +1,90 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestSurfaceChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	z := [][]float64{
+		{0, 1, 2},
+		{1, math.NaN(), 3},
+		{2, 3, 4},
+	}
+	for _, projection := range []SurfaceProjection{SurfaceProjectionIsometric, SurfaceProjectionPerspective} {
+		for _, wireframe := range []bool{false, true} {
+			sc := SurfaceChart{
+				ChartFrame: ChartFrame{
+					Title: "Test",
+				},
+				Projection: projection,
+				Wireframe:  wireframe,
+				XValues:    []float64{0, 1, 4},
+				Z:          z,
+			}
+			for _, rp := range []RendererProvider{PNG, SVG} {
+				b := bytes.NewBuffer([]byte{})
+				testutil.AssertNil(t, sc.Render(rp, b))
+				testutil.AssertNotZero(t, b.Len())
+			}
+		}
+	}
+}
+
+func TestSurfaceChartValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, SurfaceChart{}.validate())
+	testutil.AssertNotNil(t, SurfaceChart{Z: [][]float64{{1, 2}}}.validate())
+	testutil.AssertNotNil(t, SurfaceChart{Z: [][]float64{{1, 2}, {3}}}.validate())
+	testutil.AssertNotNil(t, SurfaceChart{Z: [][]float64{{math.NaN(), math.NaN()}, {math.NaN(), math.NaN()}}}.validate())
+	testutil.AssertNotNil(t, SurfaceChart{XValues: []float64{1}, Z: [][]float64{{1, 2}, {3, 4}}}.validate())
+	testutil.AssertNotNil(t, SurfaceChart{YValues: []float64{1, 2, 3}, Z: [][]float64{{1, 2}, {3, 4}}}.validate())
+	testutil.AssertNil(t, SurfaceChart{Z: [][]float64{{1, 2}, {3, 4}}}.validate())
+}
+
+func TestSurfaceChartProject(t *testing.T) {
+	// replaced new assertions helper
+
+	sc := SurfaceChart{Azimuth: 90, Elevation: 90}
+	// viewed from directly above, heights do not move points on the canvas.
+	x0, y0, _ := sc.project(1, 0, 0)
+	x1, y1, _ := sc.project(1, 0, 1)
+	testutil.AssertInDelta(t, x0, x1, 1e-9)
+	testutil.AssertInDelta(t, y0, y1, 1e-9)
+
+	// higher points are nearer to a viewer above.
+	_, _, low := sc.project(0, 0, 0)
+	_, _, high := sc.project(0, 0, 1)
+	testutil.AssertTrue(t, high < low)
+
+	sc.Projection = SurfaceProjectionPerspective
+	near, _, _ := sc.project(1, 0, 1)
+	far, _, _ := sc.project(1, 0, -1)
+	testutil.AssertTrue(t, math.Abs(near) > math.Abs(far))
+}
+
+func TestSurfaceChartGetCellsOrder(t *testing.T) {
+	// replaced new assertions helper
+
+	sc := SurfaceChart{Z: [][]float64{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}}}
+	cells := sc.getCells(Box{Right: 400, Bottom: 400})
+	testutil.AssertLen(t, cells, 4)
+	for index := 1; index < len(cells); index++ {
+		testutil.AssertTrue(t, cells[index-1].Depth >= cells[index].Depth)
+	}
+}
+
+func TestGetSurfaceAxisValue(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertEqual(t, -1.0, getSurfaceAxisValue(nil, 0, 3))
+	testutil.AssertEqual(t, 0.0, getSurfaceAxisValue(nil, 1, 3))
+	testutil.AssertEqual(t, 1.0, getSurfaceAxisValue(nil, 2, 3))
+	testutil.AssertEqual(t, -0.5, getSurfaceAxisValue([]float64{0, 1, 4}, 1, 3))
+}
@@ -0,0 +1,99 @@
+package chart
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// SVGZ returns a new renderer for gzip compressed svg, the svgz format.
+func SVGZ(width, height int) (Renderer, error) {
+	r, err := SVG(width, height)
+	if err != nil {
+		return nil, err
+	}
+	return &svgzRenderer{vectorRenderer: r.(*vectorRenderer)}, nil
+}
+
+// svgzRenderer is an svg renderer that compresses what it saves with gzip.
+type svgzRenderer struct {
+	*vectorRenderer
+}
+
+// Save implements the interface method.
+func (sr *svgzRenderer) Save(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	if err := sr.vectorRenderer.Save(gz); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// WriteSVG renders a chart as svg to an http response, compressed with gzip or deflate if the request
+// accepts either, e.g. `chart.WriteSVG(rw, req, graph.Render)`. The chart is rendered before anything
+// is written, so an error can still be written as the response.
+func WriteSVG(rw http.ResponseWriter, req *http.Request, render func(rp RendererProvider, w io.Writer) error) error {
+	buffer := bytes.NewBuffer([]byte{})
+	var err error
+	encoding := acceptedEncoding(req.Header.Get("Accept-Encoding"))
+	switch encoding {
+	case "gzip":
+		err = render(SVGZ, buffer)
+	case "deflate":
+		zw := zlib.NewWriter(buffer)
+		if err = render(SVG, zw); err == nil {
+			err = zw.Close()
+		}
+	default:
+		err = render(SVG, buffer)
+	}
+	if err != nil {
+		return err
+	}
+
+	rw.Header().Set("Content-Type", "image/svg+xml")
+	rw.Header().Add("Vary", "Accept-Encoding")
+	if len(encoding) > 0 {
+		rw.Header().Set("Content-Encoding", encoding)
+	}
+	rw.Header().Set("Content-Length", strconv.Itoa(buffer.Len()))
+	_, err = rw.Write(buffer.Bytes())
+	return err
+}
+
+// acceptedEncoding returns the encoding, gzip or deflate, an Accept-Encoding header prefers, or empty
+// if it accepts neither. A wildcard accepts the encodings the header does not name.
+func acceptedEncoding(header string) string {
+	qualities := map[string]float64{}
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if parsed, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		qualities[name] = q
+	}
+
+	var encoding string
+	var quality float64
+	for _, name := range []string{"gzip", "deflate"} {
+		q, ok := qualities[name]
+		if !ok {
+			q = qualities["*"]
+		}
+		if q > quality {
+			encoding, quality = name, q
+		}
+	}
+	return encoding
+}
@@ -0,0 +1,87 @@
+package chart
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testSVGZChart() Chart {
+	return Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+}
+
+func TestSVGZ(t *testing.T) {
+	// replaced new assertions helper
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, testSVGZChart().Render(SVGZ, b))
+
+	gz, err := gzip.NewReader(b)
+	testutil.AssertNil(t, err)
+	contents, err := ioutil.ReadAll(gz)
+	testutil.AssertNil(t, err)
+	testutil.AssertContains(t, string(contents), "<svg")
+	testutil.AssertContains(t, string(contents), "</svg>")
+}
+
+func TestAcceptedEncoding(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertEqual(t, "", acceptedEncoding(""))
+	testutil.AssertEqual(t, "", acceptedEncoding("br, identity"))
+	testutil.AssertEqual(t, "gzip", acceptedEncoding("gzip, deflate, br"))
+	testutil.AssertEqual(t, "deflate", acceptedEncoding("deflate"))
+	testutil.AssertEqual(t, "deflate", acceptedEncoding("gzip;q=0.5, deflate;q=0.8"))
+	testutil.AssertEqual(t, "", acceptedEncoding("gzip;q=0"))
+	testutil.AssertEqual(t, "gzip", acceptedEncoding("*"))
+	testutil.AssertEqual(t, "deflate", acceptedEncoding("gzip;q=0, *"))
+}
+
+func TestWriteSVG(t *testing.T) {
+	// replaced new assertions helper
+
+	testCases := []struct {
+		acceptEncoding string
+		decode         func(io.Reader) (io.Reader, error)
+	}{
+		{"", func(r io.Reader) (io.Reader, error) { return r, nil }},
+		{"gzip", func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) }},
+		{"deflate", func(r io.Reader) (io.Reader, error) { return zlib.NewReader(r) }},
+	}
+	for _, tc := range testCases {
+		req := httptest.NewRequest("GET", "/chart.svg", nil)
+		req.Header.Set("Accept-Encoding", tc.acceptEncoding)
+		rw := httptest.NewRecorder()
+		testutil.AssertNil(t, WriteSVG(rw, req, testSVGZChart().Render))
+
+		testutil.AssertEqual(t, "image/svg+xml", rw.Header().Get("Content-Type"))
+		testutil.AssertEqual(t, tc.acceptEncoding, rw.Header().Get("Content-Encoding"))
+		testutil.AssertEqual(t, "Accept-Encoding", rw.Header().Get("Vary"))
+		testutil.AssertEqual(t, strconv.Itoa(rw.Body.Len()), rw.Header().Get("Content-Length"))
+
+		r, err := tc.decode(rw.Body)
+		testutil.AssertNil(t, err)
+		contents, err := ioutil.ReadAll(r)
+		testutil.AssertNil(t, err)
+		testutil.AssertContains(t, string(contents), "</svg>")
+	}
+
+	req := httptest.NewRequest("GET", "/chart.svg", nil)
+	rw := httptest.NewRecorder()
+	testutil.AssertNotNil(t, WriteSVG(rw, req, Chart{}.Render))
+	testutil.AssertZero(t, rw.Body.Len())
+}
@@ -0,0 +1,182 @@
+package chart
+
+import "fmt"
+
+// TextBoxAnchor is an enum for the points of the canvas, or of a text box, a text box is anchored by.
+type TextBoxAnchor int
+
+const (
+	// TextBoxAnchorUnset is the unset state for text box anchors; it defaults to `TextBoxAnchorTopLeft`.
+	TextBoxAnchorUnset TextBoxAnchor = 0
+	// TextBoxAnchorTopLeft anchors the top left corner.
+	TextBoxAnchorTopLeft TextBoxAnchor = 1
+	// TextBoxAnchorTop anchors the middle of the top edge.
+	TextBoxAnchorTop TextBoxAnchor = 2
+	// TextBoxAnchorTopRight anchors the top right corner.
+	TextBoxAnchorTopRight TextBoxAnchor = 3
+	// TextBoxAnchorLeft anchors the middle of the left edge.
+	TextBoxAnchorLeft TextBoxAnchor = 4
+	// TextBoxAnchorCenter anchors the center.
+	TextBoxAnchorCenter TextBoxAnchor = 5
+	// TextBoxAnchorRight anchors the middle of the right edge.
+	TextBoxAnchorRight TextBoxAnchor = 6
+	// TextBoxAnchorBottomLeft anchors the bottom left corner.
+	TextBoxAnchorBottomLeft TextBoxAnchor = 7
+	// TextBoxAnchorBottom anchors the middle of the bottom edge.
+	TextBoxAnchorBottom TextBoxAnchor = 8
+	// TextBoxAnchorBottomRight anchors the bottom right corner.
+	TextBoxAnchorBottomRight TextBoxAnchor = 9
+)
+
+// point returns the anchor point of a box.
+func (tba TextBoxAnchor) point(b Box) (x, y int) {
+	switch tba {
+	case TextBoxAnchorTop, TextBoxAnchorCenter, TextBoxAnchorBottom:
+		x = b.Left + b.Width()>>1
+	case TextBoxAnchorTopRight, TextBoxAnchorRight, TextBoxAnchorBottomRight:
+		x = b.Right
+	default:
+		x = b.Left
+	}
+	switch tba {
+	case TextBoxAnchorLeft, TextBoxAnchorCenter, TextBoxAnchorRight:
+		y = b.Top + b.Height()>>1
+	case TextBoxAnchorBottomLeft, TextBoxAnchorBottom, TextBoxAnchorBottomRight:
+		y = b.Bottom
+	default:
+		y = b.Top
+	}
+	return
+}
+
+// Interface Assertions.
+var (
+	_ Series = (*TextBoxSeries)(nil)
+)
+
+// TextBoxSeries draws a box of free text on the chart, e.g. a note that "data is incomplete after 3pm",
+// with the background, border and padding of its style, and the text wrapped to a maximum width.
+//
+// The box is placed by its anchor: at the same point of the canvas, inset by the style margin, or, if
+// `AtValues` is set, with the anchor point of the box at `XValue` and `YValue`, kept within the canvas.
+type TextBoxSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Text   string
+	Anchor TextBoxAnchor
+
+	AtValues bool
+	XValue   float64
+	YValue   float64
+
+	// MaxWidth is the pixel width the text wraps at.
+	MaxWidth int
+}
+
+// GetName returns the name of the series.
+func (tbs TextBoxSeries) GetName() string {
+	return tbs.Name
+}
+
+// GetStyle returns the series style.
+func (tbs TextBoxSeries) GetStyle() Style {
+	return tbs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (tbs TextBoxSeries) GetYAxis() YAxisType {
+	return tbs.YAxis
+}
+
+// GetAnchor returns the anchor or the default.
+func (tbs TextBoxSeries) GetAnchor() TextBoxAnchor {
+	if tbs.Anchor == TextBoxAnchorUnset {
+		return TextBoxAnchorTopLeft
+	}
+	return tbs.Anchor
+}
+
+// GetMaxWidth returns the maximum text width or the default.
+func (tbs TextBoxSeries) GetMaxWidth() int {
+	if tbs.MaxWidth == 0 {
+		return DefaultTextBoxMaxWidth
+	}
+	return tbs.MaxWidth
+}
+
+func (tbs TextBoxSeries) styleDefaults(defaults Style) Style {
+	return tbs.Style.InheritFrom(Style{
+		Font:        defaults.Font,
+		FontColor:   DefaultTextColor,
+		FontSize:    DefaultAnnotationFontSize,
+		FillColor:   DefaultAnnotationFillColor,
+		StrokeColor: DefaultAxisColor,
+		StrokeWidth: DefaultAxisLineWidth,
+		Padding:     DefaultAnnotationPadding,
+		Margin:      DefaultTextBoxMargin,
+		TextWrap:    TextWrapWord,
+	})
+}
+
+// getBox returns the box the text box is drawn in, sized to its lines of text.
+func (tbs TextBoxSeries) getBox(r Renderer, canvasBox Box, xrange, yrange Range, style Style, lines []string) Box {
+	linesBox := Text.MeasureLines(r, lines, style)
+	padding := style.GetPadding()
+	width := linesBox.Width() + padding.GetLeft() + padding.GetRight()
+	height := linesBox.Height() + padding.GetTop() + padding.GetBottom()
+
+	anchor := tbs.GetAnchor()
+	var x, y int
+	if tbs.AtValues {
+		x = canvasBox.Left + xrange.Translate(tbs.XValue)
+		y = canvasBox.Bottom - yrange.Translate(tbs.YValue)
+	} else {
+		x, y = anchor.point(canvasBox.Inset(style.GetMargin()))
+	}
+
+	// offset the box from the point by where its own anchor point is.
+	ax, ay := anchor.point(Box{Right: width, Bottom: height})
+	box := Box{
+		Top:    y - ay,
+		Left:   x - ax,
+		Right:  x - ax + width,
+		Bottom: y - ay + height,
+	}
+	if tbs.AtValues {
+		box = box.Shift(MaxInt(0, canvasBox.Left-box.Left)+MinInt(0, canvasBox.Right-box.Right), MaxInt(0, canvasBox.Top-box.Top)+MinInt(0, canvasBox.Bottom-box.Bottom))
+	}
+	return box
+}
+
+// Render renders the series.
+func (tbs TextBoxSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if tbs.Style.Hidden || len(tbs.Text) == 0 {
+		return
+	}
+	style := tbs.styleDefaults(defaults)
+	lines := Text.WrapFit(r, tbs.Text, tbs.GetMaxWidth(), style)
+	box := tbs.getBox(r, canvasBox, xrange, yrange, style, lines)
+	Draw.Box(r, box, style)
+
+	style.GetTextOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+	y := box.Top + style.GetPadding().GetTop()
+	for _, line := range lines {
+		lineBox := r.MeasureText(line)
+		r.Text(line, box.Left+style.GetPadding().GetLeft(), y+lineBox.Height())
+		y += lineBox.Height() + style.GetTextLineSpacing()
+	}
+}
+
+// Validate validates the series.
+func (tbs TextBoxSeries) Validate() error {
+	if len(tbs.Text) == 0 {
+		return fmt.Errorf("text box series requires text to be set")
+	}
+	if tbs.Anchor < TextBoxAnchorUnset || tbs.Anchor > TextBoxAnchorBottomRight {
+		return fmt.Errorf("text box series has an invalid anchor: %d", tbs.Anchor)
+	}
+	return nil
+}
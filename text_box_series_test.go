@@ -0,0 +1,67 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestTextBoxAnchorPoint(t *testing.T) {
+	// replaced new assertions helper
+
+	b := Box{Top: 10, Left: 20, Right: 120, Bottom: 50}
+	x, y := TextBoxAnchorUnset.point(b)
+	testutil.AssertEqual(t, 20, x)
+	testutil.AssertEqual(t, 10, y)
+	x, y = TextBoxAnchorCenter.point(b)
+	testutil.AssertEqual(t, 70, x)
+	testutil.AssertEqual(t, 30, y)
+	x, y = TextBoxAnchorBottomRight.point(b)
+	testutil.AssertEqual(t, 120, x)
+	testutil.AssertEqual(t, 50, y)
+}
+
+func TestTextBoxSeriesBox(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(200, 200)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	canvasBox := Box{Top: 0, Left: 0, Right: 200, Bottom: 100}
+	xrange := &ContinuousRange{Min: 0, Max: 10, Domain: 200}
+	yrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+
+	tbs := TextBoxSeries{Text: "note", Anchor: TextBoxAnchorBottomRight}
+	style := tbs.styleDefaults(Style{Font: f})
+	lines := []string{"note"}
+	box := tbs.getBox(r, canvasBox, xrange, yrange, style, lines)
+	testutil.AssertEqual(t, 190, box.Right)
+	testutil.AssertEqual(t, 90, box.Bottom)
+
+	// a box at values is kept within the canvas.
+	tbs = TextBoxSeries{Text: "note", AtValues: true, XValue: 10, YValue: 5}
+	box = tbs.getBox(r, canvasBox, xrange, yrange, style, lines)
+	testutil.AssertEqual(t, 200, box.Right)
+	testutil.AssertEqual(t, 50, box.Top)
+}
+
+func TestTextBoxSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, TextBoxSeries{}.Validate())
+	testutil.AssertNotNil(t, TextBoxSeries{Text: "note", Anchor: 10}.Validate())
+	testutil.AssertEqual(t, DefaultTextBoxMaxWidth, TextBoxSeries{}.GetMaxWidth())
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+			TextBoxSeries{Text: "data incomplete after 3pm", Anchor: TextBoxAnchorTopRight},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), ">data incomplete after 3pm</text>")
+}
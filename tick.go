@@ -3,6 +3,7 @@ package chart
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 )
 
@@ -44,6 +45,31 @@ func (t Ticks) String() string {
 	return strings.Join(values, ", ")
 }
 
+// tickLabelSpan returns the space a tick label takes along an axis: its height along a vertical axis, and
+// its width along a horizontal axis or, if the label is rotated, the horizontal distance between rotated
+// labels that keeps them from overlapping.
+func tickLabelSpan(r Renderer, label string, isVertical bool, style Style) int {
+	degrees := style.GetTextRotationDegrees()
+	if !isVertical {
+		style.TextRotationDegrees = 0
+	}
+	style.GetTextOptions().WriteToRenderer(r)
+	labelBox := r.MeasureText(label)
+	if isVertical {
+		return labelBox.Height()
+	}
+	if degrees == 0 {
+		return labelBox.Width()
+	}
+	radians := DegreesToRadians(degrees)
+	sin, cos := math.Abs(math.Sin(radians)), math.Abs(math.Cos(radians))
+	span := float64(labelBox.Width())*cos + float64(labelBox.Height())*sin
+	if sin > 0 {
+		span = math.Min(span, float64(labelBox.Height())/sin)
+	}
+	return int(math.Ceil(span))
+}
+
 // GenerateContinuousTicks generates a set of ticks.
 func GenerateContinuousTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) []Tick {
 	if vf == nil {
@@ -66,14 +92,13 @@ func GenerateContinuousTicks(r Renderer, ra Range, isVertical bool, style Style,
 	}
 
 	minLabel := vf(min)
-	style.GetTextOptions().WriteToRenderer(r)
-	labelBox := r.MeasureText(minLabel)
+	labelSpan := tickLabelSpan(r, minLabel, isVertical, style)
 
 	var tickSize float64
 	if isVertical {
-		tickSize = float64(labelBox.Height() + DefaultMinimumTickVerticalSpacing)
+		tickSize = float64(labelSpan + DefaultMinimumTickVerticalSpacing)
 	} else {
-		tickSize = float64(labelBox.Width() + DefaultMinimumTickHorizontalSpacing)
+		tickSize = float64(labelSpan + DefaultMinimumTickHorizontalSpacing)
 	}
 
 	domain := float64(ra.GetDomain())
@@ -113,3 +138,44 @@ func GenerateContinuousTicks(r Renderer, ra Range, isVertical bool, style Style,
 
 	return ticks
 }
+
+// GenerateMinorTicks returns unlabeled minor ticks evenly dividing the space between each pair of
+// major ticks into count + 1 parts, continuing past the first and last major ticks to the ends of the range.
+func GenerateMinorTicks(ticks []Tick, ra Range, count int) []Tick {
+	if count < 1 || len(ticks) < 2 {
+		return nil
+	}
+	values := make([]float64, len(ticks))
+	for index, t := range ticks {
+		values[index] = t.Value
+	}
+	sort.Float64s(values)
+	min, max := ra.GetMin(), ra.GetMax()
+	if min > max {
+		min, max = max, min
+	}
+
+	var minor Ticks
+	add := func(value float64) {
+		if value > min && value < max {
+			minor = append(minor, Tick{Value: value})
+		}
+	}
+	for index := 0; index < len(values)-1; index++ {
+		step := (values[index+1] - values[index]) / float64(count+1)
+		for subdivision := 1; subdivision <= count; subdivision++ {
+			add(values[index] + float64(subdivision)*step)
+		}
+	}
+
+	// past the ends, the minor ticks are spaced like those of the nearest interval.
+	last := len(values) - 1
+	firstStep := (values[1] - values[0]) / float64(count+1)
+	lastStep := (values[last] - values[last-1]) / float64(count+1)
+	for subdivision := 1; subdivision <= count; subdivision++ {
+		add(values[0] - float64(subdivision)*firstStep)
+		add(values[last] + float64(subdivision)*lastStep)
+	}
+	sort.Sort(minor)
+	return minor
+}
@@ -0,0 +1,370 @@
+package chart
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Interface Assertions.
+var (
+	_ TickGenerator = (*TickGeneratorFunc)(nil)
+	_ TickGenerator = (*ContinuousTickGenerator)(nil)
+	_ TickGenerator = (*NiceTickGenerator)(nil)
+	_ TickGenerator = (*LogTickGenerator)(nil)
+	_ TickGenerator = (*TimeBoundaryTickGenerator)(nil)
+	_ TickGenerator = (*TimeTickGenerator)(nil)
+	_ TickGenerator = (*FixedCountTickGenerator)(nil)
+	_ TickGenerator = (*ValuesTickGenerator)(nil)
+)
+
+// TickGenerator generates the ticks for an axis from its range; set one on an axis with `TickGenerator`
+// to replace the default tick spacing.
+type TickGenerator interface {
+	GenerateTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) []Tick
+}
+
+// TickGeneratorFunc is a function that implements TickGenerator.
+type TickGeneratorFunc func(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) []Tick
+
+// GenerateTicks implements TickGenerator.
+func (tgf TickGeneratorFunc) GenerateTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) []Tick {
+	return tgf(r, ra, isVertical, style, vf)
+}
+
+// ContinuousTickGenerator generates ticks with `GenerateContinuousTicks`, which is what axes do by default.
+type ContinuousTickGenerator struct{}
+
+// GenerateTicks implements TickGenerator.
+func (ContinuousTickGenerator) GenerateTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) []Tick {
+	return GenerateContinuousTicks(r, ra, isVertical, style, vf)
+}
+
+// NiceTickGenerator generates ticks on round steps (1, 2 or 5 times a power of ten), e.g. 0, 25, 50 or 0.2, 0.4, 0.6.
+// The ticks need not include the ends of the range.
+type NiceTickGenerator struct {
+	// Count is the most ticks to generate; if unset it is however many labels fit along the axis.
+	Count int
+}
+
+// GenerateTicks implements TickGenerator.
+func (ntg NiceTickGenerator) GenerateTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) []Tick {
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	min, max := ra.GetMin(), ra.GetMax()
+	if min > max {
+		min, max = max, min
+	}
+	if min == max {
+		return []Tick{{Value: min, Label: vf(min)}}
+	}
+
+	count := ntg.Count
+	if count == 0 {
+		count = tickCountForDomain(r, ra, isVertical, style, vf)
+	}
+	count = MaxInt(MinInt(count, DefaultTickCountSanityCheck), 2)
+
+	// the step is niceStep * 10^exponent; steps below one are kept as a
+	// division so that values like 0.3 come out exact.
+	exponent, niceStep := niceTickStep((max - min) / float64(count))
+	scale := math.Pow(10, math.Abs(float64(exponent)))
+	value := func(index float64) float64 {
+		if exponent < 0 {
+			return index * niceStep / scale
+		}
+		return index * niceStep * scale
+	}
+
+	var ticks []Tick
+	first := math.Ceil(min / value(1))
+	for index := first; value(index) <= max && len(ticks) < DefaultTickCountSanityCheck; index++ {
+		tickValue := value(index)
+		ticks = append(ticks, Tick{
+			Value: tickValue,
+			Label: vf(tickValue),
+		})
+	}
+	return ticks
+}
+
+// niceTickStep returns the smallest step of 1, 2 or 5 times a power of ten that is at least a given step.
+func niceTickStep(step float64) (exponent int, niceStep float64) {
+	exponent = int(math.Floor(math.Log10(step)))
+	normalized := step / math.Pow(10, float64(exponent))
+	for _, niceStep = range []float64{1, 2, 5} {
+		if normalized <= niceStep {
+			return
+		}
+	}
+	return exponent + 1, 1
+}
+
+// tickCountForDomain returns how many tick labels fit along an axis, as `GenerateContinuousTicks` measures it.
+func tickCountForDomain(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) int {
+	labelSpan := tickLabelSpan(r, vf(ra.GetMin()), isVertical, style)
+
+	var tickSize int
+	if isVertical {
+		tickSize = labelSpan + DefaultMinimumTickVerticalSpacing
+	} else {
+		tickSize = labelSpan + DefaultMinimumTickHorizontalSpacing
+	}
+	if tickSize <= 0 {
+		return DefaultTickCount
+	}
+	return ra.GetDomain() / tickSize
+}
+
+// LogTickGenerator generates a tick at each power of a base (10 by default) within the range, e.g. 1, 10, 100, 1000.
+// If the range starts at or below zero the ticks start at the zeroth power, i.e. 1.
+type LogTickGenerator struct {
+	Base float64
+}
+
+// GetBase returns the base or the default.
+func (ltg LogTickGenerator) GetBase() float64 {
+	if ltg.Base <= 1 {
+		return 10
+	}
+	return ltg.Base
+}
+
+// GenerateTicks implements TickGenerator.
+func (ltg LogTickGenerator) GenerateTicks(_ Renderer, ra Range, _ bool, _ Style, vf ValueFormatter) []Tick {
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	min, max := ra.GetMin(), ra.GetMax()
+	if min > max {
+		min, max = max, min
+	}
+	if max <= 0 {
+		return nil
+	}
+
+	base := ltg.GetBase()
+	logBase := math.Log(base)
+	// a small epsilon keeps exact powers (e.g. log10(1000) = 2.9999...) in range.
+	to := math.Floor(math.Log(max)/logBase + 1e-9)
+	from := math.Min(0, to)
+	if min > 0 {
+		from = math.Ceil(math.Log(min)/logBase - 1e-9)
+	}
+
+	var ticks []Tick
+	for power := from; power <= to && len(ticks) < DefaultTickCountSanityCheck; power++ {
+		tickValue := math.Pow(base, power)
+		ticks = append(ticks, Tick{
+			Value: tickValue,
+			Label: vf(tickValue),
+		})
+	}
+	return ticks
+}
+
+// TimeBoundaryTickGenerator generates a tick at each period boundary within a range of timestamps,
+// as produced by `TimeToFloat64`.
+type TimeBoundaryTickGenerator struct {
+	Boundary TimeBoundary
+	// ValueFormatter formats the tick labels; if unset it is the boundary's own formatter.
+	ValueFormatter ValueFormatter
+}
+
+// GenerateTicks implements TickGenerator.
+func (tbtg TimeBoundaryTickGenerator) GenerateTicks(_ Renderer, ra Range, _ bool, _ Style, _ ValueFormatter) []Tick {
+	return GenerateTimeBoundaryTicks(ra, tbtg.Boundary, tbtg.ValueFormatter)
+}
+
+// TimeTickGenerator generates ticks on natural time steps within a range of timestamps, as produced by
+// `TimeToFloat64`, e.g. every 15 minutes, every 6 hours, every day, every month or every year, using the
+// smallest step whose labels fit along the axis. The labels are formatted for the step, e.g. "3:15PM" for
+// minutes or "Jan 2006" for months.
+//
+// Charts use it for the x-axis of time series unless the axis has ticks or a tick generator of its own.
+type TimeTickGenerator struct {
+	// Count is the most ticks to generate; if unset it is however many labels fit along the axis.
+	Count int
+	// ValueFormatter formats the tick labels; if unset they are formatted for the step.
+	ValueFormatter ValueFormatter
+}
+
+// timeTickStep is a natural step between time ticks; exactly one of the fields other than format is set.
+type timeTickStep struct {
+	duration time.Duration
+	days     int
+	months   int
+	years    int
+	format   string
+}
+
+var timeTickSteps = []timeTickStep{
+	{duration: time.Second, format: "3:04:05PM"},
+	{duration: 5 * time.Second, format: "3:04:05PM"},
+	{duration: 15 * time.Second, format: "3:04:05PM"},
+	{duration: 30 * time.Second, format: "3:04:05PM"},
+	{duration: time.Minute, format: "3:04PM"},
+	{duration: 5 * time.Minute, format: "3:04PM"},
+	{duration: 15 * time.Minute, format: "3:04PM"},
+	{duration: 30 * time.Minute, format: "3:04PM"},
+	{duration: time.Hour, format: DefaultDateHourFormat},
+	{duration: 3 * time.Hour, format: DefaultDateHourFormat},
+	{duration: 6 * time.Hour, format: DefaultDateHourFormat},
+	{duration: 12 * time.Hour, format: DefaultDateHourFormat},
+	{days: 1, format: "Jan 2"},
+	{days: 2, format: "Jan 2"},
+	{days: 7, format: "Jan 2"},
+	{months: 1, format: "Jan 2006"},
+	{months: 3, format: "Jan 2006"},
+	{months: 6, format: "Jan 2006"},
+	{years: 1, format: "2006"},
+	{years: 2, format: "2006"},
+	{years: 5, format: "2006"},
+	{years: 10, format: "2006"},
+	{years: 25, format: "2006"},
+	{years: 50, format: "2006"},
+	{years: 100, format: "2006"},
+}
+
+// approximate returns the length of the step, taking months and years at their average length.
+func (tts timeTickStep) approximate() float64 {
+	const day = 24 * time.Hour
+	switch {
+	case tts.days > 0:
+		return float64(time.Duration(tts.days) * day)
+	case tts.months > 0:
+		return float64(tts.months) * 30.44 * float64(day)
+	case tts.years > 0:
+		return float64(tts.years) * 365.25 * float64(day)
+	}
+	return float64(tts.duration)
+}
+
+// start returns the first step boundary at or before a given time.
+func (tts timeTickStep) start(t time.Time) time.Time {
+	switch {
+	case tts.days == 7:
+		return TimeBoundaryWeek.Start(t)
+	case tts.days > 0:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	case tts.months > 0:
+		month := (int(t.Month())-1)/tts.months*tts.months + 1
+		return time.Date(t.Year(), time.Month(month), 1, 0, 0, 0, 0, t.Location())
+	case tts.years > 0:
+		return time.Date(t.Year()/tts.years*tts.years, time.January, 1, 0, 0, 0, 0, t.Location())
+	}
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return day.Add(t.Sub(day) / tts.duration * tts.duration)
+}
+
+// next returns the step boundary after a given step boundary.
+func (tts timeTickStep) next(t time.Time) time.Time {
+	if tts.duration > 0 {
+		return t.Add(tts.duration)
+	}
+	return t.AddDate(tts.years, tts.months, tts.days)
+}
+
+// GenerateTicks implements TickGenerator.
+func (ttg TimeTickGenerator) GenerateTicks(r Renderer, ra Range, isVertical bool, style Style, _ ValueFormatter) []Tick {
+	min, max := ra.GetMin(), ra.GetMax()
+	if min > max {
+		min, max = max, min
+	}
+
+	step := timeTickSteps[len(timeTickSteps)-1]
+	vf := ttg.ValueFormatter
+	for _, candidate := range timeTickSteps {
+		candidateFormatter := vf
+		if candidateFormatter == nil {
+			candidateFormatter = TimeValueFormatterWithFormat(candidate.format)
+		}
+		count := ttg.Count
+		if count == 0 {
+			count = tickCountForDomain(r, ra, isVertical, style, candidateFormatter)
+		}
+		if (max-min)/candidate.approximate() < float64(MaxInt(count, 1)) {
+			step = candidate
+			break
+		}
+	}
+	if vf == nil {
+		vf = TimeValueFormatterWithFormat(step.format)
+	}
+
+	var ticks []Tick
+	cursor := step.start(TimeFromFloat64(min))
+	if TimeToFloat64(cursor) < min {
+		cursor = step.next(cursor)
+	}
+	for TimeToFloat64(cursor) <= max && len(ticks) < DefaultTickCountSanityCheck {
+		value := TimeToFloat64(cursor)
+		ticks = append(ticks, Tick{
+			Value: value,
+			Label: vf(value),
+		})
+		cursor = step.next(cursor)
+	}
+	return ticks
+}
+
+// FixedCountTickGenerator generates a fixed number of evenly spaced ticks, including both ends of the range.
+type FixedCountTickGenerator struct {
+	Count int
+}
+
+// GetCount returns the count or the default.
+func (fctg FixedCountTickGenerator) GetCount() int {
+	if fctg.Count < 2 {
+		return DefaultTickCount
+	}
+	return fctg.Count
+}
+
+// GenerateTicks implements TickGenerator.
+func (fctg FixedCountTickGenerator) GenerateTicks(_ Renderer, ra Range, _ bool, _ Style, vf ValueFormatter) []Tick {
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	min, max := ra.GetMin(), ra.GetMax()
+	count := MinInt(fctg.GetCount(), DefaultTickCountSanityCheck)
+	ticks := make([]Tick, count)
+	for index := range ticks {
+		tickValue := min + (max-min)*float64(index)/float64(count-1)
+		ticks[index] = Tick{
+			Value: tickValue,
+			Label: vf(tickValue),
+		}
+	}
+	return ticks
+}
+
+// ValuesTickGenerator generates a tick at each of a list of values that falls within the range,
+// labelled with the axis value formatter.
+type ValuesTickGenerator struct {
+	Values []float64
+}
+
+// GenerateTicks implements TickGenerator.
+func (vtg ValuesTickGenerator) GenerateTicks(_ Renderer, ra Range, _ bool, _ Style, vf ValueFormatter) []Tick {
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	min, max := ra.GetMin(), ra.GetMax()
+	if min > max {
+		min, max = max, min
+	}
+	var ticks Ticks
+	for _, value := range vtg.Values {
+		if value < min || value > max {
+			continue
+		}
+		ticks = append(ticks, Tick{
+			Value: value,
+			Label: vf(value),
+		})
+	}
+	sort.Sort(ticks)
+	return ticks
+}
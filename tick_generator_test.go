@@ -0,0 +1,134 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func tickValues(ticks []Tick) []float64 {
+	var values []float64
+	for _, t := range ticks {
+		values = append(values, t.Value)
+	}
+	return values
+}
+
+func TestNiceTickGenerator(t *testing.T) {
+	// replaced new assertions helper
+
+	ra := &ContinuousRange{Min: 3, Max: 97, Domain: 512}
+	ticks := NiceTickGenerator{Count: 5}.GenerateTicks(nil, ra, false, Style{}, nil)
+	testutil.AssertEqual(t, []float64{20, 40, 60, 80}, tickValues(ticks))
+
+	ticks = NiceTickGenerator{Count: 6}.GenerateTicks(nil, &ContinuousRange{Min: 0, Max: 0.6}, false, Style{}, nil)
+	testutil.AssertEqual(t, []float64{0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6}, tickValues(ticks))
+	testutil.AssertEqual(t, "0.30", ticks[3].Label)
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+	r.SetFont(f)
+	ticks = NiceTickGenerator{}.GenerateTicks(r, ra, true, Style{FontSize: 10}, nil)
+	testutil.AssertNotEmpty(t, ticks)
+	for _, tick := range ticks {
+		testutil.AssertTrue(t, tick.Value >= 3 && tick.Value <= 97)
+	}
+}
+
+func TestNiceTickStep(t *testing.T) {
+	// replaced new assertions helper
+
+	exponent, step := niceTickStep(23)
+	testutil.AssertEqual(t, 1, exponent)
+	testutil.AssertEqual(t, 5.0, step)
+
+	exponent, step = niceTickStep(0.06)
+	testutil.AssertEqual(t, -1, exponent)
+	testutil.AssertEqual(t, 1.0, step)
+}
+
+func TestLogTickGenerator(t *testing.T) {
+	// replaced new assertions helper
+
+	ticks := LogTickGenerator{}.GenerateTicks(nil, &ContinuousRange{Min: 0, Max: 1000}, false, Style{}, nil)
+	testutil.AssertEqual(t, []float64{1, 10, 100, 1000}, tickValues(ticks))
+
+	ticks = LogTickGenerator{}.GenerateTicks(nil, &ContinuousRange{Min: 0.05, Max: 500}, false, Style{}, nil)
+	testutil.AssertEqual(t, []float64{0.1, 1, 10, 100}, tickValues(ticks))
+
+	ticks = LogTickGenerator{Base: 2}.GenerateTicks(nil, &ContinuousRange{Min: 3, Max: 20}, false, Style{}, nil)
+	testutil.AssertEqual(t, []float64{4, 8, 16}, tickValues(ticks))
+
+	testutil.AssertEmpty(t, LogTickGenerator{}.GenerateTicks(nil, &ContinuousRange{Min: -10, Max: -1}, false, Style{}, nil))
+}
+
+func TestTimeBoundaryTickGenerator(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC)
+	ra := &ContinuousRange{Min: TimeToFloat64(start), Max: TimeToFloat64(start.AddDate(0, 3, 0))}
+	ticks := TimeBoundaryTickGenerator{Boundary: TimeBoundaryMonth}.GenerateTicks(nil, ra, false, Style{}, nil)
+	testutil.AssertLen(t, ticks, 3)
+	testutil.AssertEqual(t, TimeToFloat64(time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)), ticks[0].Value)
+}
+
+func TestTimeTickGenerator(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2020, 1, 15, 9, 13, 0, 0, time.Local)
+	ra := &ContinuousRange{Min: TimeToFloat64(start), Max: TimeToFloat64(start.Add(50 * time.Minute))}
+	ticks := TimeTickGenerator{Count: 5}.GenerateTicks(nil, ra, false, Style{}, nil)
+	testutil.AssertLen(t, ticks, 4)
+	testutil.AssertEqual(t, TimeToFloat64(time.Date(2020, 1, 15, 9, 15, 0, 0, time.Local)), ticks[0].Value)
+	testutil.AssertEqual(t, "9:30AM", ticks[1].Label)
+
+	ra = &ContinuousRange{Min: TimeToFloat64(start), Max: TimeToFloat64(start.AddDate(0, 5, 0))}
+	ticks = TimeTickGenerator{Count: 8}.GenerateTicks(nil, ra, false, Style{}, nil)
+	testutil.AssertLen(t, ticks, 5)
+	testutil.AssertEqual(t, "Feb 2020", ticks[0].Label)
+	testutil.AssertEqual(t, "Jun 2020", ticks[4].Label)
+
+	ticks = TimeTickGenerator{Count: 8, ValueFormatter: TimeDateValueFormatter}.GenerateTicks(nil, ra, false, Style{}, nil)
+	testutil.AssertEqual(t, TimeDateValueFormatter(ticks[0].Value), ticks[0].Label)
+}
+
+func TestFixedCountTickGenerator(t *testing.T) {
+	// replaced new assertions helper
+
+	ticks := FixedCountTickGenerator{Count: 3}.GenerateTicks(nil, &ContinuousRange{Min: 1, Max: 2}, false, Style{}, nil)
+	testutil.AssertEqual(t, []float64{1, 1.5, 2}, tickValues(ticks))
+	testutil.AssertEqual(t, DefaultTickCount, FixedCountTickGenerator{}.GetCount())
+}
+
+func TestValuesTickGenerator(t *testing.T) {
+	// replaced new assertions helper
+
+	ticks := ValuesTickGenerator{Values: []float64{5, -1, 2, 11}}.GenerateTicks(nil, &ContinuousRange{Min: 0, Max: 10}, false, Style{}, nil)
+	testutil.AssertEqual(t, []float64{2, 5}, tickValues(ticks))
+	testutil.AssertEqual(t, "2.00", ticks[0].Label)
+}
+
+func TestAxisTickGenerator(t *testing.T) {
+	// replaced new assertions helper
+
+	ra := &ContinuousRange{Min: 0, Max: 10}
+	generator := ValuesTickGenerator{Values: []float64{1, 2}}
+
+	ticks := XAxis{TickGenerator: generator}.GetTicks(nil, ra, Style{}, nil)
+	testutil.AssertEqual(t, []float64{1, 2}, tickValues(ticks))
+	ticks = YAxis{TickGenerator: generator}.GetTicks(nil, ra, Style{}, nil)
+	testutil.AssertEqual(t, []float64{1, 2}, tickValues(ticks))
+	ticks = YAxis{TickGenerator: generator, Ticks: []Tick{{Value: 3}}}.GetTicks(nil, ra, Style{}, nil)
+	testutil.AssertEqual(t, []float64{3}, tickValues(ticks))
+
+	var vertical bool
+	custom := TickGeneratorFunc(func(_ Renderer, _ Range, isVertical bool, _ Style, _ ValueFormatter) []Tick {
+		vertical = isVertical
+		return nil
+	})
+	YAxis{TickGenerator: custom}.GetTicks(nil, ra, Style{}, nil)
+	testutil.AssertTrue(t, vertical)
+}
@@ -0,0 +1,84 @@
+package chart
+
+import (
+	"bytes"
+	"text/template"
+	"time"
+)
+
+// TickTemplateData is what a tick label template is executed with.
+type TickTemplateData struct {
+	// Value is the value of the tick.
+	Value float64
+	// Label is the label the tick would have had without the template.
+	Label string
+	// Index is the position of the tick on the axis.
+	Index int
+	// Range is the range of the axis.
+	Range Range
+	// Previous is the tick before this one, if there is one.
+	Previous *Tick
+}
+
+// HasPrevious returns if there is a tick before this one.
+func (ttd TickTemplateData) HasPrevious() bool {
+	return ttd.Previous != nil
+}
+
+// Time returns the value of the tick as a time, for ticks of a time axis.
+func (ttd TickTemplateData) Time() time.Time {
+	return TimeFromFloat64(ttd.Value)
+}
+
+// PreviousTime returns the value of the previous tick as a time, or the zero time if there is no previous tick.
+func (ttd TickTemplateData) PreviousTime() time.Time {
+	if ttd.Previous == nil {
+		return time.Time{}
+	}
+	return TimeFromFloat64(ttd.Previous.Value)
+}
+
+// DayChanged returns if the tick is on a different day from the previous tick, or if it is the first tick.
+func (ttd TickTemplateData) DayChanged() bool {
+	if ttd.Previous == nil {
+		return true
+	}
+	current, previous := ttd.Time(), ttd.PreviousTime()
+	return current.Year() != previous.Year() || current.YearDay() != previous.YearDay()
+}
+
+// NewTickTemplate parses a tick label template, e.g. to label a time axis with the date only where the day changes:
+//
+//	{{if .DayChanged}}{{.Time.Format "Jan 2"}}{{else}}{{.Time.Format "15:04"}}{{end}}
+//
+// The template is executed with `TickTemplateData`.
+func NewTickTemplate(text string) (*template.Template, error) {
+	return template.New("tick").Parse(text)
+}
+
+// ApplyTickTemplate returns a copy of a set of ticks labelled by a template.
+// A tick keeps its label if the template fails to execute for it.
+func ApplyTickTemplate(ticks []Tick, ra Range, tmpl *template.Template) []Tick {
+	if tmpl == nil || len(ticks) == 0 {
+		return ticks
+	}
+	labelled := make([]Tick, len(ticks))
+	buffer := bytes.NewBuffer(nil)
+	for index, t := range ticks {
+		data := TickTemplateData{
+			Value: t.Value,
+			Label: t.Label,
+			Index: index,
+			Range: ra,
+		}
+		if index > 0 {
+			data.Previous = &ticks[index-1]
+		}
+		labelled[index] = t
+		buffer.Reset()
+		if err := tmpl.Execute(buffer, data); err == nil {
+			labelled[index].Label = buffer.String()
+		}
+	}
+	return labelled
+}
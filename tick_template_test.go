@@ -0,0 +1,52 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestApplyTickTemplate(t *testing.T) {
+	// replaced new assertions helper
+
+	tmpl, err := NewTickTemplate(`{{.Index}}:{{.Label}}{{if .HasPrevious}}<{{.Previous.Label}}{{end}}/{{.Range.GetMax}}`)
+	testutil.AssertNil(t, err)
+
+	ticks := []Tick{{Value: 1, Label: "one"}, {Value: 2, Label: "two"}}
+	labelled := ApplyTickTemplate(ticks, &ContinuousRange{Min: 0, Max: 3}, tmpl)
+	testutil.AssertEqual(t, "0:one/3", labelled[0].Label)
+	testutil.AssertEqual(t, "1:two<one/3", labelled[1].Label)
+	testutil.AssertEqual(t, 2.0, labelled[1].Value)
+	testutil.AssertEqual(t, "one", ticks[0].Label)
+
+	testutil.AssertEqual(t, ticks, ApplyTickTemplate(ticks, nil, nil))
+
+	_, err = NewTickTemplate(`{{.Value`)
+	testutil.AssertNotNil(t, err)
+
+	failing, err := NewTickTemplate(`{{.Missing}}`)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, "one", ApplyTickTemplate(ticks, nil, failing)[0].Label)
+}
+
+func TestApplyTickTemplateDayChanged(t *testing.T) {
+	// replaced new assertions helper
+
+	tmpl, err := NewTickTemplate(`{{if .DayChanged}}{{.Time.Format "Jan 2"}}{{else}}{{.Time.Format "15:04"}}{{end}}`)
+	testutil.AssertNil(t, err)
+
+	start := time.Date(2020, 3, 1, 12, 0, 0, 0, time.Local)
+	var ticks []Tick
+	for _, hours := range []int{0, 6, 12, 18} {
+		ticks = append(ticks, Tick{Value: TimeToFloat64(start.Add(time.Duration(hours) * time.Hour))})
+	}
+	labelled := XAxis{TickTemplate: tmpl, Ticks: ticks}.GetTicks(nil, nil, Style{}, nil)
+	testutil.AssertEqual(t, "Mar 1", labelled[0].Label)
+	testutil.AssertEqual(t, "18:00", labelled[1].Label)
+	testutil.AssertEqual(t, "Mar 2", labelled[2].Label)
+	testutil.AssertEqual(t, "06:00", labelled[3].Label)
+
+	labelled = YAxis{TickTemplate: tmpl, Ticks: ticks}.GetTicks(nil, nil, Style{}, nil)
+	testutil.AssertEqual(t, "Mar 1", labelled[0].Label)
+}
@@ -58,3 +58,15 @@ func TestGenerateContinuousTicksDescending(t *testing.T) {
 	testutil.AssertEqual(t, 1.0, ticks[len(ticks)-2].Value)
 	testutil.AssertEqual(t, 0.0, ticks[len(ticks)-1].Value)
 }
+
+func TestGenerateMinorTicks(t *testing.T) {
+	// replaced new assertions helper
+
+	ticks := []Tick{{Value: 20, Label: "20"}, {Value: 10, Label: "10"}, {Value: 30, Label: "30"}}
+	minor := GenerateMinorTicks(ticks, &ContinuousRange{Min: 5, Max: 33}, 4)
+	testutil.AssertEqual(t, []float64{6, 8, 12, 14, 16, 18, 22, 24, 26, 28, 32}, tickValues(minor))
+	testutil.AssertEqual(t, "", minor[0].Label)
+
+	testutil.AssertEmpty(t, GenerateMinorTicks(ticks, &ContinuousRange{Min: 5, Max: 33}, 0))
+	testutil.AssertEmpty(t, GenerateMinorTicks(ticks[:1], &ContinuousRange{Min: 5, Max: 33}, 4))
+}
@@ -0,0 +1,100 @@
+package chart
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeBoundary is an enum for the calendar periods a time axis can emphasize.
+type TimeBoundary int
+
+const (
+	// TimeBoundaryUnset is the unset state for time boundaries; no boundaries are drawn.
+	TimeBoundaryUnset TimeBoundary = 0
+	// TimeBoundaryWeek emphasizes the start of each week (mondays).
+	TimeBoundaryWeek TimeBoundary = 1
+	// TimeBoundaryMonth emphasizes the start of each month.
+	TimeBoundaryMonth TimeBoundary = 2
+	// TimeBoundaryQuarter emphasizes the start of each quarter.
+	TimeBoundaryQuarter TimeBoundary = 3
+)
+
+// Start returns the start of the period that contains a given time.
+func (tb TimeBoundary) Start(t time.Time) time.Time {
+	switch tb {
+	case TimeBoundaryWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		offset := (int(day.Weekday()) + 6) % 7 // days since monday
+		return day.AddDate(0, 0, -offset)
+	case TimeBoundaryMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	case TimeBoundaryQuarter:
+		month := ((t.Month()-1)/3)*3 + 1
+		return time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location())
+	}
+	return t
+}
+
+// Next returns the start of the period following the period that contains a given time.
+func (tb TimeBoundary) Next(t time.Time) time.Time {
+	start := tb.Start(t)
+	switch tb {
+	case TimeBoundaryWeek:
+		return start.AddDate(0, 0, 7)
+	case TimeBoundaryMonth:
+		return start.AddDate(0, 1, 0)
+	case TimeBoundaryQuarter:
+		return start.AddDate(0, 3, 0)
+	}
+	return t
+}
+
+// ValueFormatter returns the default label formatter for the boundary.
+func (tb TimeBoundary) ValueFormatter() ValueFormatter {
+	switch tb {
+	case TimeBoundaryWeek:
+		return TimeValueFormatterWithFormat("Jan 2")
+	case TimeBoundaryMonth:
+		return TimeValueFormatterWithFormat("Jan 2006")
+	case TimeBoundaryQuarter:
+		return func(v interface{}) string {
+			if typed, isTyped := v.(float64); isTyped {
+				t := TimeFromFloat64(typed)
+				return fmt.Sprintf("Q%d %d", (t.Month()-1)/3+1, t.Year())
+			}
+			return ""
+		}
+	}
+	return TimeValueFormatter
+}
+
+// GenerateTimeBoundaryTicks returns a tick for each period boundary that falls within a range of
+// timestamps (as produced by `TimeToFloat64`).
+func GenerateTimeBoundaryTicks(ra Range, boundary TimeBoundary, vf ValueFormatter) []Tick {
+	if boundary < TimeBoundaryWeek || boundary > TimeBoundaryQuarter || ra == nil || ra.GetDelta() == 0 {
+		return nil
+	}
+	if vf == nil {
+		vf = boundary.ValueFormatter()
+	}
+
+	min, max := ra.GetMin(), ra.GetMax()
+	if min > max {
+		min, max = max, min
+	}
+
+	var ticks []Tick
+	cursor := boundary.Start(TimeFromFloat64(min))
+	if TimeToFloat64(cursor) < min {
+		cursor = boundary.Next(cursor)
+	}
+	for TimeToFloat64(cursor) <= max && len(ticks) < DefaultTickCountSanityCheck {
+		value := TimeToFloat64(cursor)
+		ticks = append(ticks, Tick{
+			Value: value,
+			Label: vf(value),
+		})
+		cursor = boundary.Next(cursor)
+	}
+	return ticks
+}
@@ -0,0 +1,38 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestTimeBoundaryStart(t *testing.T) {
+	// replaced new assertions helper
+
+	ts := time.Date(2020, 5, 14, 13, 12, 11, 0, time.UTC) // a thursday
+	testutil.AssertEqual(t, time.Date(2020, 5, 11, 0, 0, 0, 0, time.UTC), TimeBoundaryWeek.Start(ts))
+	testutil.AssertEqual(t, time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC), TimeBoundaryMonth.Start(ts))
+	testutil.AssertEqual(t, time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC), TimeBoundaryQuarter.Start(ts))
+	testutil.AssertEqual(t, time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC), TimeBoundaryQuarter.Next(ts))
+}
+
+func TestGenerateTimeBoundaryTicks(t *testing.T) {
+	// replaced new assertions helper
+
+	start := time.Date(2020, 1, 15, 0, 0, 0, 0, time.Local)
+	end := time.Date(2020, 7, 15, 0, 0, 0, 0, time.Local)
+	xr := &ContinuousRange{Min: TimeToFloat64(start), Max: TimeToFloat64(end), Domain: 1024}
+
+	monthly := GenerateTimeBoundaryTicks(xr, TimeBoundaryMonth, nil)
+	testutil.AssertLen(t, monthly, 6)
+	testutil.AssertEqual(t, "Feb 2020", monthly[0].Label)
+
+	quarterly := GenerateTimeBoundaryTicks(xr, TimeBoundaryQuarter, nil)
+	testutil.AssertLen(t, quarterly, 2)
+	testutil.AssertEqual(t, "Q2 2020", quarterly[0].Label)
+	testutil.AssertEqual(t, "Q3 2020", quarterly[1].Label)
+
+	testutil.AssertEmpty(t, GenerateTimeBoundaryTicks(xr, TimeBoundaryUnset, nil))
+	testutil.AssertEmpty(t, GenerateTimeBoundaryTicks(xr, TimeBoundary(42), nil))
+}
@@ -48,6 +48,9 @@ func (ts TimeSeries) GetValues(index int) (x, y float64) {
 
 // GetFirstValues gets the first values.
 func (ts TimeSeries) GetFirstValues() (x, y float64) {
+	if len(ts.XValues) == 0 || len(ts.YValues) == 0 {
+		return
+	}
 	x = TimeToFloat64(ts.XValues[0])
 	y = ts.YValues[0]
 	return
@@ -55,6 +58,9 @@ func (ts TimeSeries) GetFirstValues() (x, y float64) {
 
 // GetLastValues gets the last values.
 func (ts TimeSeries) GetLastValues() (x, y float64) {
+	if len(ts.XValues) == 0 || len(ts.YValues) == 0 {
+		return
+	}
 	x = TimeToFloat64(ts.XValues[len(ts.XValues)-1])
 	y = ts.YValues[len(ts.YValues)-1]
 	return
@@ -89,3 +95,10 @@ func (ts TimeSeries) Validate() error {
 	}
 	return nil
 }
+
+// CopySeries returns a copy of the series that does not share its values with the original.
+func (ts TimeSeries) CopySeries() Series {
+	ts.XValues = append([]time.Time(nil), ts.XValues...)
+	ts.YValues = append([]float64(nil), ts.YValues...)
+	return ts
+}
@@ -0,0 +1,134 @@
+package chart
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Tracer is a type that receives timing and size information for each stage of a render.
+type Tracer interface {
+	Trace(TraceInfo)
+}
+
+// TracerFunc is a function that implements Tracer.
+type TracerFunc func(TraceInfo)
+
+// Trace implements Tracer.
+func (tf TracerFunc) Trace(ti TraceInfo) {
+	tf(ti)
+}
+
+// ContextTracer is a Tracer that can attribute render stages to spans, such as an adapter
+// that creates OpenTelemetry spans as children of the span active in the context.
+//
+// When rendering with `Chart.RenderContext`, a `RenderStageRender` span is started for the whole
+// render as a child of the span in the context, and a span is started for each stage as a child
+// of the render span. The function returned ends the span with the trace info of the stage.
+// When rendering with `Chart.Render` it is sent traces as a plain Tracer.
+type ContextTracer interface {
+	Tracer
+	StartStage(ctx context.Context, stage RenderStage) (context.Context, func(TraceInfo))
+}
+
+// TraceInfo describes a completed render stage.
+type TraceInfo struct {
+	Stage   RenderStage
+	Started time.Time
+	Elapsed time.Duration
+
+	// SeriesIndex, SeriesName and Values are set for `RenderStageSeries`.
+	SeriesIndex int
+	SeriesName  string
+	Values      int
+
+	// Canvas is the canvas box as of the end of the stage.
+	Canvas Box
+
+	// Bytes is the number of bytes written, set for `RenderStageEncode`.
+	Bytes int
+
+	// Err is the error the render returned, set for `RenderStageRender`.
+	Err error
+}
+
+// Trace sends trace info to a tracer if it is set.
+func Trace(tracer Tracer, info TraceInfo) {
+	if tracer == nil {
+		return
+	}
+	info.Elapsed = time.Since(info.Started)
+	tracer.Trace(info)
+}
+
+// traceSeriesInfo returns the trace info for a series.
+func traceSeriesInfo(index int, s Series, canvasBox Box) TraceInfo {
+	info := TraceInfo{
+		SeriesIndex: index,
+		SeriesName:  s.GetName(),
+		Canvas:      canvasBox,
+	}
+	if vp, isValuesProvider := s.(ValuesProvider); isValuesProvider {
+		info.Values = vp.Len()
+	} else if bvp, isBoundedValuesProvider := s.(BoundedValuesProvider); isBoundedValuesProvider {
+		info.Values = bvp.Len()
+	}
+	return info
+}
+
+// countingWriter is an io.Writer that counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+// Write implements io.Writer.
+func (cw *countingWriter) Write(buffer []byte) (int, error) {
+	n, err := cw.w.Write(buffer)
+	cw.n += n
+	return n, err
+}
+
+// contextTracer binds the context of the render span to a ContextTracer for the duration of a render.
+type contextTracer struct {
+	ctx    context.Context
+	tracer ContextTracer
+}
+
+// Trace implements Tracer.
+func (ct contextTracer) Trace(ti TraceInfo) {
+	ct.tracer.Trace(ti)
+}
+
+// stageSpan is a render stage in progress.
+type stageSpan struct {
+	tracer  Tracer
+	stage   RenderStage
+	started time.Time
+	end     func(TraceInfo)
+}
+
+// startStage starts a render stage; with a context tracer, it starts a span for the stage.
+func startStage(tracer Tracer, stage RenderStage) stageSpan {
+	span := stageSpan{tracer: tracer, stage: stage, started: time.Now()}
+	if typed, isTyped := tracer.(contextTracer); isTyped {
+		_, span.end = typed.tracer.StartStage(typed.ctx, stage)
+	}
+	return span
+}
+
+// End ends the stage, ending its span or sending its trace info to the tracer. Ending a stage that
+// has already ended, or was never started, does nothing.
+func (ss *stageSpan) End(info TraceInfo) {
+	if ss.started.IsZero() {
+		return
+	}
+	info.Stage, info.Started = ss.stage, ss.started
+	if ss.end != nil {
+		info.Elapsed = time.Since(ss.started)
+		ss.end(info)
+	} else {
+		Trace(ss.tracer, info)
+	}
+	*ss = stageSpan{}
+}
@@ -0,0 +1,282 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// TreeMapNode is a node of a tree map, weighted by its value, or if it has children and no value the
+// sum of their values.
+type TreeMapNode struct {
+	Label    string
+	Value    float64
+	Style    Style
+	Children []TreeMapNode
+}
+
+// GetValue returns the value of the node, or the sum of the values of its children if it is unset.
+func (tmn TreeMapNode) GetValue() float64 {
+	if tmn.Value != 0 || len(tmn.Children) == 0 {
+		return tmn.Value
+	}
+	var total float64
+	for _, child := range tmn.Children {
+		total += child.GetValue()
+	}
+	return total
+}
+
+// TreeMap is a chart that draws hierarchical nodes as nested rectangles, each with an area in
+// proportion to its value, laid out with the squarified algorithm to keep them close to square.
+// Each node with children has its label in a strip along its top, above its children.
+type TreeMap struct {
+	ChartFrame
+
+	NodeStyle  Style
+	LabelStyle Style
+
+	// Padding is the pixel distance between the edges of a node and its children.
+	Padding int
+	// MaxDepth limits the levels of nodes drawn, the top level being depth 1; it is unlimited if unset.
+	MaxDepth int
+	// ColorByDepth colors nodes by their depth in the tree, rather than by their top level node
+	// in lighter shades for each level down.
+	ColorByDepth bool
+
+	Nodes    []TreeMapNode
+	Elements []Renderable
+}
+
+// GetPadding returns the padding between nodes and their children or the default.
+func (tm TreeMap) GetPadding() int {
+	if tm.Padding == 0 {
+		return DefaultTreeMapPadding
+	}
+	return tm.Padding
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (tm TreeMap) Render(rp RendererProvider, w io.Writer) error {
+	if len(tm.Nodes) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one node"))
+	}
+	if err := tm.validateNodes(tm.Nodes); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	width, height := tm.GetWidth(), tm.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if tm.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		tm.defaultFont = defaultFont
+	}
+	r.SetDPI(tm.GetDPI(DefaultDPI))
+
+	canvasBox := tm.getDefaultCanvasBox(r, width, height)
+
+	tm.drawBackground(r, width, height)
+	tm.drawCanvas(r, canvasBox)
+	tm.drawNodes(r, tm.Nodes, canvasBox, 1, drawing.Color{})
+	tm.drawTitle(r, width, height)
+	for _, a := range tm.Elements {
+		a(r, canvasBox, tm.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+func (tm TreeMap) validateNodes(nodes []TreeMapNode) error {
+	var total float64
+	for _, node := range nodes {
+		if node.GetValue() < 0 {
+			return fmt.Errorf("tree map values cannot be negative")
+		}
+		total += node.GetValue()
+		if err := tm.validateNodes(node.Children); err != nil {
+			return err
+		}
+	}
+	if len(nodes) > 0 && total == 0 {
+		return fmt.Errorf("tree map nodes must contain at least (1) non-zero value")
+	}
+	return nil
+}
+
+// drawNodes lays out nodes within a box and draws them, and then recursively their children within
+// them, below a strip for their label.
+func (tm TreeMap) drawNodes(r Renderer, nodes []TreeMapNode, box Box, depth int, parentColor drawing.Color) {
+	labelStyle := tm.styleDefaultsLabels()
+	labelHeight := Draw.MeasureText(r, "M", labelStyle).Height() + 2*DefaultTreeMapLabelPadding
+
+	boxes := tm.getNodeBoxes(nodes, box)
+	for index, node := range nodes {
+		nodeBox := boxes[index]
+		if nodeBox.Width() <= 0 || nodeBox.Height() <= 0 {
+			continue
+		}
+		color := tm.getNodeColor(index, depth, parentColor)
+		style := node.Style.InheritFrom(tm.NodeStyle.InheritFrom(Style{
+			FillColor:   color,
+			StrokeColor: tm.GetColorPalette().BackgroundColor(),
+			StrokeWidth: 1,
+		}))
+		Draw.Box(r, nodeBox, style)
+
+		drawChildren := len(node.Children) > 0 && (tm.MaxDepth == 0 || depth < tm.MaxDepth)
+		padding := tm.GetPadding()
+		childBox := nodeBox.Inset(Box{Top: padding, Left: padding, Right: padding, Bottom: padding})
+		if drawChildren && len(node.Label) > 0 && !tm.LabelStyle.Hidden {
+			childBox.Top = nodeBox.Top + labelHeight
+		}
+		if drawChildren && childBox.Width() > 0 && childBox.Height() > 0 {
+			tm.drawNodes(r, node.Children, childBox, depth+1, color)
+		}
+		tm.drawLabel(r, node.Label, nodeBox, style)
+	}
+}
+
+// drawLabel draws a label in the top left of a node, if it fits.
+func (tm TreeMap) drawLabel(r Renderer, label string, nodeBox Box, style Style) {
+	if tm.LabelStyle.Hidden || len(label) == 0 {
+		return
+	}
+	labelStyle := tm.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: heatMapTextColor(style.GetFillColor()),
+		Font:      tm.GetFont(),
+	})
+	tb := Draw.MeasureText(r, label, labelStyle)
+	if tb.Width()+2*DefaultTreeMapLabelPadding > nodeBox.Width() || tb.Height()+2*DefaultTreeMapLabelPadding > nodeBox.Height() {
+		return
+	}
+	Draw.Text(r, label, nodeBox.Left+DefaultTreeMapLabelPadding, nodeBox.Top+DefaultTreeMapLabelPadding+tb.Height(), labelStyle)
+}
+
+// getNodeColor returns the fill color of a node, by its depth or its top level node.
+func (tm TreeMap) getNodeColor(index, depth int, parentColor drawing.Color) drawing.Color {
+	if tm.ColorByDepth {
+		return tm.GetColorPalette().GetSeriesColor(depth - 1)
+	}
+	if depth == 1 {
+		return tm.GetColorPalette().GetSeriesColor(index)
+	}
+	return drawing.Color{
+		R: parentColor.R + uint8(float64(255-parentColor.R)*DefaultTreeMapDepthShade),
+		G: parentColor.G + uint8(float64(255-parentColor.G)*DefaultTreeMapDepthShade),
+		B: parentColor.B + uint8(float64(255-parentColor.B)*DefaultTreeMapDepthShade),
+		A: parentColor.A,
+	}
+}
+
+// getNodeBoxes returns the box of each node within a box, squarified, in the order of the nodes.
+func (tm TreeMap) getNodeBoxes(nodes []TreeMapNode, box Box) []Box {
+	order := make([]int, 0, len(nodes))
+	var total float64
+	for index, node := range nodes {
+		if node.GetValue() > 0 {
+			order = append(order, index)
+			total += node.GetValue()
+		}
+	}
+	boxes := make([]Box, len(nodes))
+	if total == 0 || box.Width() <= 0 || box.Height() <= 0 {
+		return boxes
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return nodes[order[i]].GetValue() > nodes[order[j]].GetValue()
+	})
+
+	scale := float64(box.Width()*box.Height()) / total
+	areas := make([]float64, len(order))
+	for i, index := range order {
+		areas[i] = nodes[index].GetValue() * scale
+	}
+	rects := squarify(areas, float64(box.Left), float64(box.Top), float64(box.Width()), float64(box.Height()))
+	for i, index := range order {
+		rect := rects[i]
+		boxes[index] = Box{
+			Top:    int(math.Round(rect[1])),
+			Left:   int(math.Round(rect[0])),
+			Right:  int(math.Round(rect[0] + rect[2])),
+			Bottom: int(math.Round(rect[1] + rect[3])),
+		}
+	}
+	return boxes
+}
+
+// squarify lays out areas, largest first, as rectangles of x, y, width and height filling a
+// rectangle, adding each area to a row along the shorter side while that keeps the row's
+// rectangles closer to square, then starting a new row in the space left.
+func squarify(areas []float64, x, y, width, height float64) [][4]float64 {
+	rects := make([][4]float64, len(areas))
+	for start := 0; start < len(areas); {
+		side := math.Min(width, height)
+		end := start + 1
+		for end < len(areas) && squarifyWorst(areas[start:end+1], side) <= squarifyWorst(areas[start:end], side) {
+			end++
+		}
+
+		var rowArea float64
+		for _, area := range areas[start:end] {
+			rowArea += area
+		}
+		// the rest of the areas have no room left to fill.
+		if width <= 0 || height <= 0 || rowArea <= 0 {
+			for index := start; index < len(areas); index++ {
+				rects[index] = [4]float64{x, y, 0, 0}
+			}
+			break
+		}
+		if width >= height {
+			rowWidth := rowArea / height
+			top := y
+			for index := start; index < end; index++ {
+				rects[index] = [4]float64{x, top, rowWidth, areas[index] / rowWidth}
+				top += areas[index] / rowWidth
+			}
+			x, width = x+rowWidth, width-rowWidth
+		} else {
+			rowHeight := rowArea / width
+			left := x
+			for index := start; index < end; index++ {
+				rects[index] = [4]float64{left, y, areas[index] / rowHeight, rowHeight}
+				left += areas[index] / rowHeight
+			}
+			y, height = y+rowHeight, height-rowHeight
+		}
+		start = end
+	}
+	return rects
+}
+
+// squarifyWorst returns the worst aspect ratio of a row of areas laid along a side.
+func squarifyWorst(row []float64, side float64) float64 {
+	var sum, min, max float64
+	min = math.MaxFloat64
+	for _, area := range row {
+		sum += area
+		min, max = math.Min(min, area), math.Max(max, area)
+	}
+	sideSquared, sumSquared := side*side, sum*sum
+	return math.Max(sideSquared*max/sumSquared, sumSquared/(sideSquared*min))
+}
+
+func (tm TreeMap) styleDefaultsLabels() Style {
+	return tm.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: tm.GetColorPalette().TextColor(),
+		Font:      tm.GetFont(),
+	})
+}
@@ -0,0 +1,116 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestTreeMapRender(t *testing.T) {
+	// replaced new assertions helper
+
+	tm := TreeMap{
+		ChartFrame: ChartFrame{
+			Title: "Test",
+		},
+		Nodes: []TreeMapNode{
+			{Label: "a", Children: []TreeMapNode{
+				{Label: "a1", Value: 3},
+				{Label: "a2", Value: 1, Children: []TreeMapNode{{Label: "a2x", Value: 1}}},
+			}},
+			{Label: "b", Value: 2},
+			{Label: "c", Value: 0},
+		},
+	}
+	for _, colorByDepth := range []bool{false, true} {
+		tm.ColorByDepth = colorByDepth
+		for _, rp := range []RendererProvider{PNG, SVG} {
+			b := bytes.NewBuffer([]byte{})
+			testutil.AssertNil(t, tm.Render(rp, b))
+			testutil.AssertNotZero(t, b.Len())
+		}
+	}
+}
+
+func TestTreeMapRenderInvalid(t *testing.T) {
+	// replaced new assertions helper
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, TreeMap{}.Render(PNG, b))
+	testutil.AssertNotNil(t, TreeMap{Nodes: []TreeMapNode{{Value: -1}}}.Render(PNG, b))
+	testutil.AssertNotNil(t, TreeMap{Nodes: []TreeMapNode{{Value: 0}}}.Render(PNG, b))
+	testutil.AssertNotNil(t, TreeMap{Nodes: []TreeMapNode{{Value: 1, Children: []TreeMapNode{{Value: -1}}}}}.Render(PNG, b))
+}
+
+func TestTreeMapRenderTiny(t *testing.T) {
+	// replaced new assertions helper
+
+	// the padding leaves no canvas to lay nodes out in, which must not hang the render.
+	for _, size := range [][2]int{{10, 10}, {10, 100}, {100, 10}} {
+		tm := TreeMap{
+			ChartFrame: ChartFrame{Width: size[0], Height: size[1]},
+			Nodes:      []TreeMapNode{{Label: "a", Value: 1}, {Label: "b", Value: 2}},
+		}
+		b := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, tm.Render(PNG, b))
+		testutil.AssertNotZero(t, b.Len())
+	}
+}
+
+func TestTreeMapNodeGetValue(t *testing.T) {
+	// replaced new assertions helper
+
+	node := TreeMapNode{Children: []TreeMapNode{{Value: 1}, {Children: []TreeMapNode{{Value: 2}, {Value: 3}}}}}
+	testutil.AssertEqual(t, 6.0, node.GetValue())
+	node.Value = 10
+	testutil.AssertEqual(t, 10.0, node.GetValue())
+}
+
+func TestSquarify(t *testing.T) {
+	// replaced new assertions helper
+
+	// the example from the squarified tree maps paper, on a 6x4 rectangle.
+	areas := []float64{6, 6, 4, 3, 2, 2, 1}
+	rects := squarify(areas, 0, 0, 6, 4)
+	testutil.AssertLen(t, rects, len(areas))
+
+	var total float64
+	for index, rect := range rects {
+		testutil.AssertInDelta(t, areas[index], rect[2]*rect[3], 1e-9)
+		testutil.AssertTrue(t, rect[0] >= 0 && rect[1] >= 0)
+		testutil.AssertTrue(t, rect[0]+rect[2] <= 6+1e-9 && rect[1]+rect[3] <= 4+1e-9)
+		total += rect[2] * rect[3]
+	}
+	testutil.AssertInDelta(t, 24, total, 1e-9)
+	// the two largest areas share the first column.
+	testutil.AssertEqual(t, [4]float64{0, 0, 3, 2}, rects[0])
+	testutil.AssertEqual(t, [4]float64{0, 2, 3, 2}, rects[1])
+}
+
+func TestTreeMapGetNodeBoxes(t *testing.T) {
+	// replaced new assertions helper
+
+	tm := TreeMap{}
+	nodes := []TreeMapNode{{Value: 1}, {Value: 0}, {Value: 3}}
+	boxes := tm.getNodeBoxes(nodes, Box{Top: 0, Left: 0, Right: 100, Bottom: 100})
+	testutil.AssertLen(t, boxes, 3)
+	testutil.AssertTrue(t, boxes[1].IsZero())
+	testutil.AssertInDelta(t, 7500, float64(boxes[2].Width()*boxes[2].Height()), 100)
+	testutil.AssertInDelta(t, 2500, float64(boxes[0].Width()*boxes[0].Height()), 100)
+
+	for _, box := range tm.getNodeBoxes(nodes, Box{Right: 100}) {
+		testutil.AssertTrue(t, box.IsZero())
+	}
+}
+
+func TestSquarifyNoRoom(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, rect := range squarify([]float64{2, 1}, 5, 5, 0, 10) {
+		testutil.AssertEqual(t, [4]float64{5, 5, 0, 0}, rect)
+	}
+	for _, rect := range squarify([]float64{0, 0}, 5, 5, 10, 10) {
+		testutil.AssertEqual(t, [4]float64{5, 5, 0, 0}, rect)
+	}
+}
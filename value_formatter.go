@@ -2,7 +2,9 @@ package chart
 
 import (
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -97,9 +99,82 @@ func FloatValueFormatterWithFormat(v interface{}, floatFormat string) string {
 	return ""
 }
 
+// FloatValueFormatterFunc returns a ValueFormatter that formats numeric values with a function of a float64,
+// e.g. for currencies, to set as the `ValueFormatter` of an axis.
+func FloatValueFormatterFunc(format func(float64) string) ValueFormatter {
+	return func(v interface{}) string {
+		switch typed := v.(type) {
+		case int:
+			return format(float64(typed))
+		case int64:
+			return format(float64(typed))
+		case float32:
+			return format(float64(typed))
+		case float64:
+			return format(typed)
+		default:
+			return ""
+		}
+	}
+}
+
 // KValueFormatter is a formatter for K values.
 func KValueFormatter(k float64, vf ValueFormatter) ValueFormatter {
 	return func(v interface{}) string {
 		return fmt.Sprintf("%0.0fσ %s", k, vf(v))
 	}
 }
+
+// DurationValueFormatter is a ValueFormatter for durations, given as `time.Duration` or as a number of nanoseconds.
+// The unit (ns, µs, ms, s, m or h) is chosen by the magnitude of the value, e.g. "250µs", "1.5s" or "2h".
+func DurationValueFormatter(v interface{}) string {
+	return DurationValueFormatterWithUnit(time.Nanosecond)(v)
+}
+
+// DurationValueFormatterWithUnit returns a duration formatter for values given as a number of a unit,
+// e.g. `time.Millisecond` for latencies recorded in milliseconds.
+func DurationValueFormatterWithUnit(unit time.Duration) ValueFormatter {
+	return func(v interface{}) string {
+		var nanos float64
+		switch typed := v.(type) {
+		case time.Duration:
+			return formatDuration(float64(typed))
+		case int:
+			nanos = float64(typed)
+		case int64:
+			nanos = float64(typed)
+		case float32:
+			nanos = float64(typed)
+		case float64:
+			nanos = typed
+		default:
+			return ""
+		}
+		return formatDuration(nanos * float64(unit))
+	}
+}
+
+func formatDuration(nanos float64) string {
+	units := []struct {
+		size   time.Duration
+		suffix string
+	}{
+		{time.Hour, "h"},
+		{time.Minute, "m"},
+		{time.Second, "s"},
+		{time.Millisecond, "ms"},
+		{time.Microsecond, "µs"},
+	}
+	for _, unit := range units {
+		if math.Abs(nanos) >= float64(unit.size) {
+			return trimFloat(nanos/float64(unit.size)) + unit.suffix
+		}
+	}
+	return trimFloat(nanos) + "ns"
+}
+
+// trimFloat formats a float to at most two decimal places, without trailing zeros.
+func trimFloat(v float64) string {
+	formatted := strings.TrimRight(strconv.FormatFloat(v, 'f', 2, 64), "0")
+	return strings.TrimSuffix(formatted, ".")
+}
@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -56,3 +57,33 @@ func TestFloatValueFormatterWithFormat(t *testing.T) {
 	testutil.AssertEqual(t, "123.456", sv)
 	testutil.AssertEqual(t, "123.000", FloatValueFormatterWithFormat(123, "%.3f"))
 }
+
+func TestFloatValueFormatterFunc(t *testing.T) {
+	// replaced new assertions helper
+
+	vf := FloatValueFormatterFunc(func(v float64) string {
+		return fmt.Sprintf("$%.2f", v)
+	})
+	testutil.AssertEqual(t, "$1.50", vf(1.5))
+	testutil.AssertEqual(t, "$2.00", vf(2))
+	testutil.AssertEqual(t, "$3.00", vf(int64(3)))
+	testutil.AssertEqual(t, "", vf("4"))
+}
+
+func TestDurationValueFormatter(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertEqual(t, "0ns", DurationValueFormatter(0))
+	testutil.AssertEqual(t, "750ns", DurationValueFormatter(750.0))
+	testutil.AssertEqual(t, "250µs", DurationValueFormatter(250*time.Microsecond))
+	testutil.AssertEqual(t, "1.5ms", DurationValueFormatter(int64(1500*time.Microsecond)))
+	testutil.AssertEqual(t, "-2.25s", DurationValueFormatter(float64(-2250*time.Millisecond)))
+	testutil.AssertEqual(t, "1.5m", DurationValueFormatter(90*time.Second))
+	testutil.AssertEqual(t, "2h", DurationValueFormatter(2*time.Hour))
+	testutil.AssertEqual(t, "", DurationValueFormatter("2h"))
+
+	ms := DurationValueFormatterWithUnit(time.Millisecond)
+	testutil.AssertEqual(t, "120ms", ms(120.0))
+	testutil.AssertEqual(t, "1.2s", ms(1200))
+	testutil.AssertEqual(t, "30s", ms(30*time.Second))
+}
@@ -41,6 +41,11 @@ type FullBoundedValuesProvider interface {
 	BoundedLastValuesProvider
 }
 
+// SizeValuesProvider is a type that carries a size with each of its values, e.g. for bubbles.
+type SizeValuesProvider interface {
+	GetSizeValue(index int) float64
+}
+
 // SizeProvider is a provider for integer size.
 type SizeProvider func(xrange, yrange Range, index int, x, y float64) float64
 
@@ -0,0 +1,252 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// VariancePeriod is the actual and target value of a period in a variance chart.
+type VariancePeriod struct {
+	Label  string
+	Actual float64
+	Target float64
+}
+
+// Interface Assertions.
+var (
+	_ Series                = (*VarianceSeries)(nil)
+	_ BoundedValuesProvider = (*VarianceSeries)(nil)
+	_ MeasuredSeries        = (*VarianceSeries)(nil)
+)
+
+// VarianceSeries draws the actual value of each period as a bar from zero, the target as a tick across
+// the bar, and the variance of the actual from the target as a label above them, colored by whether the
+// actual is over or under the target.
+//
+// The periods are the x values 0, 1, 2 and so on, which lines up with an `OrdinalRange` of the period
+// labels; see `NewVarianceChart`.
+type VarianceSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	TargetStyle Style
+	// PositiveStyle and NegativeStyle are the styles of the labels of periods over and under target.
+	PositiveStyle Style
+	NegativeStyle Style
+
+	// BarWidth is the width of the bars as a fraction of the space per period.
+	BarWidth float64
+	// Percent labels the variances as a percentage of the targets.
+	Percent        bool
+	ValueFormatter ValueFormatter
+
+	Periods []VariancePeriod
+}
+
+// NewVarianceChart returns a chart of the actual against the target value of each period, with the
+// periods in order on an ordinal x-axis.
+func NewVarianceChart(periods []VariancePeriod) Chart {
+	labels := make([]interface{}, len(periods))
+	for index, period := range periods {
+		labels[index] = period.Label
+	}
+	return Chart{
+		XAxis: XAxis{
+			Range: NewOrdinalRange(labels...),
+		},
+		Series: []Series{
+			VarianceSeries{
+				Periods: periods,
+			},
+		},
+	}
+}
+
+// GetName returns the name of the series.
+func (vs VarianceSeries) GetName() string {
+	return vs.Name
+}
+
+// GetStyle returns the series style.
+func (vs VarianceSeries) GetStyle() Style {
+	return vs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (vs VarianceSeries) GetYAxis() YAxisType {
+	return vs.YAxis
+}
+
+// GetBarWidth returns the bar width or the default.
+func (vs VarianceSeries) GetBarWidth() float64 {
+	if vs.BarWidth == 0 {
+		return DefaultVarianceBarWidth
+	}
+	return vs.BarWidth
+}
+
+// GetValueFormatter returns the value formatter or the default.
+func (vs VarianceSeries) GetValueFormatter() ValueFormatter {
+	if vs.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return vs.ValueFormatter
+}
+
+// Len returns the number of periods.
+func (vs VarianceSeries) Len() int {
+	return len(vs.Periods)
+}
+
+// GetBoundedValues gets the x value of a period, and the highest and lowest of zero, its actual and its target.
+func (vs VarianceSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	period := vs.Periods[index]
+	x = float64(index)
+	y1 = math.Max(0, math.Max(period.Actual, period.Target))
+	y2 = math.Min(0, math.Min(period.Actual, period.Target))
+	return
+}
+
+// GetVariance returns the variance label of a period, signed, and whether the actual is at or over target.
+func (vs VarianceSeries) GetVariance(index int) (label string, positive bool) {
+	period := vs.Periods[index]
+	variance := period.Actual - period.Target
+	if vs.Percent {
+		if period.Target == 0 {
+			return "", variance >= 0
+		}
+		variance = 100 * variance / math.Abs(period.Target)
+		label = fmt.Sprintf("%.1f%%", variance)
+	} else {
+		label = vs.GetValueFormatter()(variance)
+	}
+	if variance > 0 {
+		label = "+" + label
+	}
+	return label, variance >= 0
+}
+
+// getSpacing returns the pixel width of the space per period.
+func (vs VarianceSeries) getSpacing(canvasBox Box, xrange Range) int {
+	if len(vs.Periods) > 1 {
+		return AbsInt(xrange.Translate(1) - xrange.Translate(0))
+	}
+	return canvasBox.Width()
+}
+
+func (vs VarianceSeries) getBarStyle(defaults Style) Style {
+	return vs.Style.InheritFrom(Style{
+		FillColor:   defaults.StrokeColor,
+		StrokeColor: defaults.StrokeColor,
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
+
+func (vs VarianceSeries) getTargetStyle() Style {
+	return vs.TargetStyle.InheritFrom(Style{
+		StrokeColor: DefaultAxisColor,
+		StrokeWidth: DefaultVarianceTargetStrokeWidth,
+	})
+}
+
+func (vs VarianceSeries) getLabelStyle(positive bool, defaults Style) Style {
+	style := vs.NegativeStyle
+	color := DefaultVarianceNegativeColor
+	if positive {
+		style = vs.PositiveStyle
+		color = DefaultVariancePositiveColor
+	}
+	return style.InheritFrom(Style{
+		Font:      defaults.Font,
+		FontColor: color,
+		FontSize:  DefaultAnnotationFontSize,
+	})
+}
+
+// getLabelBoxes returns the boxes of the variance labels, by period, above the highest of the bar, the target
+// and zero; periods without a label have an empty box.
+func (vs VarianceSeries) getLabelBoxes(r Renderer, canvasBox Box, yrange Range, defaults Style) []Box {
+	boxes := make([]Box, len(vs.Periods))
+	y0 := canvasBox.Bottom - yrange.Translate(0)
+	for index, period := range vs.Periods {
+		label, positive := vs.GetVariance(index)
+		labelStyle := vs.getLabelStyle(positive, defaults)
+		if len(label) == 0 || labelStyle.Hidden {
+			continue
+		}
+		tb := Draw.MeasureText(r, label, labelStyle)
+		ya := canvasBox.Bottom - yrange.Translate(period.Actual)
+		yt := canvasBox.Bottom - yrange.Translate(period.Target)
+		bottom := MinInt(y0, MinInt(ya, yt)) - DefaultMarkerLabelGap
+		boxes[index] = Box{Top: bottom - tb.Height(), Right: tb.Width(), Bottom: bottom}
+	}
+	return boxes
+}
+
+// Measure returns the bounds of the variance labels.
+func (vs VarianceSeries) Measure(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) Box {
+	box := Box{Top: math.MaxInt32, Left: math.MaxInt32}
+	for index, lb := range vs.getLabelBoxes(r, canvasBox, yrange, defaults) {
+		if lb.IsZero() {
+			continue
+		}
+		x := canvasBox.Left + xrange.Translate(float64(index))
+		box.Top = MinInt(box.Top, lb.Top)
+		box.Left = MinInt(box.Left, x-lb.Width()>>1)
+		box.Right = MaxInt(box.Right, x+lb.Width()>>1)
+		box.Bottom = MaxInt(box.Bottom, lb.Bottom)
+	}
+	return box
+}
+
+// Render renders the series.
+func (vs VarianceSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if vs.Style.Hidden {
+		return
+	}
+	spacing := vs.getSpacing(canvasBox, xrange)
+	half := int(math.Round(float64(spacing)*vs.GetBarWidth())) >> 1
+	targetHalf := int(math.Round(float64(half) * DefaultVarianceTargetWidth))
+	barStyle := vs.getBarStyle(defaults)
+	targetStyle := vs.getTargetStyle()
+	y0 := canvasBox.Bottom - yrange.Translate(0)
+	labelBoxes := vs.getLabelBoxes(r, canvasBox, yrange, defaults)
+
+	for index, period := range vs.Periods {
+		x := canvasBox.Left + xrange.Translate(float64(index))
+		ya := canvasBox.Bottom - yrange.Translate(period.Actual)
+		yt := canvasBox.Bottom - yrange.Translate(period.Target)
+
+		Draw.Box(r, Box{Top: MinInt(y0, ya), Left: x - half, Right: x + half, Bottom: MaxInt(y0, ya)}, barStyle)
+
+		if !targetStyle.Hidden {
+			targetStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(x-targetHalf, yt)
+			r.LineTo(x+targetHalf, yt)
+			r.Stroke()
+			r.ResetStyle()
+		}
+
+		if lb := labelBoxes[index]; !lb.IsZero() {
+			label, positive := vs.GetVariance(index)
+			Draw.Text(r, label, x-lb.Width()>>1, lb.Bottom, vs.getLabelStyle(positive, defaults))
+		}
+	}
+}
+
+// Validate validates the series.
+func (vs VarianceSeries) Validate() error {
+	if len(vs.Periods) == 0 {
+		return fmt.Errorf("variance series requires periods to be set")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its periods with the original.
+func (vs VarianceSeries) CopySeries() Series {
+	if vs.Periods != nil {
+		vs.Periods = append([]VariancePeriod(nil), vs.Periods...)
+	}
+	return vs
+}
@@ -0,0 +1,65 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestVarianceSeriesValues(t *testing.T) {
+	// replaced new assertions helper
+
+	vs := VarianceSeries{Periods: []VariancePeriod{
+		{Label: "jan", Actual: 120, Target: 100},
+		{Label: "feb", Actual: -20, Target: 10},
+		{Label: "mar", Actual: 50, Target: 0},
+	}}
+	testutil.AssertNil(t, vs.Validate())
+	testutil.AssertNotNil(t, VarianceSeries{}.Validate())
+
+	x, y1, y2 := vs.GetBoundedValues(1)
+	testutil.AssertEqual(t, 1.0, x)
+	testutil.AssertEqual(t, 10.0, y1)
+	testutil.AssertEqual(t, -20.0, y2)
+
+	label, positive := vs.GetVariance(0)
+	testutil.AssertEqual(t, "+20.00", label)
+	testutil.AssertTrue(t, positive)
+	label, positive = vs.GetVariance(1)
+	testutil.AssertEqual(t, "-30.00", label)
+	testutil.AssertFalse(t, positive)
+
+	vs.Percent = true
+	label, _ = vs.GetVariance(0)
+	testutil.AssertEqual(t, "+20.0%", label)
+	label, _ = vs.GetVariance(1)
+	testutil.AssertEqual(t, "-300.0%", label)
+	// there is no percentage of a zero target.
+	label, _ = vs.GetVariance(2)
+	testutil.AssertEmpty(t, label)
+}
+
+func TestVarianceChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	c := NewVarianceChart([]VariancePeriod{
+		{Label: "jan", Actual: 120, Target: 100},
+		{Label: "feb", Actual: 90, Target: 110},
+	})
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), ">+20.00</text>")
+	testutil.AssertContains(t, buffer.String(), ">-20.00</text>")
+	testutil.AssertContains(t, buffer.String(), ">feb</text>")
+}
+
+func TestVarianceSeriesCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	vs := VarianceSeries{Periods: []VariancePeriod{{Label: "Q1", Actual: 12, Target: 10}}}
+	copied := vs.CopySeries().(VarianceSeries)
+	copied.Periods[0].Actual = 8
+	testutil.AssertEqual(t, 12.0, vs.Periods[0].Actual)
+	testutil.AssertNil(t, VarianceSeries{}.CopySeries().(VarianceSeries).Periods)
+}
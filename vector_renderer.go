@@ -2,9 +2,13 @@ package chart
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
+	"image"
+	"image/png"
 	"io"
 	"math"
+	"strconv"
 	"strings"
 
 	"golang.org/x/image/font"
@@ -46,6 +50,61 @@ func SVGWithCSS(css string, nonce string) func(width, height int) (Renderer, err
 	}
 }
 
+// SVGOptions are options that make svg output smaller, e.g. for charts of dense series.
+type SVGOptions struct {
+	// Precision is the number of decimal places of the numbers that are not whole pixels, e.g. arc
+	// angles, text rotations, dash lengths, font sizes and color opacities, with trailing zeros trimmed;
+	// set it to `Disabled` to round them to whole numbers. It defaults to the precision of the `SVG` renderer.
+	Precision int
+	// Minify collapses redundant output: the stroke attributes of elements without a stroke, empty
+	// attributes, opaque colors as hex, and the spaces and line breaks within path data.
+	Minify bool
+	// RelativePaths writes each path command after the first relative to the point before it,
+	// which for dense series are shorter than absolute coordinates.
+	RelativePaths bool
+}
+
+// SVGWithOptions returns a renderer provider for svg written with the given options.
+func SVGWithOptions(options SVGOptions) RendererProvider {
+	return func(width, height int) (Renderer, error) {
+		buffer := bytes.NewBuffer([]byte{})
+		canvas := newCanvas(buffer)
+		canvas.options = options
+		canvas.Start(width, height)
+		return &vectorRenderer{
+			b:   buffer,
+			c:   canvas,
+			s:   &Style{},
+			p:   []string{},
+			dpi: DefaultDPI,
+		}, nil
+	}
+}
+
+// SVGStream returns a renderer provider that writes svg to a writer as a chart is drawn, rather than
+// holding the document in memory until it is saved, bounding the memory used to render charts with
+// many thousands of points.
+//
+// Render the chart to the same writer; the writer given to `Render` is not written to. As the
+// document is written while rendering, the writer holds a partial document if rendering fails.
+// The document is started by the first element drawn, so renderers only used to measure, e.g. by
+// charts sized by their canvas, don't write to the writer.
+func SVGStream(w io.Writer) RendererProvider {
+	return func(width, height int) (Renderer, error) {
+		stream := &svgStream{w: w}
+		canvas := newCanvas(stream)
+		canvas.width, canvas.height = width, height
+		stream.start = func() { canvas.Start(width, height) }
+		return &vectorRenderer{
+			c:      canvas,
+			s:      &Style{},
+			p:      []string{},
+			dpi:    DefaultDPI,
+			stream: stream,
+		}, nil
+	}
+}
+
 // vectorRenderer renders chart commands to a bitmap.
 type vectorRenderer struct {
 	dpi float64
@@ -54,6 +113,57 @@ type vectorRenderer struct {
 	s   *Style
 	p   []string
 	fc  *font.Drawer
+
+	// stream, if set, is written to as the chart is drawn, in place of the buffer.
+	stream *svgStream
+
+	// x and y are the current point of the path, and subpathX and subpathY where closing it returns to.
+	x, y               int
+	subpathX, subpathY int
+}
+
+// svgStream writes the elements of a streamed svg document as they are drawn. The data of the
+// current path is written as it is added, so elements drawn while a path is open are held until
+// the path is drawn.
+type svgStream struct {
+	w       io.Writer
+	open    bool
+	pending bytes.Buffer
+	err     error
+
+	// start, if set, starts the document before the first write.
+	start func()
+}
+
+// Write implements io.Writer for the canvas, holding elements while a path is open.
+func (ss *svgStream) Write(buffer []byte) (int, error) {
+	if ss.open {
+		return ss.pending.Write(buffer)
+	}
+	ss.writePath(string(buffer))
+	return len(buffer), nil
+}
+
+// writePath writes to the underlying writer, keeping the first error.
+func (ss *svgStream) writePath(text string) {
+	if ss.start != nil {
+		start := ss.start
+		ss.start = nil
+		start()
+	}
+	if ss.err != nil {
+		return
+	}
+	_, ss.err = io.WriteString(ss.w, text)
+}
+
+// closePath ends the current path and writes the elements held while it was open.
+func (ss *svgStream) closePath() {
+	ss.open = false
+	if ss.pending.Len() > 0 {
+		ss.writePath(ss.pending.String())
+		ss.pending.Reset()
+	}
 }
 
 func (vr *vectorRenderer) ResetStyle() {
@@ -99,17 +209,23 @@ func (vr *vectorRenderer) SetStrokeDashArray(dashArray []float64) {
 
 // MoveTo implements the interface method.
 func (vr *vectorRenderer) MoveTo(x, y int) {
-	vr.p = append(vr.p, fmt.Sprintf("M %d %d", x, y))
+	vr.addPathCommand("M", nil, x, y)
+	vr.subpathX, vr.subpathY = x, y
 }
 
 // LineTo implements the interface method.
 func (vr *vectorRenderer) LineTo(x, y int) {
-	vr.p = append(vr.p, fmt.Sprintf("L %d %d", x, y))
+	vr.addPathCommand("L", nil, x, y)
 }
 
 // QuadCurveTo draws a quad curve.
 func (vr *vectorRenderer) QuadCurveTo(cx, cy, x, y int) {
-	vr.p = append(vr.p, fmt.Sprintf("Q%d,%d %d,%d", cx, cy, x, y))
+	vr.addPathCommand("Q", nil, cx, cy, x, y)
+}
+
+// CubicCurveTo implements CubicCurveRenderer.
+func (vr *vectorRenderer) CubicCurveTo(cx1, cy1, cx2, cy2, x, y int) {
+	vr.addPathCommand("C", nil, cx1, cy1, cx2, cy2, x, y)
 }
 
 func (vr *vectorRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
@@ -119,10 +235,10 @@ func (vr *vectorRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
 	startx := cx + int(rx*math.Sin(startAngle))
 	starty := cy - int(ry*math.Cos(startAngle))
 
-	if len(vr.p) > 0 {
-		vr.p = append(vr.p, fmt.Sprintf("L %d %d", startx, starty))
+	if vr.hasPath() {
+		vr.LineTo(startx, starty)
 	} else {
-		vr.p = append(vr.p, fmt.Sprintf("M %d %d", startx, starty))
+		vr.MoveTo(startx, starty)
 	}
 
 	endx := cx + int(rx*math.Sin(endAngle))
@@ -135,12 +251,43 @@ func (vr *vectorRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
 		largeArcFlag = 1
 	}
 
-	vr.p = append(vr.p, fmt.Sprintf("A %d %d %0.2f %d 1 %d %d", int(rx), int(ry), dd, largeArcFlag, endx, endy))
+	vr.addPathCommand("A", []string{
+		strconv.Itoa(int(rx)),
+		strconv.Itoa(int(ry)),
+		vr.c.formatFloat(dd, 2),
+		strconv.Itoa(largeArcFlag),
+		"1",
+	}, endx, endy)
 }
 
 // Close closes a shape.
 func (vr *vectorRenderer) Close() {
-	vr.p = append(vr.p, fmt.Sprintf("Z"))
+	vr.addPath("Z")
+	vr.x, vr.y = vr.subpathX, vr.subpathY
+}
+
+// addPathCommand adds a path command with any parameters and then points, the last of which the
+// command ends at. Commands after the first are relative to the current point if the options say so.
+func (vr *vectorRenderer) addPathCommand(command string, params []string, points ...int) {
+	relative := vr.c.options.RelativePaths && vr.hasPath()
+	if relative {
+		command = strings.ToLower(command)
+	}
+	values := params
+	for index := 0; index < len(points); index += 2 {
+		x, y := points[index], points[index+1]
+		if relative {
+			x, y = x-vr.x, y-vr.y
+		}
+		values = append(values, strconv.Itoa(x), strconv.Itoa(y))
+	}
+	vr.x, vr.y = points[len(points)-2], points[len(points)-1]
+
+	separator := " "
+	if vr.c.options.Minify {
+		separator = ""
+	}
+	vr.addPath(command + separator + strings.Join(values, " "))
 }
 
 // Stroke draws the path with no fill.
@@ -158,10 +305,41 @@ func (vr *vectorRenderer) FillStroke() {
 	vr.drawPath(vr.s.GetFillAndStrokeOptions())
 }
 
+// addPath adds a command to the current path, writing it straight to the stream if there is one.
+func (vr *vectorRenderer) addPath(command string) {
+	if vr.stream == nil {
+		vr.p = append(vr.p, command)
+		return
+	}
+	if vr.stream.open {
+		vr.stream.writePath(vr.c.pathSeparator())
+	} else {
+		vr.stream.writePath(`<path d="`)
+		vr.stream.open = true
+	}
+	vr.stream.writePath(command)
+}
+
+// hasPath returns if the current path has any commands.
+func (vr *vectorRenderer) hasPath() bool {
+	if vr.stream == nil {
+		return len(vr.p) > 0
+	}
+	return vr.stream.open
+}
+
 // drawPath draws a path.
 func (vr *vectorRenderer) drawPath(s Style) {
-	vr.c.Path(strings.Join(vr.p, "\n"), vr.s.GetFillAndStrokeOptions())
-	vr.p = []string{} // clear the path
+	if vr.stream == nil {
+		vr.c.Path(strings.Join(vr.p, vr.c.pathSeparator()), vr.s.GetFillAndStrokeOptions())
+		vr.p = []string{} // clear the path
+		return
+	}
+	if !vr.stream.open {
+		vr.stream.writePath(`<path d="`)
+	}
+	vr.stream.writePath(`" ` + vr.c.pathAttributes(vr.s.GetFillAndStrokeOptions()) + `/>`)
+	vr.stream.closePath()
 }
 
 // Circle implements the interface method.
@@ -169,6 +347,30 @@ func (vr *vectorRenderer) Circle(radius float64, x, y int) {
 	vr.c.Circle(x, y, int(radius), vr.s.GetFillAndStrokeOptions())
 }
 
+// DrawImage implements ImageRenderer; the image is embedded as a png.
+func (vr *vectorRenderer) DrawImage(img image.Image, box Box) {
+	buffer := bytes.NewBuffer(nil)
+	if err := png.Encode(buffer, img); err != nil {
+		return
+	}
+	vr.c.Image("image/png", buffer.Bytes(), box)
+}
+
+// DrawSVGImage implements SVGImageRenderer.
+func (vr *vectorRenderer) DrawSVGImage(svg []byte, box Box) {
+	vr.c.Image("image/svg+xml", svg, box)
+}
+
+// SetClip implements ClipRenderer.
+func (vr *vectorRenderer) SetClip(box Box) {
+	vr.c.StartClip(box)
+}
+
+// ClearClip implements ClipRenderer.
+func (vr *vectorRenderer) ClearClip() {
+	vr.c.EndClip()
+}
+
 // SetFont implements the interface method.
 func (vr *vectorRenderer) SetFont(f *truetype.Font) {
 	vr.s.Font = f
@@ -220,8 +422,18 @@ func (vr *vectorRenderer) ClearTextRotation() {
 	vr.c.textTheta = nil
 }
 
-// Save saves the renderer's contents to a writer.
+// Save saves the renderer's contents to a writer. A streaming renderer ends the document it has
+// been writing instead, and returns the first error writing it.
 func (vr *vectorRenderer) Save(w io.Writer) error {
+	if vr.stream != nil {
+		if vr.stream.open {
+			// a path that was never drawn is left out by the buffered renderer, so end it unpainted.
+			vr.stream.writePath(`" ` + vr.c.styleAsSVG(Style{}) + `/>`)
+			vr.stream.closePath()
+		}
+		vr.c.End()
+		return vr.stream.err
+	}
 	vr.c.End()
 	_, err := w.Write(vr.b.Bytes())
 	return err
@@ -242,6 +454,41 @@ type canvas struct {
 	height    int
 	css       string
 	nonce     string
+	options   SVGOptions
+	// clips counts the clip paths, to give each its own id, and clipped is if a clipped group is open.
+	clips   int
+	clipped bool
+}
+
+// formatFloat formats a number with the precision of the options, or a default number of decimal places.
+func (c *canvas) formatFloat(value float64, defaultPrecision int) string {
+	if c.options.Precision == 0 {
+		return strconv.FormatFloat(value, 'f', defaultPrecision, 64)
+	}
+	formatted := strconv.FormatFloat(value, 'f', MaxInt(0, c.options.Precision), 64)
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+	return formatted
+}
+
+// formatColor formats a color, as hex if it is opaque and the output is minified.
+func (c *canvas) formatColor(color drawing.Color) string {
+	if c.options.Minify && color.A == 255 {
+		return fmt.Sprintf("#%02x%02x%02x", color.R, color.G, color.B)
+	}
+	if c.options.Precision == 0 {
+		return color.String()
+	}
+	return fmt.Sprintf("rgba(%v,%v,%v,%s)", color.R, color.G, color.B, c.formatFloat(float64(color.A)/255, 1))
+}
+
+// pathSeparator returns the separator between path commands.
+func (c *canvas) pathSeparator() string {
+	if c.options.Minify {
+		return " "
+	}
+	return "\n"
 }
 
 func (c *canvas) Start(width, height int) {
@@ -264,14 +511,26 @@ func (c *canvas) Path(d string, style Style) {
 	if len(style.StrokeDashArray) > 0 {
 		strokeDashArrayProperty = c.getStrokeDashArray(style)
 	}
+	if c.options.Minify {
+		c.w.Write([]byte(fmt.Sprintf(`<path d="%s" %s/>`, d, c.pathAttributes(style))))
+		return
+	}
 	c.w.Write([]byte(fmt.Sprintf(`<path %s d="%s" %s/>`, strokeDashArrayProperty, d, c.styleAsSVG(style))))
 }
 
+// pathAttributes returns the attributes of a path element after its path data.
+func (c *canvas) pathAttributes(style Style) string {
+	if len(style.StrokeDashArray) > 0 {
+		return c.getStrokeDashArray(style) + " " + c.styleAsSVG(style)
+	}
+	return c.styleAsSVG(style)
+}
+
 func (c *canvas) Text(x, y int, body string, style Style) {
 	if c.textTheta == nil {
 		c.w.Write([]byte(fmt.Sprintf(`<text x="%d" y="%d" %s>%s</text>`, x, y, c.styleAsSVG(style), body)))
 	} else {
-		transform := fmt.Sprintf(` transform="rotate(%0.2f,%d,%d)"`, RadiansToDegrees(*c.textTheta), x, y)
+		transform := fmt.Sprintf(` transform="rotate(%s,%d,%d)"`, c.formatFloat(RadiansToDegrees(*c.textTheta), 2), x, y)
 		c.w.Write([]byte(fmt.Sprintf(`<text x="%d" y="%d" %s%s>%s</text>`, x, y, c.styleAsSVG(style), transform, body)))
 	}
 }
@@ -280,7 +539,29 @@ func (c *canvas) Circle(x, y, r int, style Style) {
 	c.w.Write([]byte(fmt.Sprintf(`<circle cx="%d" cy="%d" r="%d" %s/>`, x, y, r, c.styleAsSVG(style))))
 }
 
+func (c *canvas) Image(mediaType string, contents []byte, box Box) {
+	c.w.Write([]byte(fmt.Sprintf(`<image x="%d" y="%d" width="%d" height="%d" xlink:href="data:%s;base64,%s"/>`, box.Left, box.Top, box.Width(), box.Height(), mediaType, base64.StdEncoding.EncodeToString(contents))))
+}
+
+// StartClip starts a group of the elements that follow clipped to a box, ending any clipped group
+// before it.
+func (c *canvas) StartClip(box Box) {
+	c.EndClip()
+	c.clips++
+	c.w.Write([]byte(fmt.Sprintf(`<clipPath id="clip%d"><rect x="%d" y="%d" width="%d" height="%d"/></clipPath><g clip-path="url(#clip%d)">`, c.clips, box.Left, box.Top, box.Width(), box.Height(), c.clips)))
+	c.clipped = true
+}
+
+// EndClip ends the clipped group, if there is one.
+func (c *canvas) EndClip() {
+	if c.clipped {
+		c.w.Write([]byte("</g>"))
+		c.clipped = false
+	}
+}
+
 func (c *canvas) End() {
+	c.EndClip()
 	c.w.Write([]byte("</svg>"))
 }
 
@@ -289,9 +570,13 @@ func (c *canvas) getStrokeDashArray(s Style) string {
 	if len(s.StrokeDashArray) > 0 {
 		var values []string
 		for _, v := range s.StrokeDashArray {
-			values = append(values, fmt.Sprintf("%0.1f", v))
+			values = append(values, c.formatFloat(v, 1))
 		}
-		return "stroke-dasharray=\"" + strings.Join(values, ", ") + "\""
+		separator := ", "
+		if c.options.Minify {
+			separator = ","
+		}
+		return "stroke-dasharray=\"" + strings.Join(values, separator) + "\""
 	}
 	return ""
 }
@@ -319,10 +604,10 @@ func (c *canvas) styleAsSVG(s Style) string {
 	if s.ClassName != "" {
 		var classes []string
 		classes = append(classes, s.ClassName)
-		if !sc.IsZero() {
+		if !sc.IsTransparent() {
 			classes = append(classes, "stroke")
 		}
-		if !fc.IsZero() {
+		if !fc.IsTransparent() {
 			classes = append(classes, "fill")
 		}
 		if fs != 0 || s.Font != nil {
@@ -334,28 +619,32 @@ func (c *canvas) styleAsSVG(s Style) string {
 
 	var pieces []string
 
-	if sw != 0 {
-		pieces = append(pieces, "stroke-width:"+fmt.Sprintf("%d", int(sw)))
+	if c.options.Minify && (sc.IsTransparent() || sw == 0) {
+		// svg elements have no stroke by default, so leave it out.
 	} else {
-		pieces = append(pieces, "stroke-width:0")
-	}
+		if sw != 0 {
+			pieces = append(pieces, "stroke-width:"+fmt.Sprintf("%d", int(sw)))
+		} else {
+			pieces = append(pieces, "stroke-width:0")
+		}
 
-	if !sc.IsZero() {
-		pieces = append(pieces, "stroke:"+sc.String())
-	} else {
-		pieces = append(pieces, "stroke:none")
+		if !sc.IsTransparent() {
+			pieces = append(pieces, "stroke:"+c.formatColor(sc))
+		} else {
+			pieces = append(pieces, "stroke:none")
+		}
 	}
 
-	if !fnc.IsZero() {
-		pieces = append(pieces, "fill:"+fnc.String())
-	} else if !fc.IsZero() {
-		pieces = append(pieces, "fill:"+fc.String())
+	if !fnc.IsTransparent() {
+		pieces = append(pieces, "fill:"+c.formatColor(fnc))
+	} else if !fc.IsTransparent() {
+		pieces = append(pieces, "fill:"+c.formatColor(fc))
 	} else {
 		pieces = append(pieces, "fill:none")
 	}
 
 	if fs != 0 {
-		pieces = append(pieces, "font-size:"+fmt.Sprintf("%.1fpx", drawing.PointsToPixels(c.dpi, fs)))
+		pieces = append(pieces, "font-size:"+c.formatFloat(drawing.PointsToPixels(c.dpi, fs), 1)+"px")
 	}
 
 	if s.Font != nil {
@@ -35,6 +35,22 @@ func TestVectorRendererPath(t *testing.T) {
 	testutil.AssertTrue(t, strings.HasSuffix(raw, "</svg>"))
 }
 
+func TestVectorRendererCubicCurveTo(t *testing.T) {
+	// replaced new assertions helper
+
+	vr, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+
+	vr.MoveTo(0, 0)
+	vr.(CubicCurveRenderer).CubicCurveTo(50, 0, 50, 100, 100, 100)
+	vr.Stroke()
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, vr.Save(buffer))
+	testutil.AssertContains(t, buffer.String(), `d="M 0 0
+C 50 0 50 100 100 100"`)
+}
+
 func TestVectorRendererMeasureText(t *testing.T) {
 	// replaced new assertions helper
 
@@ -115,3 +131,132 @@ func TestCanvasCustomInlineStylesheetWithNonce(t *testing.T) {
 
 	testutil.AssertContains(t, b.String(), fmt.Sprintf(`<style type="text/css" nonce="%s"><![CDATA[%s]]></style>`, canvas.nonce, canvas.css))
 }
+
+func TestSVGStream(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0, 4.0},
+				YValues: []float64{1.0, 3.0, 2.0, 4.0},
+			},
+		},
+	}
+
+	buffered := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, buffered))
+
+	streamed := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVGStream(streamed), bytes.NewBuffer([]byte{})))
+	testutil.AssertEqual(t, strings.Replace(buffered.String(), "<path  d=", "<path d=", -1), streamed.String())
+}
+
+func TestSVGStreamMeasured(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		CanvasWidth:  300,
+		CanvasHeight: 200,
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0, 4.0},
+				YValues: []float64{1.0, 3.0, 2.0, 4.0},
+			},
+		},
+	}
+	streamed := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVGStream(streamed), bytes.NewBuffer([]byte{})))
+	testutil.AssertEqual(t, 1, strings.Count(streamed.String(), "<svg"))
+	testutil.AssertEqual(t, 1, strings.Count(streamed.String(), "</svg>"))
+
+	stack := NewIndicatorStack(Chart{Series: c.Series}, IndicatorPanel{Series: c.Series})
+	streamed.Reset()
+	testutil.AssertNil(t, stack.Render(SVGStream(streamed), bytes.NewBuffer([]byte{})))
+	testutil.AssertEqual(t, 1, strings.Count(streamed.String(), "<svg"))
+	testutil.AssertEqual(t, 1, strings.Count(streamed.String(), "</svg>"))
+}
+
+func TestSVGStreamPendingElements(t *testing.T) {
+	// replaced new assertions helper
+
+	b := bytes.NewBuffer([]byte{})
+	r, err := SVGStream(b)(100, 100)
+	testutil.AssertNil(t, err)
+
+	r.SetStrokeColor(drawing.ColorBlack)
+	r.SetStrokeWidth(1)
+	r.MoveTo(0, 0)
+	r.LineTo(10, 10)
+	r.Circle(5, 50, 50)
+	r.Stroke()
+	r.MoveTo(20, 20)
+	testutil.AssertNil(t, r.Save(nil))
+
+	raw := b.String()
+	testutil.AssertTrue(t, strings.HasSuffix(raw, "</svg>"))
+	// the circle drawn while the path was open follows the path.
+	testutil.AssertTrue(t, strings.Index(raw, "M 0 0\nL 10 10\"") < strings.Index(raw, "<circle"))
+	// the path left undrawn is ended unpainted.
+	testutil.AssertContains(t, raw, `<path d="M 20 20" style="stroke-width:0;stroke:none;fill:none"/>`)
+}
+
+func TestSVGWithOptionsRelativePaths(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := SVGWithOptions(SVGOptions{RelativePaths: true, Minify: true})(100, 100)
+	testutil.AssertNil(t, err)
+
+	r.SetStrokeColor(drawing.ColorBlack)
+	r.SetStrokeWidth(1)
+	r.MoveTo(10, 10)
+	r.LineTo(20, 15)
+	r.QuadCurveTo(30, 30, 20, 40)
+	r.Close()
+	r.LineTo(5, 5)
+	r.Stroke()
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, r.Save(b))
+	testutil.AssertContains(t, b.String(), `<path d="M10 10 l10 5 q10 15 0 25 Z l-5 -5" style="stroke-width:1;stroke:#000000;fill:none"/>`)
+}
+
+func TestSVGWithOptionsPrecision(t *testing.T) {
+	// replaced new assertions helper
+
+	c := &canvas{options: SVGOptions{Precision: 3}}
+	testutil.AssertEqual(t, "1.235", c.formatFloat(1.23456, 2))
+	testutil.AssertEqual(t, "1.5", c.formatFloat(1.5, 2))
+	testutil.AssertEqual(t, "2", c.formatFloat(2, 2))
+	testutil.AssertEqual(t, "rgba(255,0,0,0.502)", c.formatColor(drawing.ColorRed.WithAlpha(128)))
+
+	c = &canvas{options: SVGOptions{Precision: Disabled}}
+	testutil.AssertEqual(t, "1", c.formatFloat(1.23456, 2))
+
+	c = &canvas{}
+	testutil.AssertEqual(t, "1.23", c.formatFloat(1.23456, 2))
+	testutil.AssertEqual(t, "rgba(255,0,0,1.0)", c.formatColor(drawing.ColorRed))
+}
+
+func TestSVGWithOptionsMinify(t *testing.T) {
+	// replaced new assertions helper
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0, 4.0},
+				YValues: []float64{1.0, 3.0, 2.0, 4.0},
+			},
+		},
+	}
+
+	plain := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVG, plain))
+	minified := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(SVGWithOptions(SVGOptions{Minify: true, RelativePaths: true}), minified))
+
+	testutil.AssertTrue(t, minified.Len() < plain.Len())
+	testutil.AssertNotContains(t, minified.String(), "stroke:none")
+	testutil.AssertNotContains(t, minified.String(), "<path  d=")
+	testutil.AssertEqual(t, strings.Count(plain.String(), "<path"), strings.Count(minified.String(), "<path"))
+}
@@ -0,0 +1,200 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultViolinWidth is the default half width of a violin, in x axis units.
+	DefaultViolinWidth = 0.4
+	// DefaultViolinSamples is the default number of points along each side of a violin.
+	DefaultViolinSamples = 64
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*ViolinSeries)(nil)
+	_ BoundedValuesProvider = (*ViolinSeries)(nil)
+)
+
+// ViolinSeries draws the distribution of the observations of each of a set of categories as a violin,
+// a kernel density estimate mirrored around the position of the category, optionally with a box plot
+// of the quartiles inside it.
+//
+// Each violin spans the range of its observations, and is scaled so its widest point is `Width`.
+type ViolinSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// XValues are the positions of each category, e.g. from `OrdinalRange.Position`.
+	XValues []float64
+	// YValues are the observations of each category.
+	YValues [][]float64
+
+	// Bandwidth is the kernel density bandwidth, it defaults to `SilvermanBandwidth` of each category.
+	Bandwidth float64
+	// Width is the half width of the widest point of each violin, in x axis units.
+	Width float64
+
+	ShowBoxPlot bool
+	BoxStyle    Style
+}
+
+// GetName returns the name of the time series.
+func (vs ViolinSeries) GetName() string {
+	return vs.Name
+}
+
+// GetStyle returns the line style.
+func (vs ViolinSeries) GetStyle() Style {
+	return vs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (vs ViolinSeries) GetYAxis() YAxisType {
+	return vs.YAxis
+}
+
+// GetWidth returns the half width of the violins or the default.
+func (vs ViolinSeries) GetWidth() float64 {
+	if vs.Width == 0 {
+		return DefaultViolinWidth
+	}
+	return vs.Width
+}
+
+// Len returns the number of bounded values, the left and right edge of each violin.
+func (vs ViolinSeries) Len() int {
+	return len(vs.XValues) << 1
+}
+
+// GetBoundedValues returns the left or right edge of a violin, with the range of its observations.
+func (vs ViolinSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	category := index >> 1
+	x = vs.XValues[category] - vs.GetWidth()
+	if index%2 == 1 {
+		x = vs.XValues[category] + vs.GetWidth()
+	}
+	y1, y2 = MinMax(vs.YValues[category]...)
+	return
+}
+
+// Render renders the series.
+func (vs ViolinSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := vs.Style.InheritFrom(defaults.InheritFrom(Style{
+		StrokeWidth: 1.0,
+	}))
+	if style.FillColor.IsZero() {
+		style.FillColor = style.GetStrokeColor().WithAlpha(100)
+	}
+	boxStyle := vs.BoxStyle.InheritFrom(Style{
+		StrokeWidth: 1.0,
+		StrokeColor: style.GetStrokeColor(),
+		FillColor:   style.GetStrokeColor(),
+		DotColor:    ColorWhite,
+	})
+
+	for category, x := range vs.XValues {
+		values := vs.YValues[category]
+		if len(values) == 0 {
+			continue
+		}
+
+		cx := canvasBox.Left + xrange.Translate(x)
+		halfWidth := float64(canvasBox.Left + xrange.Translate(x+vs.GetWidth()) - cx)
+		vs.drawViolin(r, canvasBox, yrange, style, values, cx, halfWidth)
+
+		if vs.ShowBoxPlot {
+			vs.drawBoxPlot(r, canvasBox, yrange, boxStyle, values, cx, halfWidth)
+		}
+	}
+}
+
+// drawViolin draws the outline of the density of the values up the right side and back down the left.
+func (vs ViolinSeries) drawViolin(r Renderer, canvasBox Box, yrange Range, style Style, values []float64, cx int, halfWidth float64) {
+	min, max := MinMax(values...)
+	density := KernelDensity(values, vs.Bandwidth)
+
+	ys := make([]float64, DefaultViolinSamples)
+	samples := make([]float64, DefaultViolinSamples)
+	var peak float64
+	for index := range samples {
+		ys[index] = min + (max-min)*float64(index)/float64(DefaultViolinSamples-1)
+		samples[index] = density(ys[index])
+		peak = math.Max(peak, samples[index])
+	}
+	if peak == 0 {
+		return
+	}
+
+	widthAt := func(index int) int {
+		return int(math.Round(halfWidth * samples[index] / peak))
+	}
+	yAt := func(index int) int {
+		return canvasBox.Bottom - yrange.Translate(ys[index])
+	}
+
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(cx+widthAt(0), yAt(0))
+	for index := 1; index < len(samples); index++ {
+		r.LineTo(cx+widthAt(index), yAt(index))
+	}
+	for index := len(samples) - 1; index >= 0; index-- {
+		r.LineTo(cx-widthAt(index), yAt(index))
+	}
+	r.Close()
+	r.FillStroke()
+}
+
+// drawBoxPlot draws a narrow box of the quartiles with whiskers to the extremes and a dot at the median.
+func (vs ViolinSeries) drawBoxPlot(r Renderer, canvasBox Box, yrange Range, style Style, values []float64, cx int, halfWidth float64) {
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	yAt := func(value float64) int {
+		return canvasBox.Bottom - yrange.Translate(value)
+	}
+
+	style.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(cx, yAt(sorted[0]))
+	r.LineTo(cx, yAt(sorted[len(sorted)-1]))
+	r.Stroke()
+
+	boxWidth := MaxInt(1, int(halfWidth*0.1))
+	Draw.Box(r, Box{
+		Top:    yAt(quantile(sorted, 0.75)),
+		Left:   cx - boxWidth,
+		Right:  cx + boxWidth,
+		Bottom: yAt(quantile(sorted, 0.25)),
+	}, style)
+
+	style.GetDotOptions().WriteToRenderer(r)
+	r.Circle(math.Max(1, float64(boxWidth)*0.6), cx, yAt(quantile(sorted, 0.5)))
+	r.Fill()
+}
+
+// Validate validates the series.
+func (vs ViolinSeries) Validate() error {
+	if len(vs.XValues) == 0 {
+		return fmt.Errorf("violin series must have xvalues set")
+	}
+	if len(vs.XValues) != len(vs.YValues) {
+		return fmt.Errorf("violin series must have the same number of xvalues as categories of yvalues")
+	}
+	for _, values := range vs.YValues {
+		if len(values) == 0 {
+			return fmt.Errorf("violin series must have at least one observation for each category")
+		}
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its values with the original.
+func (vs ViolinSeries) CopySeries() Series {
+	vs.XValues = append([]float64(nil), vs.XValues...)
+	vs.YValues = copyFloat64Slices(vs.YValues)
+	return vs
+}
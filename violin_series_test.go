@@ -0,0 +1,71 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestViolinSeriesGetBoundedValues(t *testing.T) {
+	// replaced new assertions helper
+
+	vs := ViolinSeries{
+		XValues: []float64{0, 1},
+		YValues: [][]float64{{1, 2, 3}, {-1, 4}},
+	}
+
+	testutil.AssertEqual(t, 4, vs.Len())
+
+	x, y1, y2 := vs.GetBoundedValues(0)
+	testutil.AssertEqual(t, -DefaultViolinWidth, x)
+	testutil.AssertEqual(t, 1.0, y1)
+	testutil.AssertEqual(t, 3.0, y2)
+
+	x, y1, y2 = vs.GetBoundedValues(3)
+	testutil.AssertEqual(t, 1+DefaultViolinWidth, x)
+	testutil.AssertEqual(t, -1.0, y1)
+	testutil.AssertEqual(t, 4.0, y2)
+}
+
+func TestViolinSeriesValidate(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, ViolinSeries{}.Validate())
+	testutil.AssertNotNil(t, ViolinSeries{XValues: []float64{0}}.Validate())
+	testutil.AssertNotNil(t, ViolinSeries{XValues: []float64{0}, YValues: [][]float64{{}}}.Validate())
+	testutil.AssertNil(t, ViolinSeries{XValues: []float64{0}, YValues: [][]float64{{1}}}.Validate())
+}
+
+func TestViolinSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	graph := Chart{
+		XAxis: XAxis{Range: NewOrdinalRange("one", "two")},
+		Series: []Series{
+			ViolinSeries{
+				XValues:     []float64{0, 1},
+				YValues:     [][]float64{{1, 2, 2, 3, 3, 3, 4}, {2, 2, 2}},
+				ShowBoxPlot: true,
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, graph.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), "<path")
+}
+
+func TestViolinSeriesCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	vs := ViolinSeries{
+		XValues: []float64{0, 1},
+		YValues: [][]float64{{1, 2, 3}, {4, 5, 6}},
+	}
+	copied := vs.CopySeries().(ViolinSeries)
+	copied.XValues[0] = 10
+	copied.YValues[1][0] = 10
+	testutil.AssertEqual(t, 0.0, vs.XValues[0])
+	testutil.AssertEqual(t, 4.0, vs.YValues[1][0])
+}
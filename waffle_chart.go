@@ -0,0 +1,245 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// WaffleChart is a chart that draws a grid of squares, colored by category in proportion to each value,
+// as an alternative to a pie chart.
+type WaffleChart struct {
+	ChartFrame
+
+	CellStyle   Style
+	LegendStyle Style
+
+	// Rows and Columns are the dimensions of the grid, they default to a 10x10 grid of 100 cells.
+	Rows    int
+	Columns int
+	// CellSpacing is the pixel spacing between cells.
+	CellSpacing int
+
+	Values   []Value
+	Elements []Renderable
+}
+
+// GetRows returns the number of rows in the grid or the default value.
+func (wc WaffleChart) GetRows() int {
+	if wc.Rows == 0 {
+		return DefaultWaffleGridSize
+	}
+	return wc.Rows
+}
+
+// GetColumns returns the number of columns in the grid or the default value.
+func (wc WaffleChart) GetColumns() int {
+	if wc.Columns == 0 {
+		return DefaultWaffleGridSize
+	}
+	return wc.Columns
+}
+
+// GetCellSpacing returns the spacing between cells or the default value.
+func (wc WaffleChart) GetCellSpacing() int {
+	if wc.CellSpacing == 0 {
+		return DefaultWaffleCellSpacing
+	}
+	return wc.CellSpacing
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (wc WaffleChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(wc.Values) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one value"))
+	}
+	if wc.Rows < 0 || wc.Columns < 0 {
+		return newRenderError(RenderStageValidate, errors.New("waffle chart grid dimensions must be positive"))
+	}
+
+	width, height := wc.GetWidth(), wc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if wc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		wc.defaultFont = defaultFont
+	}
+	r.SetDPI(wc.GetDPI(DefaultDPI))
+
+	counts, err := wc.getCellCounts()
+	if err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+
+	canvasBox := wc.getDefaultCanvasBox(r, width, height)
+	gridBox, legendBox := wc.getLayout(r, canvasBox)
+
+	wc.drawBackground(r, width, height)
+	wc.drawCanvas(r, canvasBox)
+	wc.drawCells(r, gridBox, counts)
+	wc.drawLegend(r, gridBox, legendBox)
+	wc.drawTitle(r, width, height)
+	for _, a := range wc.Elements {
+		a(r, canvasBox, wc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+// drawCells fills the grid row by row from the top left, with the cells of each value in turn.
+func (wc WaffleChart) drawCells(r Renderer, gridBox Box, counts []int) {
+	cellSize := wc.getCellSize(gridBox)
+	spacing := wc.GetCellSpacing()
+	columns := wc.GetColumns()
+
+	var cell int
+	for index, count := range counts {
+		style := wc.Values[index].Style.InheritFrom(wc.styleWaffleChartValue(index))
+		for c := 0; c < count; c++ {
+			row, column := cell/columns, cell%columns
+			left := gridBox.Left + column*(cellSize+spacing)
+			top := gridBox.Top + row*(cellSize+spacing)
+			Draw.Box(r, Box{Top: top, Left: left, Right: left + cellSize, Bottom: top + cellSize}, style)
+			cell++
+		}
+	}
+}
+
+// drawLegend draws a swatch and label for each value, vertically centered beside the grid.
+func (wc WaffleChart) drawLegend(r Renderer, gridBox, legendBox Box) {
+	if wc.LegendStyle.Hidden || legendBox.IsZero() {
+		return
+	}
+
+	textStyle := wc.styleDefaultsLegend()
+	swatch := wc.getLegendSwatchSize()
+	lineHeight := swatch + DefaultLineSpacing
+	top := gridBox.Top + (gridBox.Height()-lineHeight*len(wc.Values))>>1
+
+	for index, v := range wc.Values {
+		y := top + index*lineHeight
+		Draw.Box(r, Box{
+			Top:    y,
+			Left:   legendBox.Left,
+			Right:  legendBox.Left + swatch,
+			Bottom: y + swatch,
+		}, v.Style.InheritFrom(wc.styleWaffleChartValue(index)))
+		if len(v.Label) > 0 {
+			tb := Draw.MeasureText(r, v.Label, textStyle)
+			Draw.Text(r, v.Label, legendBox.Left+swatch+DefaultWaffleLegendGap, y+(swatch+tb.Height())>>1, textStyle)
+		}
+	}
+}
+
+// getCellCounts returns the number of cells for each value, proportional to the values and
+// summing to the size of the grid, by giving any cells left over from rounding down to the
+// values with the largest remainders.
+func (wc WaffleChart) getCellCounts() ([]int, error) {
+	var total float64
+	for _, v := range wc.Values {
+		if v.Value < 0 {
+			return nil, fmt.Errorf("waffle chart values cannot be negative")
+		}
+		total += v.Value
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("waffle chart must contain at least (1) non-zero value")
+	}
+
+	cells := wc.GetRows() * wc.GetColumns()
+	counts := make([]int, len(wc.Values))
+	remainders := make([]float64, len(wc.Values))
+	assigned := 0
+	for index, v := range wc.Values {
+		exact := float64(cells) * v.Value / total
+		counts[index] = int(math.Floor(exact))
+		remainders[index] = exact - float64(counts[index])
+		assigned += counts[index]
+	}
+
+	order := make([]int, len(wc.Values))
+	for index := range order {
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]] > remainders[order[j]]
+	})
+	for i := 0; assigned < cells; i++ {
+		counts[order[i%len(order)]]++
+		assigned++
+	}
+	return counts, nil
+}
+
+func (wc WaffleChart) getCellSize(gridBox Box) int {
+	spacing := wc.GetCellSpacing()
+	return (gridBox.Width() - (wc.GetColumns()-1)*spacing) / wc.GetColumns()
+}
+
+// getLayout returns the largest grid of square cells that fits the canvas beside the legend,
+// with the grid and legend centered in the canvas together.
+func (wc WaffleChart) getLayout(r Renderer, canvasBox Box) (gridBox, legendBox Box) {
+	var legendWidth int
+	if !wc.LegendStyle.Hidden {
+		textStyle := wc.styleDefaultsLegend()
+		var labelWidth int
+		for _, v := range wc.Values {
+			labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, v.Label, textStyle).Width())
+		}
+		legendWidth = DefaultWaffleLegendGap + wc.getLegendSwatchSize() + DefaultWaffleLegendGap + labelWidth
+	}
+
+	rows, columns, spacing := wc.GetRows(), wc.GetColumns(), wc.GetCellSpacing()
+	cellSize := MaxInt(0, MinInt(
+		(canvasBox.Width()-legendWidth-(columns-1)*spacing)/columns,
+		(canvasBox.Height()-(rows-1)*spacing)/rows,
+	))
+
+	width, height := columns*cellSize+(columns-1)*spacing, rows*cellSize+(rows-1)*spacing
+	left := canvasBox.Left + (canvasBox.Width()-width-legendWidth)>>1
+	top := canvasBox.Top + (canvasBox.Height()-height)>>1
+	gridBox = Box{
+		Top:    top,
+		Left:   left,
+		Right:  left + width,
+		Bottom: top + height,
+	}
+	if legendWidth > 0 {
+		legendBox = Box{
+			Top:    gridBox.Top,
+			Left:   gridBox.Right + DefaultWaffleLegendGap,
+			Right:  gridBox.Right + legendWidth,
+			Bottom: gridBox.Bottom,
+		}
+	}
+	return
+}
+
+// getLegendSwatchSize returns the size of the legend swatches, the height of the legend text in pixels.
+func (wc WaffleChart) getLegendSwatchSize() int {
+	return int(wc.styleDefaultsLegend().GetFontSize() * wc.GetDPI(DefaultDPI) / 72.0)
+}
+
+func (wc WaffleChart) styleWaffleChartValue(index int) Style {
+	return wc.CellStyle.InheritFrom(Style{
+		StrokeColor: wc.GetColorPalette().GetSeriesColor(index),
+		StrokeWidth: DefaultStrokeWidth,
+		FillColor:   wc.GetColorPalette().GetSeriesColor(index),
+	})
+}
+
+func (wc WaffleChart) styleDefaultsLegend() Style {
+	return wc.LegendStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: wc.GetColorPalette().TextColor(),
+		Font:      wc.GetFont(),
+	})
+}
@@ -0,0 +1,94 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestWaffleChart(t *testing.T) {
+	// replaced new assertions helper
+
+	wc := WaffleChart{
+		ChartFrame: ChartFrame{
+			Title: "test",
+		},
+		Values: []Value{
+			{Value: 5, Label: "Blue"},
+			{Value: 5, Label: "Green"},
+			{Value: 4, Label: "Gray"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, wc.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestWaffleChartProps(t *testing.T) {
+	// replaced new assertions helper
+
+	wc := WaffleChart{}
+	testutil.AssertEqual(t, DefaultWaffleGridSize, wc.GetRows())
+	testutil.AssertEqual(t, DefaultWaffleGridSize, wc.GetColumns())
+	testutil.AssertEqual(t, DefaultWaffleCellSpacing, wc.GetCellSpacing())
+
+	wc.Rows, wc.Columns, wc.CellSpacing = 5, 20, 1
+	testutil.AssertEqual(t, 5, wc.GetRows())
+	testutil.AssertEqual(t, 20, wc.GetColumns())
+	testutil.AssertEqual(t, 1, wc.GetCellSpacing())
+}
+
+func TestWaffleChartGetCellCounts(t *testing.T) {
+	// replaced new assertions helper
+
+	wc := WaffleChart{
+		Values: []Value{
+			{Value: 1},
+			{Value: 1},
+			{Value: 1},
+		},
+	}
+
+	counts, err := wc.getCellCounts()
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []int{34, 33, 33}, counts)
+
+	wc.Values = []Value{{Value: 42}, {Value: 27.5}, {Value: 30.5}}
+	counts, err = wc.getCellCounts()
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []int{42, 28, 30}, counts)
+
+	wc.Values = []Value{{Value: 0}}
+	_, err = wc.getCellCounts()
+	testutil.AssertNotNil(t, err)
+
+	wc.Values = []Value{{Value: 1}, {Value: -1}}
+	_, err = wc.getCellCounts()
+	testutil.AssertNotNil(t, err)
+}
+
+func TestWaffleChartGetLayout(t *testing.T) {
+	// replaced new assertions helper
+
+	r, err := PNG(DefaultChartWidth, DefaultChartHeight)
+	testutil.AssertNil(t, err)
+
+	wc := WaffleChart{
+		Values:      []Value{{Value: 1, Label: "One"}},
+		LegendStyle: Hidden(),
+	}
+	canvasBox := wc.Box()
+	gridBox, legendBox := wc.getLayout(r, canvasBox)
+	testutil.AssertTrue(t, legendBox.IsZero())
+	testutil.AssertEqual(t, gridBox.Width(), gridBox.Height())
+	testutil.AssertEqual(t, canvasBox.Height()-gridBox.Height(), 2*(gridBox.Top-canvasBox.Top))
+}
+
+func TestWaffleChartRenderNoValues(t *testing.T) {
+	// replaced new assertions helper
+
+	wc := WaffleChart{}
+	testutil.AssertNotNil(t, wc.Render(PNG, bytes.NewBuffer(nil)))
+}
@@ -0,0 +1,217 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultWaterfallBarWidth is the default half width of the bars of a waterfall series, in x axis units.
+	DefaultWaterfallBarWidth = 0.35
+	// DefaultWaterfallTotalLabel is the default label of the total bar of a waterfall series.
+	DefaultWaterfallTotalLabel = "Total"
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*WaterfallSeries)(nil)
+	_ BoundedValuesProvider = (*WaterfallSeries)(nil)
+)
+
+// WaterfallSeries draws a running total as a bar for each change in `Values`, where each bar starts
+// where the previous one ends, increases and decreases in different colors, with an optional final
+// bar from zero to the total.
+//
+// The bars are at positions 0, 1, 2 and so on; `GetXRange` returns an ordinal range labeled for them.
+type WaterfallSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// Values are the labeled changes to the running total.
+	Values []Value
+
+	// ShowTotal adds a bar for the total after the changes.
+	ShowTotal  bool
+	TotalLabel string
+
+	// Width is the half width of each bar, in x axis units.
+	Width float64
+
+	IncreaseStyle Style
+	DecreaseStyle Style
+	TotalStyle    Style
+	// ConnectorStyle is the style of the lines joining the end of each bar to the start of the next.
+	ConnectorStyle Style
+}
+
+// GetName returns the name of the time series.
+func (ws WaterfallSeries) GetName() string {
+	return ws.Name
+}
+
+// GetStyle returns the line style.
+func (ws WaterfallSeries) GetStyle() Style {
+	return ws.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ws WaterfallSeries) GetYAxis() YAxisType {
+	return ws.YAxis
+}
+
+// GetWidth returns the half width of the bars or the default.
+func (ws WaterfallSeries) GetWidth() float64 {
+	if ws.Width == 0 {
+		return DefaultWaterfallBarWidth
+	}
+	return ws.Width
+}
+
+// GetTotalLabel returns the label of the total bar or the default.
+func (ws WaterfallSeries) GetTotalLabel() string {
+	if len(ws.TotalLabel) == 0 {
+		return DefaultWaterfallTotalLabel
+	}
+	return ws.TotalLabel
+}
+
+// GetTotal returns the sum of the changes.
+func (ws WaterfallSeries) GetTotal() (total float64) {
+	for _, v := range ws.Values {
+		total += v.Value
+	}
+	return
+}
+
+// GetXRange returns an ordinal range with the label of each bar, for the x axis.
+func (ws WaterfallSeries) GetXRange() *OrdinalRange {
+	keys := make([]interface{}, 0, len(ws.Values)+1)
+	for _, v := range ws.Values {
+		keys = append(keys, v.Label)
+	}
+	if ws.ShowTotal {
+		keys = append(keys, ws.GetTotalLabel())
+	}
+	return NewOrdinalRange(keys...)
+}
+
+// getBars returns the start and end of each bar, with the total bar last if it is shown.
+func (ws WaterfallSeries) getBars() [][2]float64 {
+	bars := make([][2]float64, 0, len(ws.Values)+1)
+	var total float64
+	for _, v := range ws.Values {
+		bars = append(bars, [2]float64{total, total + v.Value})
+		total += v.Value
+	}
+	if ws.ShowTotal {
+		bars = append(bars, [2]float64{0, total})
+	}
+	return bars
+}
+
+// Len returns the number of bounded values, the left and right edge of each bar.
+func (ws WaterfallSeries) Len() int {
+	length := len(ws.Values)
+	if ws.ShowTotal {
+		length++
+	}
+	return length << 1
+}
+
+// GetBoundedValues returns the left or right edge of a bar, with its top and bottom.
+func (ws WaterfallSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	bar := index >> 1
+	x = float64(bar) - ws.GetWidth()
+	if index%2 == 1 {
+		x = float64(bar) + ws.GetWidth()
+	}
+
+	var start, end float64
+	for i, v := range ws.Values {
+		if i == bar {
+			end = start + v.Value
+			break
+		}
+		start += v.Value
+	}
+	if bar == len(ws.Values) {
+		start, end = 0, ws.GetTotal()
+	}
+	return x, math.Max(start, end), math.Min(start, end)
+}
+
+// Render renders the series.
+func (ws WaterfallSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := ws.Style.InheritFrom(defaults.InheritFrom(Style{
+		StrokeWidth: 1.0,
+	}))
+	increaseStyle := ws.IncreaseStyle.InheritFrom(Style{
+		FillColor:   ColorGreen,
+		StrokeColor: ColorGreen,
+		StrokeWidth: style.GetStrokeWidth(),
+	})
+	decreaseStyle := ws.DecreaseStyle.InheritFrom(Style{
+		FillColor:   ColorRed,
+		StrokeColor: ColorRed,
+		StrokeWidth: style.GetStrokeWidth(),
+	})
+	totalStyle := ws.TotalStyle.InheritFrom(Style{
+		FillColor:   style.GetStrokeColor(),
+		StrokeColor: style.GetStrokeColor(),
+		StrokeWidth: style.GetStrokeWidth(),
+	})
+	connectorStyle := ws.ConnectorStyle.InheritFrom(Style{
+		StrokeColor:     DefaultAxisColor,
+		StrokeWidth:     DefaultAxisLineWidth,
+		StrokeDashArray: []float64{3, 3},
+	})
+
+	xAt := func(value float64) int {
+		return canvasBox.Left + xrange.Translate(value)
+	}
+	yAt := func(value float64) int {
+		return canvasBox.Bottom - yrange.Translate(value)
+	}
+
+	bars := ws.getBars()
+	for index, bar := range bars {
+		x := float64(index)
+		barStyle := increaseStyle
+		if ws.ShowTotal && index == len(bars)-1 {
+			barStyle = totalStyle
+		} else {
+			if bar[1] < bar[0] {
+				barStyle = decreaseStyle
+			}
+			barStyle = ws.Values[index].Style.InheritFrom(barStyle)
+		}
+		Draw.Box(r, Box{
+			Top:    yAt(math.Max(bar[0], bar[1])),
+			Left:   xAt(x - ws.GetWidth()),
+			Right:  xAt(x + ws.GetWidth()),
+			Bottom: yAt(math.Min(bar[0], bar[1])),
+		}, barStyle)
+
+		if index < len(bars)-1 && !ws.ConnectorStyle.Hidden {
+			connectorStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(xAt(x+ws.GetWidth()), yAt(bar[1]))
+			r.LineTo(xAt(x+1-ws.GetWidth()), yAt(bar[1]))
+			r.Stroke()
+		}
+	}
+}
+
+// Validate validates the series.
+func (ws WaterfallSeries) Validate() error {
+	if len(ws.Values) == 0 {
+		return fmt.Errorf("waterfall series must have values set")
+	}
+	return nil
+}
+
+// CopySeries returns a copy of the series that does not share its values with the original.
+func (ws WaterfallSeries) CopySeries() Series {
+	ws.Values = append([]Value(nil), ws.Values...)
+	return ws
+}
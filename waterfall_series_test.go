@@ -0,0 +1,69 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestWaterfallSeries(t *testing.T) {
+	// replaced new assertions helper
+
+	ws := WaterfallSeries{
+		ShowTotal: true,
+		Values: []Value{
+			{Label: "a", Value: 10},
+			{Label: "b", Value: -4},
+			{Label: "c", Value: 2},
+		},
+	}
+	testutil.AssertNil(t, ws.Validate())
+	testutil.AssertEqual(t, 8.0, ws.GetTotal())
+	testutil.AssertEqual(t, [][2]float64{{0, 10}, {10, 6}, {6, 8}, {0, 8}}, ws.getBars())
+	testutil.AssertEqual(t, []interface{}{"a", "b", "c", DefaultWaterfallTotalLabel}, ws.GetXRange().Keys)
+
+	testutil.AssertEqual(t, 8, ws.Len())
+	x, y1, y2 := ws.GetBoundedValues(2)
+	testutil.AssertEqual(t, 1-DefaultWaterfallBarWidth, x)
+	testutil.AssertEqual(t, 10.0, y1)
+	testutil.AssertEqual(t, 6.0, y2)
+	x, y1, y2 = ws.GetBoundedValues(7)
+	testutil.AssertEqual(t, 3+DefaultWaterfallBarWidth, x)
+	testutil.AssertEqual(t, 8.0, y1)
+	testutil.AssertEqual(t, 0.0, y2)
+
+	ws.ShowTotal = false
+	testutil.AssertEqual(t, 6, ws.Len())
+	testutil.AssertLen(t, ws.getBars(), 3)
+
+	testutil.AssertNotNil(t, WaterfallSeries{}.Validate())
+}
+
+func TestWaterfallSeriesRender(t *testing.T) {
+	// replaced new assertions helper
+
+	ws := WaterfallSeries{
+		ShowTotal: true,
+		Values: []Value{
+			{Label: "a", Value: 10},
+			{Label: "b", Value: -4},
+		},
+	}
+	c := Chart{
+		XAxis:  XAxis{Range: ws.GetXRange()},
+		Series: []Series{ws},
+	}
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestWaterfallSeriesCopySeries(t *testing.T) {
+	// replaced new assertions helper
+
+	ws := WaterfallSeries{Values: []Value{{Label: "a", Value: 1}, {Label: "b", Value: -2}}}
+	copied := ws.CopySeries().(WaterfallSeries)
+	copied.Values[0].Value = 10
+	testutil.AssertEqual(t, 1.0, ws.Values[0].Value)
+}
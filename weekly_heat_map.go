@@ -0,0 +1,184 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// Aggregation is an enum for the ways to combine the values that fall into the same bucket.
+type Aggregation int
+
+const (
+	// AggregationUnset is the unset state for aggregations; it defaults to `AggregationSum`.
+	AggregationUnset Aggregation = 0
+	// AggregationSum adds the values up.
+	AggregationSum Aggregation = 1
+	// AggregationMean averages the values.
+	AggregationMean Aggregation = 2
+	// AggregationCount counts the values.
+	AggregationCount Aggregation = 3
+	// AggregationMin takes the smallest value.
+	AggregationMin Aggregation = 4
+	// AggregationMax takes the largest value.
+	AggregationMax Aggregation = 5
+)
+
+// Aggregate combines the values, or returns NaN if there are none.
+func (a Aggregation) Aggregate(values ...float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	switch a {
+	case AggregationMean:
+		return Mean(values...)
+	case AggregationCount:
+		return float64(len(values))
+	case AggregationMin:
+		min, _ := MinMax(values...)
+		return min
+	case AggregationMax:
+		_, max := MinMax(values...)
+		return max
+	default:
+		return Sum(values...)
+	}
+}
+
+// WeeklyHeatMap aggregates a time series into a grid of the days of the week by the hours of the day,
+// and draws it as a heat map chart, e.g. to show when traffic happens.
+//
+// The rows are the days of the week, starting with `WeekStart`, and the columns are the hours of the
+// day, in `Location`. Hours without values are left empty.
+type WeeklyHeatMap struct {
+	Title  string
+	Width  int
+	Height int
+	DPI    float64
+
+	// Aggregation combines the values of the same day and hour, it defaults to `AggregationSum`.
+	Aggregation Aggregation
+	// WeekStart is the day of the week in the first row, it defaults to Sunday.
+	WeekStart time.Weekday
+	// Location is the time zone the values are bucketed in, it defaults to `time.Local`.
+	Location *time.Location
+
+	// ColorMap maps the values to colors, it defaults to `Greens`.
+	ColorMap   ColorMap
+	ShowValues bool
+	// ValueFormatter formats the values in the cells and the legend.
+	ValueFormatter ValueFormatter
+
+	// InnerSeries are the values to aggregate, with times as x values (see `TimeToFloat64`), e.g. a `TimeSeries`.
+	InnerSeries ValuesProvider
+}
+
+// GetAggregation returns the aggregation or the default.
+func (whm WeeklyHeatMap) GetAggregation() Aggregation {
+	if whm.Aggregation == AggregationUnset {
+		return AggregationSum
+	}
+	return whm.Aggregation
+}
+
+// GetLocation returns the location or the default.
+func (whm WeeklyHeatMap) GetLocation() *time.Location {
+	if whm.Location == nil {
+		return time.Local
+	}
+	return whm.Location
+}
+
+// GetColorMap returns the color map or the default.
+func (whm WeeklyHeatMap) GetColorMap() ColorMap {
+	if whm.ColorMap == nil {
+		return Greens
+	}
+	return whm.ColorMap
+}
+
+// GetValues returns the aggregated values, a row per day of the week and a column per hour of the day;
+// the hours without values are NaN.
+func (whm WeeklyHeatMap) GetValues() [][]float64 {
+	buckets := make([][][]float64, 7)
+	for row := range buckets {
+		buckets[row] = make([][]float64, 24)
+	}
+	location := whm.GetLocation()
+	for index := 0; index < whm.InnerSeries.Len(); index++ {
+		x, y := whm.InnerSeries.GetValues(index)
+		t := TimeFromFloat64(x).In(location)
+		row := (int(t.Weekday()) - int(whm.WeekStart) + 7) % 7
+		buckets[row][t.Hour()] = append(buckets[row][t.Hour()], y)
+	}
+
+	aggregation := whm.GetAggregation()
+	values := make([][]float64, 7)
+	for row := range buckets {
+		values[row] = make([]float64, 24)
+		for hour, bucket := range buckets[row] {
+			values[row][hour] = aggregation.Aggregate(bucket...)
+		}
+	}
+	return values
+}
+
+// GetRowLabels returns the abbreviated days of the week, starting with `WeekStart`.
+func (whm WeeklyHeatMap) GetRowLabels() []string {
+	labels := make([]string, 7)
+	for row := range labels {
+		labels[row] = time.Weekday((int(whm.WeekStart) + row) % 7).String()[:3]
+	}
+	return labels
+}
+
+// GetColumnLabels returns the hours of the day, as two digits.
+func (whm WeeklyHeatMap) GetColumnLabels() []string {
+	labels := make([]string, 24)
+	for hour := range labels {
+		labels[hour] = fmt.Sprintf("%02d", hour)
+	}
+	return labels
+}
+
+// Validate validates the heat map.
+func (whm WeeklyHeatMap) Validate() error {
+	if whm.InnerSeries == nil {
+		return errors.New("weekly heat map requires InnerSeries to be set")
+	}
+	if whm.InnerSeries.Len() == 0 {
+		return errors.New("weekly heat map requires at least one value")
+	}
+	if whm.Aggregation < AggregationUnset || whm.Aggregation > AggregationMax {
+		return fmt.Errorf("weekly heat map has an invalid aggregation: %d", whm.Aggregation)
+	}
+	return nil
+}
+
+// GetChart returns the heat map chart of the aggregated values.
+func (whm WeeklyHeatMap) GetChart() HeatMapChart {
+	return HeatMapChart{
+		ChartFrame: ChartFrame{
+			Title:  whm.Title,
+			Width:  whm.Width,
+			Height: whm.Height,
+			DPI:    whm.DPI,
+		},
+		ColorMap:       whm.GetColorMap(),
+		ShowValues:     whm.ShowValues,
+		ValueFormatter: whm.ValueFormatter,
+		Values:         whm.GetValues(),
+		RowLabels:      whm.GetRowLabels(),
+		ColumnLabels:   whm.GetColumnLabels(),
+	}
+}
+
+// Render renders the heat map with the given renderer to the given io.Writer.
+func (whm WeeklyHeatMap) Render(rp RendererProvider, w io.Writer) error {
+	if err := whm.Validate(); err != nil {
+		return newRenderError(RenderStageValidate, err)
+	}
+	return whm.GetChart().Render(rp, w)
+}
@@ -0,0 +1,79 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestAggregation(t *testing.T) {
+	// replaced new assertions helper
+
+	values := []float64{4, 1, 7}
+	testutil.AssertEqual(t, 12.0, AggregationUnset.Aggregate(values...))
+	testutil.AssertEqual(t, 12.0, AggregationSum.Aggregate(values...))
+	testutil.AssertEqual(t, 4.0, AggregationMean.Aggregate(values...))
+	testutil.AssertEqual(t, 3.0, AggregationCount.Aggregate(values...))
+	testutil.AssertEqual(t, 1.0, AggregationMin.Aggregate(values...))
+	testutil.AssertEqual(t, 7.0, AggregationMax.Aggregate(values...))
+	testutil.AssertTrue(t, math.IsNaN(AggregationSum.Aggregate()))
+}
+
+func TestWeeklyHeatMapGetValues(t *testing.T) {
+	// replaced new assertions helper
+
+	// 2024-03-04 is a monday.
+	whm := WeeklyHeatMap{
+		WeekStart: time.Monday,
+		Location:  time.UTC,
+		InnerSeries: TimeSeries{
+			XValues: []time.Time{
+				time.Date(2024, 3, 4, 9, 15, 0, 0, time.UTC),
+				time.Date(2024, 3, 11, 9, 45, 0, 0, time.UTC),
+				time.Date(2024, 3, 10, 23, 0, 0, 0, time.UTC),
+			},
+			YValues: []float64{2, 4, 5},
+		},
+	}
+	testutil.AssertEqual(t, AggregationSum, whm.GetAggregation())
+
+	values := whm.GetValues()
+	testutil.AssertLen(t, values, 7)
+	testutil.AssertLen(t, values[0], 24)
+	testutil.AssertEqual(t, 6.0, values[0][9])
+	testutil.AssertEqual(t, 5.0, values[6][23])
+	testutil.AssertTrue(t, math.IsNaN(values[1][9]))
+
+	whm.Aggregation = AggregationMean
+	testutil.AssertEqual(t, 3.0, whm.GetValues()[0][9])
+
+	testutil.AssertEqual(t, "Mon", whm.GetRowLabels()[0])
+	testutil.AssertEqual(t, "Sun", whm.GetRowLabels()[6])
+	testutil.AssertEqual(t, "Sun", WeeklyHeatMap{}.GetRowLabels()[0])
+	testutil.AssertEqual(t, "09", whm.GetColumnLabels()[9])
+}
+
+func TestWeeklyHeatMapRender(t *testing.T) {
+	// replaced new assertions helper
+
+	testutil.AssertNotNil(t, WeeklyHeatMap{}.Validate())
+	testutil.AssertNotNil(t, WeeklyHeatMap{InnerSeries: TimeSeries{}}.Validate())
+	testutil.AssertNotNil(t, WeeklyHeatMap{Aggregation: Aggregation(42), InnerSeries: TimeSeries{
+		XValues: []time.Time{time.Now()},
+		YValues: []float64{1},
+	}}.Validate())
+
+	whm := WeeklyHeatMap{
+		Title: "Traffic",
+		InnerSeries: TimeSeries{
+			XValues: []time.Time{time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 5, 17, 0, 0, 0, time.UTC)},
+			YValues: []float64{1, 2},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, whm.Render(SVG, buffer))
+	testutil.AssertContains(t, buffer.String(), "Traffic")
+}
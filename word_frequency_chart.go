@@ -0,0 +1,253 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// WordFrequencyLayout is an enum for the ways a word frequency chart can draw its words.
+type WordFrequencyLayout int
+
+const (
+	// WordFrequencyLayoutUnset is the unset state for word frequency layouts; it defaults to `WordFrequencyLayoutBars`.
+	WordFrequencyLayoutUnset WordFrequencyLayout = 0
+	// WordFrequencyLayoutBars draws the words as horizontal bars, ranked from the most frequent at the top.
+	WordFrequencyLayoutBars WordFrequencyLayout = 1
+	// WordFrequencyLayoutCloud draws the words as a cloud, sized by frequency, spiralling out from the center without overlapping.
+	WordFrequencyLayoutCloud WordFrequencyLayout = 2
+)
+
+// RankWords returns a value for each word labeled with the word, ranked from the most frequent,
+// with ties in alphabetical order.
+func RankWords(words map[string]float64) []Value {
+	values := make([]Value, 0, len(words))
+	for word, count := range words {
+		values = append(values, Value{Label: word, Value: count})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].Value == values[j].Value {
+			return values[i].Label < values[j].Label
+		}
+		return values[i].Value > values[j].Value
+	})
+	return values
+}
+
+// WordFrequencyChart is a chart that summarizes the counts of words, e.g. from text analytics, as either
+// a ranked horizontal bar chart or a word cloud.
+type WordFrequencyChart struct {
+	ChartFrame
+
+	BarStyle   Style
+	LabelStyle Style
+
+	Layout WordFrequencyLayout
+	// Limit is the number of most frequent words to draw.
+	Limit int
+	// MinFontSize and MaxFontSize are the font sizes of the least and most frequent words of a word cloud.
+	MinFontSize float64
+	MaxFontSize float64
+
+	// Words are the counts of the words; they are drawn in the order of `RankWords`, so renders are
+	// the same whatever order the map is iterated in.
+	Words    map[string]float64
+	Elements []Renderable
+}
+
+// GetLimit returns the number of words to draw or the default value.
+func (wfc WordFrequencyChart) GetLimit() int {
+	if wfc.Limit == 0 {
+		return DefaultWordFrequencyLimit
+	}
+	return wfc.Limit
+}
+
+// GetMinFontSize returns the font size of the least frequent words or the default value.
+func (wfc WordFrequencyChart) GetMinFontSize() float64 {
+	if wfc.MinFontSize == 0 {
+		return DefaultWordCloudMinFontSize
+	}
+	return wfc.MinFontSize
+}
+
+// GetMaxFontSize returns the font size of the most frequent words or the default value.
+func (wfc WordFrequencyChart) GetMaxFontSize() float64 {
+	if wfc.MaxFontSize == 0 {
+		return DefaultWordCloudMaxFontSize
+	}
+	return wfc.MaxFontSize
+}
+
+// GetWords returns the words to draw, ranked from the most frequent, up to the limit.
+func (wfc WordFrequencyChart) GetWords() []Value {
+	words := RankWords(wfc.Words)
+	if len(words) > wfc.GetLimit() {
+		words = words[:wfc.GetLimit()]
+	}
+	return words
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (wfc WordFrequencyChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(wfc.Words) == 0 {
+		return newRenderError(RenderStageValidate, errors.New("please provide at least one word"))
+	}
+	for _, count := range wfc.Words {
+		if count < 0 {
+			return newRenderError(RenderStageValidate, fmt.Errorf("word frequency chart counts cannot be negative"))
+		}
+	}
+
+	width, height := wfc.GetWidth(), wfc.GetHeight()
+	r, err := rp(width, height)
+	if err != nil {
+		return newRenderError(RenderStageRenderer, err)
+	}
+
+	if wfc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return newRenderError(RenderStageFonts, err)
+		}
+		wfc.defaultFont = defaultFont
+	}
+	r.SetDPI(wfc.GetDPI(DefaultDPI))
+
+	canvasBox := wfc.getDefaultCanvasBox(r, width, height)
+
+	wfc.drawBackground(r, width, height)
+	wfc.drawCanvas(r, canvasBox)
+	if wfc.Layout == WordFrequencyLayoutCloud {
+		wfc.drawCloud(r, canvasBox)
+	} else {
+		wfc.drawBars(r, canvasBox)
+	}
+	wfc.drawTitle(r, width, height)
+	for _, a := range wfc.Elements {
+		a(r, canvasBox, wfc.styleDefaultsElements())
+	}
+
+	return newRenderError(RenderStageEncode, r.Save(w))
+}
+
+// drawBars draws a row for each word, from the most frequent down, with the word to the left of its bar
+// and the count to the right.
+func (wfc WordFrequencyChart) drawBars(r Renderer, canvasBox Box) {
+	words := wfc.GetWords()
+	labelStyle := wfc.styleDefaultsLabels()
+
+	var wordWidth, countWidth int
+	for _, v := range words {
+		wordWidth = MaxInt(wordWidth, Draw.MeasureText(r, v.Label, labelStyle).Width())
+		countWidth = MaxInt(countWidth, Draw.MeasureText(r, FloatValueFormatterWithFormat(v.Value, "%v"), labelStyle).Width())
+	}
+	barLeft := canvasBox.Left + wordWidth + DefaultWordFrequencyLabelGap
+	barMaxWidth := canvasBox.Right - countWidth - DefaultWordFrequencyLabelGap - barLeft
+	if barMaxWidth <= 0 {
+		return
+	}
+
+	max := words[0].Value
+	rowHeight := float64(canvasBox.Height()) / float64(len(words))
+	for index, v := range words {
+		top := canvasBox.Top + int(math.Round(rowHeight*float64(index)))
+		bottom := canvasBox.Top + int(math.Round(rowHeight*float64(index+1)))
+		inset := int(rowHeight * 0.15)
+
+		right := barLeft
+		if max > 0 {
+			right += int(math.Round(float64(barMaxWidth) * v.Value / max))
+		}
+		Draw.Box(r, Box{
+			Top:    top + inset,
+			Left:   barLeft,
+			Right:  right,
+			Bottom: bottom - inset,
+		}, v.Style.InheritFrom(wfc.styleWordFrequencyBar()))
+
+		cy := (top + bottom) >> 1
+		tb := Draw.MeasureText(r, v.Label, labelStyle)
+		Draw.Text(r, v.Label, barLeft-DefaultWordFrequencyLabelGap-tb.Width(), cy+tb.Height()>>1, labelStyle)
+		count := FloatValueFormatterWithFormat(v.Value, "%v")
+		tb = Draw.MeasureText(r, count, labelStyle)
+		Draw.Text(r, count, right+DefaultWordFrequencyLabelGap, cy+tb.Height()>>1, labelStyle)
+	}
+}
+
+// drawCloud draws the words from the most frequent, each at the first point along a spiral out from the
+// center of the canvas where it fits without overlapping the words already drawn; words that do not
+// fit anywhere on the canvas are left out.
+func (wfc WordFrequencyChart) drawCloud(r Renderer, canvasBox Box) {
+	words := wfc.GetWords()
+	min, max := words[len(words)-1].Value, words[0].Value
+	cx, cy := canvasBox.Center()
+	aspect := float64(canvasBox.Width()) / math.Max(1, float64(canvasBox.Height()))
+	limit := math.Hypot(float64(canvasBox.Width()), float64(canvasBox.Height()))
+
+	var placed []Box
+	for index, v := range words {
+		style := v.Style.InheritFrom(wfc.styleWordCloudWord(index, v.Value, min, max))
+		tb := Draw.MeasureText(r, v.Label, style)
+		width, height := tb.Width(), tb.Height()
+
+		for theta := 0.0; theta*math.Max(1, aspect) < limit; theta += 0.1 {
+			radius := 2 * theta
+			x := cx + int(radius*math.Cos(theta)*aspect) - width>>1
+			y := cy + int(radius*math.Sin(theta)) - height>>1
+			box := Box{Top: y, Left: x, Right: x + width, Bottom: y + height}
+			if box.Left < canvasBox.Left || box.Right > canvasBox.Right || box.Top < canvasBox.Top || box.Bottom > canvasBox.Bottom {
+				continue
+			}
+			if wordCloudOverlaps(box, placed) {
+				continue
+			}
+			placed = append(placed, box)
+			Draw.Text(r, v.Label, box.Left, box.Bottom, style)
+			break
+		}
+	}
+}
+
+// wordCloudOverlaps returns if a box is within the word cloud padding of any of the placed boxes.
+func wordCloudOverlaps(box Box, placed []Box) bool {
+	for _, p := range placed {
+		if box.Left < p.Right+DefaultWordCloudPadding && p.Left < box.Right+DefaultWordCloudPadding &&
+			box.Top < p.Bottom+DefaultWordCloudPadding && p.Top < box.Bottom+DefaultWordCloudPadding {
+			return true
+		}
+	}
+	return false
+}
+
+func (wfc WordFrequencyChart) styleWordFrequencyBar() Style {
+	return wfc.BarStyle.InheritFrom(Style{
+		FillColor:   wfc.GetColorPalette().GetSeriesColor(0),
+		StrokeColor: wfc.GetColorPalette().GetSeriesColor(0),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
+
+// styleWordCloudWord returns the style of a word of a word cloud, with a font size between the min and
+// max font size in proportion to its count.
+func (wfc WordFrequencyChart) styleWordCloudWord(index int, count, min, max float64) Style {
+	fontSize := wfc.GetMaxFontSize()
+	if max > min {
+		fontSize = wfc.GetMinFontSize() + (wfc.GetMaxFontSize()-wfc.GetMinFontSize())*(count-min)/(max-min)
+	}
+	return wfc.LabelStyle.InheritFrom(Style{
+		FontSize:  fontSize,
+		FontColor: wfc.GetColorPalette().GetSeriesColor(index),
+		Font:      wfc.GetFont(),
+	})
+}
+
+func (wfc WordFrequencyChart) styleDefaultsLabels() Style {
+	return wfc.LabelStyle.InheritFrom(Style{
+		FontSize:  DefaultFontSize,
+		FontColor: wfc.GetColorPalette().TextColor(),
+		Font:      wfc.GetFont(),
+	})
+}
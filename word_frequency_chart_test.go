@@ -0,0 +1,85 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestRankWords(t *testing.T) {
+	// replaced new assertions helper
+
+	ranked := RankWords(map[string]float64{"b": 2, "a": 2, "c": 5, "d": 1})
+	testutil.AssertLen(t, ranked, 4)
+	testutil.AssertEqual(t, "c", ranked[0].Label)
+	testutil.AssertEqual(t, "a", ranked[1].Label)
+	testutil.AssertEqual(t, "b", ranked[2].Label)
+	testutil.AssertEqual(t, "d", ranked[3].Label)
+	testutil.AssertEqual(t, 5.0, ranked[0].Value)
+}
+
+func TestWordFrequencyChartGetWords(t *testing.T) {
+	// replaced new assertions helper
+
+	wfc := WordFrequencyChart{
+		Limit: 2,
+		Words: map[string]float64{"a": 1, "b": 2, "c": 3},
+	}
+	words := wfc.GetWords()
+	testutil.AssertLen(t, words, 2)
+	testutil.AssertEqual(t, "c", words[0].Label)
+	testutil.AssertEqual(t, "b", words[1].Label)
+}
+
+func TestWordFrequencyChartRender(t *testing.T) {
+	// replaced new assertions helper
+
+	for _, layout := range []WordFrequencyLayout{WordFrequencyLayoutUnset, WordFrequencyLayoutBars, WordFrequencyLayoutCloud} {
+		wfc := WordFrequencyChart{
+			ChartFrame: ChartFrame{
+				Title: "Test",
+			},
+			Layout: layout,
+			Words:  map[string]float64{"chart": 10, "series": 6, "axis": 3, "tick": 3, "font": 1},
+		}
+		for _, rp := range []RendererProvider{PNG, SVG} {
+			b := bytes.NewBuffer([]byte{})
+			testutil.AssertNil(t, wfc.Render(rp, b))
+			testutil.AssertNotZero(t, b.Len())
+		}
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNotNil(t, WordFrequencyChart{}.Render(PNG, b))
+	testutil.AssertNotNil(t, WordFrequencyChart{Words: map[string]float64{"a": -1}}.Render(PNG, b))
+}
+
+func TestWordFrequencyChartRenderReproducible(t *testing.T) {
+	// replaced new assertions helper
+
+	words := map[string]float64{}
+	for index, word := range []string{"chart", "series", "axis", "tick", "font", "legend", "style", "range", "value", "grid"} {
+		words[word] = float64(index % 3)
+	}
+	for _, layout := range []WordFrequencyLayout{WordFrequencyLayoutBars, WordFrequencyLayoutCloud} {
+		wfc := WordFrequencyChart{Layout: layout, Words: words}
+		first := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, wfc.Render(SVG, first))
+		// maps iterate in a different order each time, so render a few times to catch any dependence on it.
+		for attempt := 0; attempt < 5; attempt++ {
+			b := bytes.NewBuffer([]byte{})
+			testutil.AssertNil(t, wfc.Render(SVG, b))
+			testutil.AssertEqual(t, first.String(), b.String())
+		}
+	}
+}
+
+func TestWordCloudOverlaps(t *testing.T) {
+	// replaced new assertions helper
+
+	placed := []Box{{Top: 0, Left: 0, Right: 10, Bottom: 10}}
+	testutil.AssertTrue(t, wordCloudOverlaps(Box{Top: 5, Left: 5, Right: 15, Bottom: 15}, placed))
+	testutil.AssertTrue(t, wordCloudOverlaps(Box{Top: 0, Left: 11, Right: 20, Bottom: 10}, placed))
+	testutil.AssertFalse(t, wordCloudOverlaps(Box{Top: 0, Left: 20, Right: 30, Bottom: 10}, placed))
+}
@@ -2,6 +2,7 @@ package chart
 
 import (
 	"math"
+	"text/template"
 )
 
 // HideXAxis hides the x-axis.
@@ -19,14 +20,49 @@ type XAxis struct {
 	Style          Style
 	ValueFormatter ValueFormatter
 	Range          Range
+	// Descending draws the x values decreasing from left to right, in place of the default of increasing
+	// from left to right, without negating them. It applies to the range the chart creates when `Range`
+	// is not set; a set range is drawn in its own direction.
+	Descending bool
+	// Categories, if set and the range is not, makes the axis categorical: the chart ranges it with an
+	// `OrdinalRange` of the categories, so that the value of a series at x = i is drawn in the evenly
+	// spaced slot labeled with the i-th category.
+	Categories []string
 
+	// TickStyle styles the ticks and their labels; a `TextRotationDegrees` of e.g. 45 or 90 angles long
+	// labels, which then hang below their ticks, and the axis makes room for them.
 	TickStyle    Style
 	Ticks        []Tick
 	TickPosition TickPosition
+	// TickGenerator generates the ticks when `Ticks` is not set, in place of the range ticks or continuous ticks.
+	TickGenerator TickGenerator
+	// TickTemplate, if set, relabels the ticks; see `NewTickTemplate`.
+	TickTemplate *template.Template
 
 	GridLines      []GridLine
 	GridMajorStyle Style
 	GridMinorStyle Style
+	// GridStyle, if set, draws a grid line at every tick in place of the alternating major and minor grid
+	// lines, e.g. faint dotted lines, independently of the axis style.
+	GridStyle Style
+
+	// MinorTicks is the number of minor ticks between each pair of ticks, drawn as shorter tick marks
+	// without labels. With minor ticks, `GridMinorStyle` draws lighter grid lines at them, in place of
+	// the alternating major and minor grid lines.
+	MinorTicks     int
+	MinorTickStyle Style
+
+	// Boundary, for an axis of timestamps, draws a line from the top of the canvas down past the tick
+	// labels at the start of each week, month or quarter (`TimeBoundaryWeek`, `TimeBoundaryMonth` or
+	// `TimeBoundaryQuarter`), labeled below the tick labels. It defaults to `TimeBoundaryUnset`, which
+	// draws no boundaries, as does any other value.
+	Boundary TimeBoundary
+	// BoundaryStyle styles the boundary lines and labels; unset fields inherit from the chart defaults,
+	// with the axis color and line width for the lines. A hidden style draws no boundaries.
+	BoundaryStyle Style
+	// BoundaryValueFormatter formats the boundary labels. When it is nil, the labels use the formatter
+	// of the boundary: e.g. "Jan 2" for weeks, "Jan 2006" for months and "Q1 2006" for quarters.
+	BoundaryValueFormatter ValueFormatter
 }
 
 // GetName returns the name.
@@ -39,6 +75,29 @@ func (xa XAxis) GetStyle() Style {
 	return xa.Style
 }
 
+// Clone returns a copy of the axis, including its range, styles, ticks and grid lines.
+func (xa XAxis) Clone() XAxis {
+	xa.NameStyle = xa.NameStyle.Clone()
+	xa.Style = xa.Style.Clone()
+	xa.TickStyle = xa.TickStyle.Clone()
+	xa.GridMajorStyle = xa.GridMajorStyle.Clone()
+	xa.GridMinorStyle = xa.GridMinorStyle.Clone()
+	xa.GridStyle = xa.GridStyle.Clone()
+	xa.MinorTickStyle = xa.MinorTickStyle.Clone()
+	xa.BoundaryStyle = xa.BoundaryStyle.Clone()
+	if xa.Categories != nil {
+		xa.Categories = append([]string{}, xa.Categories...)
+	}
+	if xa.Range != nil {
+		xa.Range = CloneRange(xa.Range)
+	}
+	if xa.Ticks != nil {
+		xa.Ticks = append([]Tick{}, xa.Ticks...)
+	}
+	xa.GridLines = CloneGridLines(xa.GridLines)
+	return xa
+}
+
 // GetValueFormatter returns the value formatter for the axis.
 func (xa XAxis) GetValueFormatter() ValueFormatter {
 	if xa.ValueFormatter != nil {
@@ -61,16 +120,27 @@ func (xa XAxis) GetTickPosition(defaults ...TickPosition) TickPosition {
 // GetTicks returns the ticks for a series.
 // The coalesce priority is:
 // 	- User Supplied Ticks (i.e. Ticks array on the axis itself).
+// 	- Generated ticks (i.e. TickGenerator on the axis itself).
 // 	- Range ticks (i.e. if the range provides ticks).
 //	- Generating continuous ticks based on minimum spacing and canvas width.
+// The ticks are then relabelled by the TickTemplate, if set.
 func (xa XAxis) GetTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter) []Tick {
+	return ApplyTickTemplate(xa.generateTicks(r, ra, defaults, vf), ra, xa.TickTemplate)
+}
+
+func (xa XAxis) generateTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter) []Tick {
 	if len(xa.Ticks) > 0 {
 		return xa.Ticks
 	}
+	// the ticks are spaced by their labels, including their rotation.
+	tickStyle := xa.Style.InheritFrom(defaults)
+	tickStyle.TextRotationDegrees = xa.TickStyle.GetTextRotationDegrees(tickStyle.TextRotationDegrees)
+	if xa.TickGenerator != nil {
+		return xa.TickGenerator.GenerateTicks(r, ra, false, tickStyle, vf)
+	}
 	if tp, isTickProvider := ra.(TicksProvider); isTickProvider {
 		return tp.GetTicks(r, defaults, vf)
 	}
-	tickStyle := xa.Style.InheritFrom(defaults)
 	return GenerateContinuousTicks(r, ra, false, tickStyle, vf)
 }
 
@@ -82,11 +152,27 @@ func (xa XAxis) GetGridLines(ticks []Tick) []GridLine {
 	return GenerateGridLines(ticks, xa.GridMajorStyle, xa.GridMinorStyle)
 }
 
+// GetBoundaryTicks returns the period boundary ticks for the axis, if a boundary is set.
+func (xa XAxis) GetBoundaryTicks(ra Range) []Tick {
+	if xa.Boundary == TimeBoundaryUnset || xa.BoundaryStyle.Hidden {
+		return nil
+	}
+	return GenerateTimeBoundaryTicks(ra, xa.Boundary, xa.BoundaryValueFormatter)
+}
+
+func (xa XAxis) styleDefaultsBoundary(defaults Style) Style {
+	return xa.BoundaryStyle.InheritFrom(defaults.InheritFrom(Style{
+		StrokeColor: DefaultAxisColor,
+		StrokeWidth: DefaultAxisLineWidth,
+	}))
+}
+
 // Measure returns the bounds of the axis.
 func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) Box {
 	tickStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
 
 	tp := xa.GetTickPosition()
+	tickMargin := tickStyle.Margin.GetTop(DefaultXAxisMargin)
 
 	var ltx, rtx int
 	var tx, ty int
@@ -96,11 +182,18 @@ func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 		tb := Draw.MeasureText(r, t.Label, tickStyle.GetTextOptions())
 
 		tx = canvasBox.Left + ra.Translate(v)
-		ty = canvasBox.Bottom + DefaultXAxisMargin + tb.Height()
+		ty = canvasBox.Bottom + tickMargin + tb.Height()
 		switch tp {
 		case TickPositionUnderTick, TickPositionUnset:
-			ltx = tx - tb.Width()>>1
-			rtx = tx + tb.Width()>>1
+			if tickStyle.TextRotationDegrees == 0 {
+				ltx = tx - tb.Width()>>1
+				rtx = tx + tb.Width()>>1
+			} else {
+				_, _, bounds := rotatedTickLabel(r, t.Label, tickStyle)
+				ltx = tx + bounds.Left
+				rtx = tx + bounds.Right
+				ty = canvasBox.Bottom + tickMargin + bounds.Height()
+			}
 			break
 		case TickPositionBetweenTicks:
 			if index > 0 {
@@ -115,9 +208,22 @@ func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 		bottom = MaxInt(bottom, ty)
 	}
 
+	boundaryTicks := xa.GetBoundaryTicks(ra)
+	if len(boundaryTicks) > 0 {
+		boundaryStyle := xa.styleDefaultsBoundary(defaults)
+		var maxBoundaryHeight int
+		for _, t := range boundaryTicks {
+			tb := Draw.MeasureText(r, t.Label, boundaryStyle.GetTextOptions())
+			maxBoundaryHeight = MaxInt(maxBoundaryHeight, tb.Height())
+			right = MaxInt(right, canvasBox.Left+ra.Translate(t.Value)+DefaultHorizontalTickWidth+tb.Width())
+		}
+		bottom += boundaryStyle.Margin.GetTop(DefaultXAxisMargin) + maxBoundaryHeight
+	}
+
 	if !xa.NameStyle.Hidden && len(xa.Name) > 0 {
-		tb := Draw.MeasureText(r, xa.Name, xa.NameStyle.InheritFrom(defaults))
-		bottom += DefaultXAxisMargin + tb.Height()
+		nameStyle := xa.NameStyle.InheritFrom(defaults)
+		tb := Draw.MeasureText(r, xa.Name, nameStyle)
+		bottom += nameStyle.Margin.GetTop(DefaultXAxisMargin) + tb.Height()
 	}
 
 	return Box{
@@ -128,6 +234,40 @@ func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 	}
 }
 
+// rotatedTickLabel returns where to draw a rotated tick label relative to the bottom of its tick, so that
+// the label hangs below the tick, starting at it if rotated clockwise and ending at it if rotated
+// counterclockwise, and the bounds of the drawn label relative to the same point.
+func rotatedTickLabel(r Renderer, label string, style Style) (dx, dy int, bounds Box) {
+	radians := DegreesToRadians(style.GetTextRotationDegrees())
+	style.TextRotationDegrees = 0
+	tb := Draw.MeasureText(r, label, style)
+	w, h := float64(tb.Width()), float64(tb.Height())
+
+	sin, cos := math.Sin(radians), math.Cos(radians)
+	rotate := func(x, y float64) (float64, float64) {
+		return x*cos - y*sin, x*sin + y*cos
+	}
+	minX, minY, maxX, maxY := math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64, -math.MaxFloat64
+	for _, corner := range [][2]float64{{0, 0}, {0, -h}, {w, 0}, {w, -h}} {
+		x, y := rotate(corner[0], corner[1])
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+	}
+
+	// the anchor is the middle of the start of the label, or of the end if it is rotated counterclockwise.
+	anchorX, _ := rotate(0, -h/2)
+	if sin < 0 {
+		anchorX, _ = rotate(w, -h/2)
+	}
+	dx, dy = int(math.Round(-anchorX)), int(math.Round(-minY))
+	bounds = Box{
+		Left:   dx + int(math.Floor(minX)),
+		Right:  dx + int(math.Ceil(maxX)),
+		Bottom: int(math.Ceil(maxY - minY)),
+	}
+	return
+}
+
 // Render renders the axis
 func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) {
 	tickStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
@@ -138,6 +278,7 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	r.Stroke()
 
 	tp := xa.GetTickPosition()
+	tickMargin := tickStyle.Margin.GetTop(DefaultXAxisMargin)
 
 	var tx, ty int
 	var maxTextHeight int
@@ -159,9 +300,12 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		case TickPositionUnderTick, TickPositionUnset:
 			if tickStyle.TextRotationDegrees == 0 {
 				tx = tx - tb.Width()>>1
-				ty = canvasBox.Bottom + DefaultXAxisMargin + tb.Height()
+				ty = canvasBox.Bottom + tickMargin + tb.Height()
 			} else {
-				ty = canvasBox.Bottom + (2 * DefaultXAxisMargin)
+				dx, dy, bounds := rotatedTickLabel(r, t.Label, tickWithAxisStyle)
+				tx = tx + dx
+				ty = canvasBox.Bottom + tickMargin + dy
+				tb = bounds
 			}
 			Draw.Text(r, t.Label, tx, ty, tickWithAxisStyle)
 			maxTextHeight = MaxInt(maxTextHeight, tb.Height())
@@ -175,8 +319,8 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 				Draw.TextWithin(r, t.Label, Box{
 					Left:   ltx,
 					Right:  tx,
-					Top:    canvasBox.Bottom + DefaultXAxisMargin,
-					Bottom: canvasBox.Bottom + DefaultXAxisMargin,
+					Top:    canvasBox.Bottom + tickMargin,
+					Bottom: canvasBox.Bottom + tickMargin,
 				}, finalTickStyle)
 
 				ftb := Text.MeasureLines(r, Text.WrapFit(r, t.Label, tx-ltx, finalTickStyle), finalTickStyle)
@@ -186,15 +330,48 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		}
 	}
 
+	if xa.MinorTicks > 0 && !xa.MinorTickStyle.Hidden {
+		xa.MinorTickStyle.InheritFrom(tickStyle).GetStrokeOptions().WriteToRenderer(r)
+		for _, t := range GenerateMinorTicks(ticks, ra, xa.MinorTicks) {
+			tx = canvasBox.Left + ra.Translate(t.Value)
+			r.MoveTo(tx, canvasBox.Bottom)
+			r.LineTo(tx, canvasBox.Bottom+DefaultMinorTickHeight)
+			r.Stroke()
+		}
+	}
+
+	boundaryTicks := xa.GetBoundaryTicks(ra)
+	if len(boundaryTicks) > 0 {
+		boundaryStyle := xa.styleDefaultsBoundary(defaults)
+		boundaryMargin := boundaryStyle.Margin.GetTop(DefaultXAxisMargin)
+		var maxBoundaryHeight int
+		for _, t := range boundaryTicks {
+			tx = canvasBox.Left + ra.Translate(t.Value)
+			tb := Draw.MeasureText(r, t.Label, boundaryStyle)
+			ty = canvasBox.Bottom + tickMargin + maxTextHeight + boundaryMargin + tb.Height()
+
+			boundaryStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(tx, canvasBox.Top)
+			r.LineTo(tx, ty)
+			r.Stroke()
+
+			Draw.Text(r, t.Label, tx+DefaultHorizontalTickWidth, ty, boundaryStyle)
+			maxBoundaryHeight = MaxInt(maxBoundaryHeight, tb.Height())
+		}
+		maxTextHeight += boundaryMargin + maxBoundaryHeight
+	}
+
 	nameStyle := xa.NameStyle.InheritFrom(defaults)
 	if !xa.NameStyle.Hidden && len(xa.Name) > 0 {
 		tb := Draw.MeasureText(r, xa.Name, nameStyle)
 		tx := canvasBox.Right - (canvasBox.Width()>>1 + tb.Width()>>1)
-		ty := canvasBox.Bottom + DefaultXAxisMargin + maxTextHeight + DefaultXAxisMargin + tb.Height()
+		ty := canvasBox.Bottom + tickMargin + maxTextHeight + nameStyle.Margin.GetTop(DefaultXAxisMargin) + tb.Height()
 		Draw.Text(r, xa.Name, tx, ty, nameStyle)
 	}
 
-	if !xa.GridMajorStyle.Hidden || !xa.GridMinorStyle.Hidden {
+	if len(xa.GridLines) == 0 && (!xa.GridStyle.IsZero() || (xa.MinorTicks > 0 && !xa.GridMinorStyle.IsZero())) {
+		drawTickGridLines(r, canvasBox, ra, true, ticks, xa.MinorTicks, xa.GridStyle, xa.GridMinorStyle)
+	} else if !xa.GridMajorStyle.Hidden || !xa.GridMinorStyle.Hidden {
 		for _, gl := range xa.GetGridLines(ticks) {
 			if (gl.IsMinor && !xa.GridMinorStyle.Hidden) || (!gl.IsMinor && !xa.GridMajorStyle.Hidden) {
 				defaults := xa.GridMajorStyle
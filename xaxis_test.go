@@ -1,8 +1,12 @@
 package chart
 
 import (
+	"bytes"
+	"image/png"
 	"testing"
+	"time"
 
+	"github.com/wcharczuk/go-chart/v2/drawing"
 	"github.com/wcharczuk/go-chart/v2/testutil"
 )
 
@@ -65,3 +69,97 @@ func TestXAxisMeasure(t *testing.T) {
 	testutil.AssertEqual(t, 122, xab.Width())
 	testutil.AssertEqual(t, 21, xab.Height())
 }
+
+func TestXAxisMeasureMargin(t *testing.T) {
+	// replaced new assertions helper
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	testutil.AssertNil(t, err)
+	ticks := []Tick{{Value: 1.0, Label: "1.0"}, {Value: 2.0, Label: "2.0"}, {Value: 3.0, Label: "3.0"}}
+	ra := &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}
+
+	named := XAxis{Name: "Time"}.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	margined := XAxis{
+		Name:      "Time",
+		TickStyle: Style{Margin: Box{Top: 20}},
+		NameStyle: Style{Margin: Box{Top: 15}},
+	}.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	testutil.AssertEqual(t, named.Height()+(20-DefaultXAxisMargin)+(15-DefaultXAxisMargin), margined.Height())
+}
+
+func TestXAxisRenderBoundaryLabel(t *testing.T) {
+	// replaced new assertions helper
+
+	// the boundary at the end of the range puts its label against the right edge of the chart.
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.Local)
+	end := time.Date(2020, 2, 1, 0, 0, 0, 0, time.Local)
+	c := Chart{
+		Width:      400,
+		Height:     200,
+		Background: Style{Padding: Box{IsSet: true}},
+		XAxis: XAxis{
+			Boundary:      TimeBoundaryMonth,
+			BoundaryStyle: Style{FontColor: drawing.ColorRed},
+		},
+		Series: []Series{TimeSeries{XValues: []time.Time{start, end}, YValues: []float64{1, 2}}},
+	}
+	buffer := bytes.NewBuffer(nil)
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	img, err := png.Decode(buffer)
+	testutil.AssertNil(t, err)
+
+	right := img.Bounds().Max.X - 1
+	for y := 0; y < img.Bounds().Max.Y; y++ {
+		r, g, b, _ := img.At(right, y).RGBA()
+		testutil.AssertFalse(t, r>>8 > 150 && g>>8 < 100 && b>>8 < 100, "the boundary label is clipped at y", y)
+	}
+}
+
+func TestXAxisMeasureRotatedTicks(t *testing.T) {
+	// replaced new assertions helper
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	testutil.AssertNil(t, err)
+	ticks := []Tick{{Value: 1.0, Label: "2024-01-01 00:00:00"}, {Value: 3.0, Label: "2024-01-03 00:00:00"}}
+	ra := &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}
+
+	flat := XAxis{}.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	upright := XAxis{TickStyle: Style{TextRotationDegrees: 90}}.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	testutil.AssertTrue(t, upright.Height() > flat.Height())
+	testutil.AssertTrue(t, upright.Width() < flat.Width())
+
+	// a clockwise label hangs from its tick to the right, a counterclockwise one to the left.
+	_, _, clockwise := rotatedTickLabel(r, ticks[0].Label, style.InheritFrom(Style{TextRotationDegrees: 45}))
+	testutil.AssertTrue(t, clockwise.Right > -clockwise.Left)
+	_, _, counterclockwise := rotatedTickLabel(r, ticks[0].Label, style.InheritFrom(Style{TextRotationDegrees: -45}))
+	testutil.AssertTrue(t, -counterclockwise.Left > counterclockwise.Right)
+	testutil.AssertEqual(t, clockwise.Height(), counterclockwise.Height())
+}
+
+func TestXAxisGetTicksRotated(t *testing.T) {
+	// replaced new assertions helper
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+	ra := &ContinuousRange{Min: 0, Max: 1000000, Domain: 400}
+	vf := func(v interface{}) string { return FloatValueFormatterWithFormat(v, "%0.2f units") }
+	defaults := Style{Font: f, FontSize: 10}
+
+	flat := XAxis{}.GetTicks(r, ra, defaults, vf)
+	rotated := XAxis{TickStyle: Style{TextRotationDegrees: 90}}.GetTicks(r, ra, defaults, vf)
+	testutil.AssertTrue(t, len(rotated) > len(flat))
+}
@@ -0,0 +1,39 @@
+package chart
+
+import "math"
+
+// GetSharedYRange returns the union of the primary y ranges of the charts, as each chart would
+// compute it from its series, ticks or range. If symmetric is set the range is widened to be
+// symmetric about zero, e.g. for charts of changes or variances.
+func GetSharedYRange(charts []Chart, symmetric bool) *ContinuousRange {
+	miny, maxy := math.MaxFloat64, -math.MaxFloat64
+	for _, c := range charts {
+		_, yrange, _ := c.getRanges()
+		miny = math.Min(miny, yrange.GetMin())
+		maxy = math.Max(maxy, yrange.GetMax())
+	}
+	if len(charts) == 0 {
+		miny, maxy = 0, 0
+	}
+	if symmetric {
+		maxy = math.Max(math.Abs(miny), math.Abs(maxy))
+		miny = -maxy
+	}
+	return &ContinuousRange{Min: miny, Max: maxy}
+}
+
+// SyncYRanges sets the primary y range of each of the charts, in place, to their shared range
+// (see `GetSharedYRange`), so that charts drawn side by side can be compared with each other.
+// A chart keeps the kind and direction of its own range if it has one.
+func SyncYRanges(charts []Chart, symmetric bool) {
+	shared := GetSharedYRange(charts, symmetric)
+	for index := range charts {
+		yrange := CloneRange(charts[index].YAxis.Range)
+		if yrange == nil {
+			yrange = &ContinuousRange{}
+		}
+		yrange.SetMin(shared.Min)
+		yrange.SetMax(shared.Max)
+		charts[index].YAxis.Range = yrange
+	}
+}
@@ -0,0 +1,43 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestSyncYRanges(t *testing.T) {
+	// replaced new assertions helper
+
+	charts := []Chart{
+		{
+			YAxis: YAxis{Style: Hidden()},
+			Series: []Series{
+				ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{-2, 1, 4}},
+			},
+		},
+		{
+			YAxis: YAxis{Style: Hidden(), Range: &ContinuousRange{Descending: true}},
+			Series: []Series{
+				ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{3, 8, 5}},
+			},
+		},
+	}
+
+	shared := GetSharedYRange(charts, false)
+	testutil.AssertEqual(t, -2.0, shared.Min)
+	testutil.AssertEqual(t, 8.0, shared.Max)
+
+	shared = GetSharedYRange(charts, true)
+	testutil.AssertEqual(t, -8.0, shared.Min)
+	testutil.AssertEqual(t, 8.0, shared.Max)
+
+	SyncYRanges(charts, false)
+	for _, c := range charts {
+		testutil.AssertEqual(t, -2.0, c.YAxis.Range.GetMin())
+		testutil.AssertEqual(t, 8.0, c.YAxis.Range.GetMax())
+	}
+	testutil.AssertTrue(t, charts[1].YAxis.Range.IsDescending())
+
+	testutil.AssertTrue(t, GetSharedYRange(nil, true).IsZero())
+}
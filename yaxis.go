@@ -2,6 +2,7 @@ package chart
 
 import (
 	"math"
+	"text/template"
 )
 
 // HideYAxis hides a y-axis.
@@ -27,12 +28,33 @@ type YAxis struct {
 	ValueFormatter ValueFormatter
 	Range          Range
 
+	// Transform, if set on the secondary y-axis, makes it mirror the primary y-axis in other units,
+	// e.g. fahrenheit for a chart in celsius, without a range or series of its own.
+	// The transform should be linear, as the mirrored range is mapped linearly onto the canvas.
+	Transform func(float64) float64
+
+	// Break cuts an interval of values out of the axis.
+	Break AxisBreak
+
 	TickStyle Style
 	Ticks     []Tick
+	// TickGenerator generates the ticks when `Ticks` is not set, in place of the range ticks or continuous ticks.
+	TickGenerator TickGenerator
+	// TickTemplate, if set, relabels the ticks; see `NewTickTemplate`.
+	TickTemplate *template.Template
 
 	GridLines      []GridLine
 	GridMajorStyle Style
 	GridMinorStyle Style
+	// GridStyle, if set, draws a grid line at every tick in place of the alternating major and minor grid
+	// lines, e.g. faint dotted lines, independently of the axis style.
+	GridStyle Style
+
+	// MinorTicks is the number of minor ticks between each pair of ticks, drawn as shorter tick marks
+	// without labels. With minor ticks, `GridMinorStyle` draws lighter grid lines at them, in place of
+	// the alternating major and minor grid lines.
+	MinorTicks     int
+	MinorTickStyle Style
 }
 
 // GetName returns the name.
@@ -50,6 +72,27 @@ func (ya YAxis) GetStyle() Style {
 	return ya.Style
 }
 
+// Clone returns a copy of the axis, including its range, styles, ticks and grid lines.
+func (ya YAxis) Clone() YAxis {
+	ya.NameStyle = ya.NameStyle.Clone()
+	ya.Style = ya.Style.Clone()
+	ya.Zero = ya.Zero.Clone()
+	ya.TickStyle = ya.TickStyle.Clone()
+	ya.GridMajorStyle = ya.GridMajorStyle.Clone()
+	ya.GridMinorStyle = ya.GridMinorStyle.Clone()
+	ya.GridStyle = ya.GridStyle.Clone()
+	ya.MinorTickStyle = ya.MinorTickStyle.Clone()
+	ya.Break.Style = ya.Break.Style.Clone()
+	if ya.Range != nil {
+		ya.Range = CloneRange(ya.Range)
+	}
+	if ya.Ticks != nil {
+		ya.Ticks = append([]Tick{}, ya.Ticks...)
+	}
+	ya.GridLines = CloneGridLines(ya.GridLines)
+	return ya
+}
+
 // GetValueFormatter returns the value formatter for the axis.
 func (ya YAxis) GetValueFormatter() ValueFormatter {
 	if ya.ValueFormatter != nil {
@@ -66,12 +109,21 @@ func (ya YAxis) GetTickStyle() Style {
 // GetTicks returns the ticks for a series.
 // The coalesce priority is:
 // 	- User Supplied Ticks (i.e. Ticks array on the axis itself).
+// 	- Generated ticks (i.e. TickGenerator on the axis itself).
 // 	- Range ticks (i.e. if the range provides ticks).
 //	- Generating continuous ticks based on minimum spacing and canvas width.
+// The ticks are then relabelled by the TickTemplate, if set.
 func (ya YAxis) GetTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter) []Tick {
+	return ApplyTickTemplate(ya.generateTicks(r, ra, defaults, vf), ra, ya.TickTemplate)
+}
+
+func (ya YAxis) generateTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter) []Tick {
 	if len(ya.Ticks) > 0 {
 		return ya.Ticks
 	}
+	if ya.TickGenerator != nil {
+		return ya.TickGenerator.GenerateTicks(r, ra, true, ya.Style.InheritFrom(defaults), vf)
+	}
 	if tp, isTickProvider := ra.(TicksProvider); isTickProvider {
 		return tp.GetTicks(r, defaults, vf)
 	}
@@ -87,16 +139,28 @@ func (ya YAxis) GetGridLines(ticks []Tick) []GridLine {
 	return GenerateGridLines(ticks, ya.GridMajorStyle, ya.GridMinorStyle)
 }
 
+// labelMargin returns the space between labels drawn with a style and whatever is nearer the canvas,
+// i.e. the left margin of the style for the primary axis on the right of the canvas, and the right
+// margin for the secondary axis on the left.
+func (ya YAxis) labelMargin(style Style) int {
+	if ya.AxisType == YAxisSecondary {
+		return style.Margin.GetRight(DefaultYAxisMargin)
+	}
+	return style.Margin.GetLeft(DefaultYAxisMargin)
+}
+
 // Measure returns the bounds of the axis.
 func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) Box {
+	tickStyle := ya.TickStyle.InheritFrom(ya.Style.InheritFrom(defaults))
+
 	var tx int
 	if ya.AxisType == YAxisPrimary {
-		tx = canvasBox.Right + DefaultYAxisMargin
+		tx = canvasBox.Right + ya.labelMargin(tickStyle)
 	} else if ya.AxisType == YAxisSecondary {
-		tx = canvasBox.Left - DefaultYAxisMargin
+		tx = canvasBox.Left - ya.labelMargin(tickStyle)
 	}
 
-	ya.TickStyle.InheritFrom(ya.Style.InheritFrom(defaults)).WriteToRenderer(r)
+	tickStyle.WriteToRenderer(r)
 	var minx, maxx, miny, maxy = math.MaxInt32, 0, math.MaxInt32, 0
 	var maxTextHeight int
 	for _, t := range ticks {
@@ -125,7 +189,12 @@ func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 	}
 
 	if !ya.NameStyle.Hidden && len(ya.Name) > 0 {
-		maxx += (DefaultYAxisMargin + maxTextHeight)
+		nameMargin := ya.labelMargin(ya.NameStyle.InheritFrom(defaults))
+		if ya.AxisType == YAxisSecondary {
+			minx -= (nameMargin + maxTextHeight)
+		} else {
+			maxx += (nameMargin + maxTextHeight)
+		}
 	}
 
 	return Box{
@@ -145,17 +214,29 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 
 	var lx int
 	var tx int
+	tickMargin := ya.labelMargin(tickStyle)
 	if ya.AxisType == YAxisPrimary {
 		lx = canvasBox.Right + int(sw)
-		tx = lx + DefaultYAxisMargin
+		tx = lx + tickMargin
 	} else if ya.AxisType == YAxisSecondary {
 		lx = canvasBox.Left - int(sw)
-		tx = lx - DefaultYAxisMargin
+		tx = lx - tickMargin
 	}
 
-	r.MoveTo(lx, canvasBox.Bottom)
-	r.LineTo(lx, canvasBox.Top)
-	r.Stroke()
+	if br, isBroken := ra.(*BrokenRange); isBroken && br.HasBreak() {
+		lower, upper := br.GetBreakEdges()
+		r.MoveTo(lx, canvasBox.Bottom)
+		r.LineTo(lx, canvasBox.Bottom-MinInt(lower, upper))
+		r.MoveTo(lx, canvasBox.Bottom-MaxInt(lower, upper))
+		r.LineTo(lx, canvasBox.Top)
+		r.Stroke()
+		br.Break.Render(r, lx, canvasBox.Bottom-lower, canvasBox.Bottom-upper, tickStyle)
+		tickStyle.WriteToRenderer(r)
+	} else {
+		r.MoveTo(lx, canvasBox.Bottom)
+		r.LineTo(lx, canvasBox.Top)
+		r.Stroke()
+	}
 
 	var maxTextWidth int
 	var finalTextX, finalTextY int
@@ -194,16 +275,35 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		Draw.Text(r, t.Label, finalTextX, finalTextY, tickStyle)
 	}
 
+	if ya.MinorTicks > 0 && !ya.MinorTickStyle.Hidden {
+		ya.MinorTickStyle.InheritFrom(tickStyle).GetStrokeOptions().WriteToRenderer(r)
+		for _, t := range GenerateMinorTicks(ticks, ra, ya.MinorTicks) {
+			ly := canvasBox.Bottom - ra.Translate(t.Value)
+			r.MoveTo(lx, ly)
+			if ya.AxisType == YAxisPrimary {
+				r.LineTo(lx+DefaultMinorTickWidth, ly)
+			} else if ya.AxisType == YAxisSecondary {
+				r.LineTo(lx-DefaultMinorTickWidth, ly)
+			}
+			r.Stroke()
+		}
+	}
+
 	nameStyle := ya.NameStyle.InheritFrom(defaults.InheritFrom(Style{TextRotationDegrees: 90}))
 	if !ya.NameStyle.Hidden && len(ya.Name) > 0 {
 		nameStyle.GetTextOptions().WriteToRenderer(r)
 		tb := Draw.MeasureText(r, ya.Name, nameStyle)
 
+		nameMargin := ya.labelMargin(nameStyle)
 		var tx int
 		if ya.AxisType == YAxisPrimary {
-			tx = canvasBox.Right + int(sw) + DefaultYAxisMargin + maxTextWidth + DefaultYAxisMargin
+			tx = canvasBox.Right + int(sw) + tickMargin + maxTextWidth + nameMargin
 		} else if ya.AxisType == YAxisSecondary {
-			tx = canvasBox.Left - (DefaultYAxisMargin + int(sw) + maxTextWidth + DefaultYAxisMargin)
+			tx = canvasBox.Left - (tickMargin + int(sw) + maxTextWidth + nameMargin)
+			if nameStyle.TextRotationDegrees != 0 {
+				// the rotated name is drawn to the right of tx, so move it clear of the tick labels.
+				tx -= tb.Width()
+			}
 		}
 
 		var ty int
@@ -220,7 +320,9 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		ya.Zero.Render(r, canvasBox, ra, false, Style{})
 	}
 
-	if !ya.GridMajorStyle.Hidden || !ya.GridMinorStyle.Hidden {
+	if len(ya.GridLines) == 0 && (!ya.GridStyle.IsZero() || (ya.MinorTicks > 0 && !ya.GridMinorStyle.IsZero())) {
+		drawTickGridLines(r, canvasBox, ra, false, ticks, ya.MinorTicks, ya.GridStyle, ya.GridMinorStyle)
+	} else if !ya.GridMajorStyle.Hidden || !ya.GridMinorStyle.Hidden {
 		for _, gl := range ya.GetGridLines(ticks) {
 			if (gl.IsMinor && !ya.GridMinorStyle.Hidden) || (!gl.IsMinor && !ya.GridMajorStyle.Hidden) {
 				defaults := ya.GridMajorStyle
@@ -66,6 +66,39 @@ func TestYAxisMeasure(t *testing.T) {
 	testutil.AssertEqual(t, 110, yab.Height())
 }
 
+func TestYAxisMeasureMargin(t *testing.T) {
+	// replaced new assertions helper
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	testutil.AssertNil(t, err)
+	ticks := []Tick{{Value: 1.0, Label: "1.0"}, {Value: 2.0, Label: "2.0"}, {Value: 3.0, Label: "3.0"}}
+	ra := &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}
+
+	named := YAxis{Name: "Requests"}.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	margined := YAxis{
+		Name:      "Requests",
+		TickStyle: Style{Margin: Box{Left: 20}},
+		NameStyle: Style{Margin: Box{Left: 15}},
+	}.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	testutil.AssertEqual(t, named.Width()+(20-DefaultYAxisMargin)+(15-DefaultYAxisMargin), margined.Width())
+
+	// the secondary axis is on the left of the canvas, so its labels are spaced by their right margin.
+	secondary := YAxis{AxisType: YAxisSecondary, Name: "Requests"}.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	secondaryMargined := YAxis{
+		AxisType:  YAxisSecondary,
+		Name:      "Requests",
+		TickStyle: Style{Margin: Box{Right: 20}},
+		NameStyle: Style{Margin: Box{Right: 15}},
+	}.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	testutil.AssertEqual(t, secondary.Width()+(20-DefaultYAxisMargin)+(15-DefaultYAxisMargin), secondaryMargined.Width())
+}
+
 func TestYAxisSecondaryMeasure(t *testing.T) {
 	// replaced new assertions helper
 